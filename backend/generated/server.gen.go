@@ -20,39 +20,251 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+// Defines values for ApplicationReviewStatus.
+const (
+	ApplicationReviewStatusApproved   ApplicationReviewStatus = "approved"
+	ApplicationReviewStatusRejected   ApplicationReviewStatus = "rejected"
+	ApplicationReviewStatusWaitlisted ApplicationReviewStatus = "waitlisted"
+)
+
+// Defines values for CoinAwardRequestKind.
+const (
+	CoinAwardRequestKindAdminAdjustment CoinAwardRequestKind = "admin_adjustment"
+	CoinAwardRequestKindAttendance      CoinAwardRequestKind = "attendance"
+)
+
+// Defines values for CoinAwardRequestStatus.
+const (
+	CoinAwardRequestStatusApproved CoinAwardRequestStatus = "approved"
+	CoinAwardRequestStatusPending  CoinAwardRequestStatus = "pending"
+	CoinAwardRequestStatusRejected CoinAwardRequestStatus = "rejected"
+)
+
+// Defines values for FormFieldType.
+const (
+	Checkbox    FormFieldType = "checkbox"
+	Choice      FormFieldType = "choice"
+	LongText    FormFieldType = "long_text"
+	MultiChoice FormFieldType = "multi_choice"
+	Number      FormFieldType = "number"
+	Text        FormFieldType = "text"
+)
+
+// Defines values for HackathonCreateRequestStatus.
+const (
+	HackathonCreateRequestStatusDraft            HackathonCreateRequestStatus = "draft"
+	HackathonCreateRequestStatusRegistrationOpen HackathonCreateRequestStatus = "registration_open"
+)
+
 // Defines values for HackathonStatus.
 const (
-	HackathonStatusActive HackathonStatus = "active"
-	HackathonStatusPast   HackathonStatus = "past"
+	HackathonStatusCancelled          HackathonStatus = "cancelled"
+	HackathonStatusDraft              HackathonStatus = "draft"
+	HackathonStatusFinished           HackathonStatus = "finished"
+	HackathonStatusJudging            HackathonStatus = "judging"
+	HackathonStatusRegistrationClosed HackathonStatus = "registration_closed"
+	HackathonStatusRegistrationOpen   HackathonStatus = "registration_open"
+	HackathonStatusRunning            HackathonStatus = "running"
+)
+
+// Defines values for PromoCodeDiscountType.
+const (
+	PromoCodeDiscountTypeFixed   PromoCodeDiscountType = "fixed"
+	PromoCodeDiscountTypePercent PromoCodeDiscountType = "percent"
+)
+
+// Defines values for PromoCodeCreateRequestDiscountType.
+const (
+	PromoCodeCreateRequestDiscountTypeFixed   PromoCodeCreateRequestDiscountType = "fixed"
+	PromoCodeCreateRequestDiscountTypePercent PromoCodeCreateRequestDiscountType = "percent"
+)
+
+// Defines values for QuestMyStatus.
+const (
+	QuestMyStatusApproved QuestMyStatus = "approved"
+	QuestMyStatusPending  QuestMyStatus = "pending"
+	QuestMyStatusRejected QuestMyStatus = "rejected"
+)
+
+// Defines values for QuestSubmissionStatus.
+const (
+	QuestSubmissionStatusApproved QuestSubmissionStatus = "approved"
+	QuestSubmissionStatusPending  QuestSubmissionStatus = "pending"
+	QuestSubmissionStatusRejected QuestSubmissionStatus = "rejected"
+)
+
+// Defines values for RaffleStatus.
+const (
+	Drawn RaffleStatus = "drawn"
+	Open  RaffleStatus = "open"
+)
+
+// Defines values for ReferralStatus.
+const (
+	ReferralStatusPending  ReferralStatus = "pending"
+	ReferralStatusRejected ReferralStatus = "rejected"
+	ReferralStatusRewarded ReferralStatus = "rewarded"
+)
+
+// Defines values for SeasonStatus.
+const (
+	SeasonStatusActive   SeasonStatus = "active"
+	SeasonStatusClosed   SeasonStatus = "closed"
+	SeasonStatusEnded    SeasonStatus = "ended"
+	SeasonStatusUpcoming SeasonStatus = "upcoming"
 )
 
 // Defines values for UserRole.
 const (
-	Admin      UserRole = "admin"
-	ClubLeader UserRole = "club_leader"
-	Guest      UserRole = "guest"
-	Student    UserRole = "student"
+	UserRoleAdmin      UserRole = "admin"
+	UserRoleClubLeader UserRole = "club_leader"
+	UserRoleGuest      UserRole = "guest"
+	UserRoleStudent    UserRole = "student"
+)
+
+// Defines values for UserProfileRole.
+const (
+	UserProfileRoleAdmin      UserProfileRole = "admin"
+	UserProfileRoleClubLeader UserProfileRole = "club_leader"
+	UserProfileRoleGuest      UserProfileRole = "guest"
+	UserProfileRoleStudent    UserProfileRole = "student"
+)
+
+// Defines values for ListCoinAwardRequestsParamsStatus.
+const (
+	ListCoinAwardRequestsParamsStatusApproved ListCoinAwardRequestsParamsStatus = "approved"
+	ListCoinAwardRequestsParamsStatusPending  ListCoinAwardRequestsParamsStatus = "pending"
+	ListCoinAwardRequestsParamsStatusRejected ListCoinAwardRequestsParamsStatus = "rejected"
 )
 
 // Defines values for ListHackathonsParamsStatus.
 const (
-	ListHackathonsParamsStatusActive ListHackathonsParamsStatus = "active"
-	ListHackathonsParamsStatusPast   ListHackathonsParamsStatus = "past"
+	ListHackathonsParamsStatusActive             ListHackathonsParamsStatus = "active"
+	ListHackathonsParamsStatusCancelled          ListHackathonsParamsStatus = "cancelled"
+	ListHackathonsParamsStatusDraft              ListHackathonsParamsStatus = "draft"
+	ListHackathonsParamsStatusFinished           ListHackathonsParamsStatus = "finished"
+	ListHackathonsParamsStatusJudging            ListHackathonsParamsStatus = "judging"
+	ListHackathonsParamsStatusPast               ListHackathonsParamsStatus = "past"
+	ListHackathonsParamsStatusRegistrationClosed ListHackathonsParamsStatus = "registration_closed"
+	ListHackathonsParamsStatusRegistrationOpen   ListHackathonsParamsStatus = "registration_open"
+	ListHackathonsParamsStatusRunning            ListHackathonsParamsStatus = "running"
+)
+
+// Defines values for GetLeaderboardParamsPeriod.
+const (
+	GetLeaderboardParamsPeriodAll    GetLeaderboardParamsPeriod = "all"
+	GetLeaderboardParamsPeriodMonth  GetLeaderboardParamsPeriod = "month"
+	GetLeaderboardParamsPeriodSeason GetLeaderboardParamsPeriod = "season"
+	GetLeaderboardParamsPeriodWeek   GetLeaderboardParamsPeriod = "week"
+)
+
+// Defines values for GetLeaderboardParamsMetric.
+const (
+	GetLeaderboardParamsMetricAttendance  GetLeaderboardParamsMetric = "attendance"
+	GetLeaderboardParamsMetricCoins       GetLeaderboardParamsMetric = "coins"
+	GetLeaderboardParamsMetricCommunityXp GetLeaderboardParamsMetric = "community_xp"
+	GetLeaderboardParamsMetricLevel       GetLeaderboardParamsMetric = "level"
+	GetLeaderboardParamsMetricXp          GetLeaderboardParamsMetric = "xp"
 )
 
+// Defines values for GetLeagueLeaderboardsParamsPeriod.
+const (
+	GetLeagueLeaderboardsParamsPeriodAll    GetLeagueLeaderboardsParamsPeriod = "all"
+	GetLeagueLeaderboardsParamsPeriodMonth  GetLeagueLeaderboardsParamsPeriod = "month"
+	GetLeagueLeaderboardsParamsPeriodSeason GetLeagueLeaderboardsParamsPeriod = "season"
+	GetLeagueLeaderboardsParamsPeriodWeek   GetLeagueLeaderboardsParamsPeriod = "week"
+)
+
+// Defines values for GetLeagueLeaderboardsParamsMetric.
+const (
+	GetLeagueLeaderboardsParamsMetricAttendance  GetLeagueLeaderboardsParamsMetric = "attendance"
+	GetLeagueLeaderboardsParamsMetricCoins       GetLeagueLeaderboardsParamsMetric = "coins"
+	GetLeagueLeaderboardsParamsMetricCommunityXp GetLeagueLeaderboardsParamsMetric = "community_xp"
+	GetLeagueLeaderboardsParamsMetricLevel       GetLeagueLeaderboardsParamsMetric = "level"
+	GetLeagueLeaderboardsParamsMetricXp          GetLeagueLeaderboardsParamsMetric = "xp"
+)
+
+// Defines values for GetMyLeaderboardEntryParamsPeriod.
+const (
+	GetMyLeaderboardEntryParamsPeriodAll    GetMyLeaderboardEntryParamsPeriod = "all"
+	GetMyLeaderboardEntryParamsPeriodMonth  GetMyLeaderboardEntryParamsPeriod = "month"
+	GetMyLeaderboardEntryParamsPeriodSeason GetMyLeaderboardEntryParamsPeriod = "season"
+	GetMyLeaderboardEntryParamsPeriodWeek   GetMyLeaderboardEntryParamsPeriod = "week"
+)
+
+// Defines values for GetMyLeaderboardEntryParamsMetric.
+const (
+	GetMyLeaderboardEntryParamsMetricAttendance  GetMyLeaderboardEntryParamsMetric = "attendance"
+	GetMyLeaderboardEntryParamsMetricCoins       GetMyLeaderboardEntryParamsMetric = "coins"
+	GetMyLeaderboardEntryParamsMetricCommunityXp GetMyLeaderboardEntryParamsMetric = "community_xp"
+	GetMyLeaderboardEntryParamsMetricLevel       GetMyLeaderboardEntryParamsMetric = "level"
+	GetMyLeaderboardEntryParamsMetricXp          GetMyLeaderboardEntryParamsMetric = "xp"
+)
+
+// Defines values for ListQuestSubmissionsParamsStatus.
+const (
+	Approved ListQuestSubmissionsParamsStatus = "approved"
+	Pending  ListQuestSubmissionsParamsStatus = "pending"
+	Rejected ListQuestSubmissionsParamsStatus = "rejected"
+)
+
+// AdminAlert defines model for AdminAlert.
+type AdminAlert struct {
+	AdminId   *int64     `json:"admin_id,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        int64      `json:"id"`
+
+	// Kind e.g. repeat_check_in, award_spike
+	Kind       string     `json:"kind"`
+	Message    string     `json:"message"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	ResolvedBy *int64     `json:"resolved_by,omitempty"`
+	UserId     *int64     `json:"user_id,omitempty"`
+}
+
 // AdminAuthRequest defines model for AdminAuthRequest.
 type AdminAuthRequest struct {
 	Password string `json:"password"`
 	Username string `json:"username"`
 }
 
+// ApplicationBulkReviewRequest defines model for ApplicationBulkReviewRequest.
+type ApplicationBulkReviewRequest struct {
+	ApplicationIds []int64 `json:"application_ids"`
+
+	// Message Included in every applicant's Telegram notification
+	Message *string                 `json:"message,omitempty"`
+	Status  ApplicationReviewStatus `json:"status"`
+}
+
+// ApplicationReviewRequest defines model for ApplicationReviewRequest.
+type ApplicationReviewRequest struct {
+	// Message Included in the applicant's Telegram notification
+	Message *string                 `json:"message,omitempty"`
+	Status  ApplicationReviewStatus `json:"status"`
+}
+
+// ApplicationReviewStatus defines model for ApplicationReviewStatus.
+type ApplicationReviewStatus string
+
 // Attendance defines model for Attendance.
 type Attendance struct {
+	// AwardedBy Admin who made the check-in
+	AwardedBy *int64 `json:"awarded_by,omitempty"`
+
+	// BaseCoins Coins entered by the admin before the multiplier
+	BaseCoins *int `json:"base_coins,omitempty"`
+
+	// CoinsAwarded Coins credited, including the streak multiplier
 	CoinsAwarded int        `json:"coins_awarded"`
 	CreatedAt    *time.Time `json:"created_at,omitempty"`
 	EventName    string     `json:"event_name"`
 	Id           int64      `json:"id"`
-	UserId       int64      `json:"user_id"`
+	Multiplier   *float64   `json:"multiplier,omitempty"`
+
+	// StreakWeeks Streak length this check-in counted towards
+	StreakWeeks *int  `json:"streak_weeks,omitempty"`
+	UserId      int64 `json:"user_id"`
 }
 
 // AuthRequest defines model for AuthRequest.
@@ -65,6 +277,36 @@ type AuthResponse struct {
 	User User `json:"user"`
 }
 
+// Badge defines model for Badge.
+type Badge struct {
+	AwardedAt   *time.Time `json:"awarded_at,omitempty"`
+	Code        string     `json:"code"`
+	Description *string    `json:"description,omitempty"`
+	Icon        *string    `json:"icon,omitempty"`
+	Id          int64      `json:"id"`
+	Name        string     `json:"name"`
+}
+
+// BulkAwardResult defines model for BulkAwardResult.
+type BulkAwardResult struct {
+	Committed   bool           `json:"committed"`
+	Rows        []CoinAwardRow `json:"rows"`
+	TotalAmount int            `json:"total_amount"`
+	Valid       bool           `json:"valid"`
+}
+
+// BuyRequest defines model for BuyRequest.
+type BuyRequest struct {
+	PromoCode *string `json:"promo_code,omitempty"`
+}
+
+// BuyerStats defines model for BuyerStats.
+type BuyerStats struct {
+	CoinsSpent int  `json:"coins_spent"`
+	Units      int  `json:"units"`
+	User       User `json:"user"`
+}
+
 // CheckInRequest defines model for CheckInRequest.
 type CheckInRequest struct {
 	Coins     int    `json:"coins"`
@@ -72,6 +314,17 @@ type CheckInRequest struct {
 	UserId    int64  `json:"user_id"`
 }
 
+// CheckoutItem defines model for CheckoutItem.
+type CheckoutItem struct {
+	ItemId   int64 `json:"item_id"`
+	Quantity *int  `json:"quantity,omitempty"`
+}
+
+// CheckoutRequest defines model for CheckoutRequest.
+type CheckoutRequest struct {
+	Items []CheckoutItem `json:"items"`
+}
+
 // Club defines model for Club.
 type Club struct {
 	CreatedAt   *time.Time `json:"created_at,omitempty"`
@@ -92,11 +345,98 @@ type ClubCreateRequest struct {
 	Schedule    *string `json:"schedule,omitempty"`
 }
 
+// CoinAdjustmentRequest defines model for CoinAdjustmentRequest.
+type CoinAdjustmentRequest struct {
+	// Amount Positive to credit, negative to debit
+	Amount int    `json:"amount"`
+	Reason string `json:"reason"`
+	UserId int64  `json:"user_id"`
+}
+
+// CoinAwardRequest defines model for CoinAwardRequest.
+type CoinAwardRequest struct {
+	Amount    int                  `json:"amount"`
+	CreatedAt *time.Time           `json:"created_at,omitempty"`
+	Id        int64                `json:"id"`
+	Kind      CoinAwardRequestKind `json:"kind"`
+
+	// Reason Adjustment reason, or the event name for check-ins
+	Reason      string                 `json:"reason"`
+	RequestedBy int64                  `json:"requested_by"`
+	ReviewedAt  *time.Time             `json:"reviewed_at,omitempty"`
+	ReviewedBy  *int64                 `json:"reviewed_by,omitempty"`
+	Status      CoinAwardRequestStatus `json:"status"`
+	UserId      int64                  `json:"user_id"`
+}
+
+// CoinAwardRequestKind defines model for CoinAwardRequest.Kind.
+type CoinAwardRequestKind string
+
+// CoinAwardRequestStatus defines model for CoinAwardRequest.Status.
+type CoinAwardRequestStatus string
+
+// CoinAwardRow defines model for CoinAwardRow.
+type CoinAwardRow struct {
+	Amount     int     `json:"amount"`
+	Error      *string `json:"error,omitempty"`
+	Identifier string  `json:"identifier"`
+	Line       int     `json:"line"`
+	Reason     *string `json:"reason,omitempty"`
+	User       *User   `json:"user,omitempty"`
+}
+
+// CriterionScore defines model for CriterionScore.
+type CriterionScore struct {
+	CriterionId int64 `json:"criterion_id"`
+	Score       int   `json:"score"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
 
+// FormAnswer defines model for FormAnswer.
+type FormAnswer struct {
+	// Choices The picked options of a multi_choice field
+	Choices *[]string `json:"choices,omitempty"`
+	FieldId int64     `json:"field_id"`
+
+	// Value The answer; "true" or "false" for checkboxes
+	Value *string `json:"value,omitempty"`
+}
+
+// FormField defines model for FormField.
+type FormField struct {
+	// Choices Options of choice and multi_choice fields
+	Choices []string `json:"choices"`
+	Id      int64    `json:"id"`
+	Label   string   `json:"label"`
+
+	// Required A required checkbox must be ticked
+	Required bool          `json:"required"`
+	Type     FormFieldType `json:"type"`
+}
+
+// FormFieldInput defines model for FormFieldInput.
+type FormFieldInput struct {
+	Choices *[]string `json:"choices,omitempty"`
+
+	// Id Set to update an existing field and keep its answers
+	Id       *int64        `json:"id,omitempty"`
+	Label    string        `json:"label"`
+	Required *bool         `json:"required,omitempty"`
+	Type     FormFieldType `json:"type"`
+}
+
+// FormFieldType defines model for FormFieldType.
+type FormFieldType string
+
+// FormRequest defines model for FormRequest.
+type FormRequest struct {
+	Fields []FormFieldInput `json:"fields"`
+}
+
 // GovMember defines model for GovMember.
 type GovMember struct {
 	ContactUrl   *string    `json:"contact_url,omitempty"`
@@ -119,40 +459,132 @@ type GovMemberCreateRequest struct {
 
 // Hackathon defines model for Hackathon.
 type Hackathon struct {
-	CreatedAt   *time.Time      `json:"created_at,omitempty"`
-	Description string          `json:"description"`
-	EndDate     time.Time       `json:"end_date"`
-	Id          int64           `json:"id"`
-	StartDate   time.Time       `json:"start_date"`
-	Status      HackathonStatus `json:"status"`
-	Title       string          `json:"title"`
+	CoverImageUrl *string    `json:"cover_image_url,omitempty"`
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	Description   string     `json:"description"`
+	EndDate       time.Time  `json:"end_date"`
+	Id            int64      `json:"id"`
+	Location      *string    `json:"location,omitempty"`
+
+	// MaxParticipants Participant cap; omit for no limit
+	MaxParticipants *int `json:"max_participants,omitempty"`
+
+	// MaxTeamSize Omit for no limit
+	MaxTeamSize       *int    `json:"max_team_size,omitempty"`
+	OrganizerContacts *string `json:"organizer_contacts,omitempty"`
+
+	// Participants Pending and approved applications, counted against max_participants
+	Participants         *int            `json:"participants,omitempty"`
+	Prizes               *string         `json:"prizes,omitempty"`
+	RegistrationClosesAt *time.Time      `json:"registration_closes_at,omitempty"`
+	RegistrationOpensAt  *time.Time      `json:"registration_opens_at,omitempty"`
+	ResultsPublishedAt   *time.Time      `json:"results_published_at,omitempty"`
+	Rules                *string         `json:"rules,omitempty"`
+	StartDate            time.Time       `json:"start_date"`
+	Status               HackathonStatus `json:"status"`
+	StatusChangedAt      *time.Time      `json:"status_changed_at,omitempty"`
+
+	// SubmissionDeadline Project submissions lock at this time, or at end_date if unset
+	SubmissionDeadline *time.Time `json:"submission_deadline,omitempty"`
+	Title              string     `json:"title"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
 }
 
-// HackathonStatus defines model for Hackathon.Status.
-type HackathonStatus string
-
 // HackathonApplication defines model for HackathonApplication.
 type HackathonApplication struct {
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	HackathonId int64      `json:"hackathon_id"`
-	Id          int64      `json:"id"`
-	Status      string     `json:"status"`
-	TeamName    *string    `json:"team_name,omitempty"`
-	User        *User      `json:"user,omitempty"`
-	UserId      int64      `json:"user_id"`
+	Answers        *[]FormAnswer `json:"answers,omitempty"`
+	CreatedAt      *time.Time    `json:"created_at,omitempty"`
+	HackathonId    int64         `json:"hackathon_id"`
+	HackathonTitle *string       `json:"hackathon_title,omitempty"`
+	Id             int64         `json:"id"`
+
+	// Places Participant places taken, the team size for team applications
+	Places *int `json:"places,omitempty"`
+
+	// ReviewMessage Message from the reviewing admin, sent to the applicant
+	ReviewMessage *string    `json:"review_message,omitempty"`
+	ReviewedAt    *time.Time `json:"reviewed_at,omitempty"`
+	ReviewedBy    *int64     `json:"reviewed_by,omitempty"`
+
+	// Status pending, approved, rejected or waitlisted
+	Status   string  `json:"status"`
+	TeamId   *int64  `json:"team_id,omitempty"`
+	TeamName *string `json:"team_name,omitempty"`
+	User     *User   `json:"user,omitempty"`
+	UserId   int64   `json:"user_id"`
+
+	// WaitlistPosition 1-based place on the waitlist, set while waitlisted
+	WaitlistPosition *int `json:"waitlist_position,omitempty"`
 }
 
 // HackathonApplyRequest defines model for HackathonApplyRequest.
 type HackathonApplyRequest struct {
+	// Answers Answers to the hackathon's application form
+	Answers *[]FormAnswer `json:"answers,omitempty"`
+
+	// TeamId Apply for the whole team; only the captain can, and every member must be eligible
+	TeamId   *int64  `json:"team_id,omitempty"`
 	TeamName *string `json:"team_name,omitempty"`
 }
 
 // HackathonCreateRequest defines model for HackathonCreateRequest.
 type HackathonCreateRequest struct {
-	Description string    `json:"description"`
-	EndDate     time.Time `json:"end_date"`
-	StartDate   time.Time `json:"start_date"`
-	Title       string    `json:"title"`
+	CoverImageUrl *string   `json:"cover_image_url,omitempty"`
+	Description   string    `json:"description"`
+	EndDate       time.Time `json:"end_date"`
+	Location      *string   `json:"location,omitempty"`
+
+	// MaxParticipants Participant cap; omit for no limit. Applicants beyond it are waitlisted;
+	// raising it moves waitlisted applicants up to pending.
+	MaxParticipants *int `json:"max_participants,omitempty"`
+
+	// MaxTeamSize Omit for no limit
+	MaxTeamSize       *int    `json:"max_team_size,omitempty"`
+	OrganizerContacts *string `json:"organizer_contacts,omitempty"`
+	Prizes            *string `json:"prizes,omitempty"`
+
+	// RegistrationClosesAt Registration deadline; defaults to start_date
+	RegistrationClosesAt *time.Time `json:"registration_closes_at,omitempty"`
+
+	// RegistrationOpensAt A draft opens for registration automatically at this time
+	RegistrationOpensAt *time.Time `json:"registration_opens_at,omitempty"`
+	Rules               *string    `json:"rules,omitempty"`
+	StartDate           time.Time  `json:"start_date"`
+
+	// Status Defaults to draft if registration_opens_at is in the future, otherwise registration_open. Ignored on update.
+	Status *HackathonCreateRequestStatus `json:"status,omitempty"`
+
+	// SubmissionDeadline When project submissions lock, between start_date and end_date; defaults to end_date
+	SubmissionDeadline *time.Time `json:"submission_deadline,omitempty"`
+	Title              string     `json:"title"`
+}
+
+// HackathonCreateRequestStatus Defaults to draft if registration_opens_at is in the future, otherwise registration_open. Ignored on update.
+type HackathonCreateRequestStatus string
+
+// HackathonResult defines model for HackathonResult.
+type HackathonResult struct {
+	// Judges Judges who scored the project
+	Judges    int  `json:"judges"`
+	Placement *int `json:"placement,omitempty"`
+
+	// Prize Coins won by the team for its placement
+	Prize     *int  `json:"prize,omitempty"`
+	ProjectId int64 `json:"project_id"`
+
+	// Score 0-100, unset if no judge has scored the project
+	Score    *float64 `json:"score,omitempty"`
+	TeamId   int64    `json:"team_id"`
+	TeamName string   `json:"team_name"`
+	Title    string   `json:"title"`
+}
+
+// HackathonStatus defines model for HackathonStatus.
+type HackathonStatus string
+
+// HackathonStatusRequest defines model for HackathonStatusRequest.
+type HackathonStatusRequest struct {
+	Status HackathonStatus `json:"status"`
 }
 
 // HealthResponse defines model for HealthResponse.
@@ -160,16 +592,126 @@ type HealthResponse struct {
 	Status string `json:"status"`
 }
 
+// ItemSales defines model for ItemSales.
+type ItemSales struct {
+	CoinsSpent int    `json:"coins_spent"`
+	ItemId     int64  `json:"item_id"`
+	ItemName   string `json:"item_name"`
+	UnitsSold  int    `json:"units_sold"`
+}
+
+// Judge defines model for Judge.
+type Judge struct {
+	Assigned  int        `json:"assigned"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Scored    int        `json:"scored"`
+	User      *User      `json:"user,omitempty"`
+	UserId    int64      `json:"user_id"`
+}
+
+// JudgeAddRequest defines model for JudgeAddRequest.
+type JudgeAddRequest struct {
+	UserId int64 `json:"user_id"`
+}
+
+// JudgeAssignRequest defines model for JudgeAssignRequest.
+type JudgeAssignRequest struct {
+	JudgeId    int64   `json:"judge_id"`
+	ProjectIds []int64 `json:"project_ids"`
+}
+
+// JudgeAssignResult defines model for JudgeAssignResult.
+type JudgeAssignResult struct {
+	Assigned int `json:"assigned"`
+}
+
+// JudgeAssignment defines model for JudgeAssignment.
+type JudgeAssignment struct {
+	Comment   *string            `json:"comment,omitempty"`
+	JudgeId   int64              `json:"judge_id"`
+	Project   *ProjectSubmission `json:"project,omitempty"`
+	ProjectId int64              `json:"project_id"`
+	ScoredAt  *time.Time         `json:"scored_at,omitempty"`
+	Scores    []CriterionScore   `json:"scores"`
+}
+
+// JudgeAutoAssignRequest defines model for JudgeAutoAssignRequest.
+type JudgeAutoAssignRequest struct {
+	JudgesPerProject int `json:"judges_per_project"`
+}
+
+// JudgingCriteriaRequest defines model for JudgingCriteriaRequest.
+type JudgingCriteriaRequest struct {
+	Criteria []JudgingCriterionInput `json:"criteria"`
+}
+
+// JudgingCriterion defines model for JudgingCriterion.
+type JudgingCriterion struct {
+	Description *string `json:"description,omitempty"`
+	Id          int64   `json:"id"`
+	MaxScore    int     `json:"max_score"`
+	Name        string  `json:"name"`
+	Weight      int     `json:"weight"`
+}
+
+// JudgingCriterionInput defines model for JudgingCriterionInput.
+type JudgingCriterionInput struct {
+	Description *string `json:"description,omitempty"`
+
+	// MaxScore Defaults to 10
+	MaxScore *int   `json:"max_score,omitempty"`
+	Name     string `json:"name"`
+
+	// Weight 1-100, relative to the other criteria
+	Weight int `json:"weight"`
+}
+
 // LeaderboardEntry defines model for LeaderboardEntry.
 type LeaderboardEntry struct {
-	Coins       int     `json:"coins"`
-	FirstName   string  `json:"first_name"`
-	LastName    *string `json:"last_name,omitempty"`
-	PhotoUrl    *string `json:"photo_url,omitempty"`
-	Rank        int     `json:"rank"`
-	SchoolLevel *int    `json:"school_level,omitempty"`
-	UserId      int64   `json:"user_id"`
-	Username    *string `json:"username,omitempty"`
+	// Coins Current balance
+	Coins          int     `json:"coins"`
+	CommunityLevel *int    `json:"community_level,omitempty"`
+	FirstName      string  `json:"first_name"`
+	LastName       *string `json:"last_name,omitempty"`
+	League         *string `json:"league,omitempty"`
+	PhotoUrl       *string `json:"photo_url,omitempty"`
+	Rank           int     `json:"rank"`
+	SchoolLevel    *int    `json:"school_level,omitempty"`
+
+	// Score Value of the requested metric
+	Score    int64   `json:"score"`
+	UserId   int64   `json:"user_id"`
+	Username *string `json:"username,omitempty"`
+}
+
+// League defines model for League.
+type League struct {
+	// MaxLevel Absent for the top league
+	MaxLevel *int   `json:"max_level,omitempty"`
+	MinLevel int    `json:"min_level"`
+	Name     string `json:"name"`
+}
+
+// LeagueStandings defines model for LeagueStandings.
+type LeagueStandings struct {
+	Entries []LeaderboardEntry `json:"entries"`
+	League  League             `json:"league"`
+}
+
+// LedgerEntry defines model for LedgerEntry.
+type LedgerEntry struct {
+	// ActorId Admin who made the change
+	ActorId *int64 `json:"actor_id,omitempty"`
+
+	// Amount Positive for credits, negative for debits
+	Amount         int        `json:"amount"`
+	CounterpartyId *int64     `json:"counterparty_id,omitempty"`
+	CreatedAt      *time.Time `json:"created_at,omitempty"`
+	Id             int64      `json:"id"`
+
+	// Kind e.g. attendance, purchase, raffle_ticket, transfer_in, transfer_out
+	Kind string  `json:"kind"`
+	Note *string `json:"note,omitempty"`
 }
 
 // News defines model for News.
@@ -197,116 +739,814 @@ type NewsSummary struct {
 	Summary string `json:"summary"`
 }
 
-// Purchase defines model for Purchase.
-type Purchase struct {
+// Order defines model for Order.
+type Order struct {
 	CreatedAt  *time.Time `json:"created_at,omitempty"`
 	Id         int64      `json:"id"`
-	ItemId     int64      `json:"item_id"`
-	ItemName   *string    `json:"item_name,omitempty"`
-	PriceCoins *int       `json:"price_coins,omitempty"`
+	Items      []Purchase `json:"items"`
+	TotalCoins int        `json:"total_coins"`
 	UserId     int64      `json:"user_id"`
 }
 
-// SchoolAuthRequest defines model for SchoolAuthRequest.
-type SchoolAuthRequest struct {
-	Password string `json:"password"`
-	Username string `json:"username"`
+// PoolEntry defines model for PoolEntry.
+type PoolEntry struct {
+	// Applied Has an individual application, so is left out of team suggestions until it is withdrawn
+	Applied     *bool      `json:"applied,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	HackathonId int64      `json:"hackathon_id"`
+	Note        *string    `json:"note,omitempty"`
+
+	// Roles Preferred roles, e.g. backend or design
+	Roles  []string `json:"roles"`
+	Skills []string `json:"skills"`
+
+	// User What other students may see of a user
+	User   *PublicUser `json:"user,omitempty"`
+	UserId int64       `json:"user_id"`
 }
 
-// ShopItem defines model for ShopItem.
-type ShopItem struct {
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	Description *string    `json:"description,omitempty"`
-	Id          int64      `json:"id"`
-	ImageUrl    *string    `json:"image_url,omitempty"`
-	Name        string     `json:"name"`
-	PriceCoins  int        `json:"price_coins"`
-	Stock       *int       `json:"stock,omitempty"`
+// PoolEntryRequest defines model for PoolEntryRequest.
+type PoolEntryRequest struct {
+	Note   *string   `json:"note,omitempty"`
+	Roles  *[]string `json:"roles,omitempty"`
+	Skills *[]string `json:"skills,omitempty"`
 }
 
-// ShopItemCreateRequest defines model for ShopItemCreateRequest.
-type ShopItemCreateRequest struct {
-	Description *string `json:"description,omitempty"`
-	ImageUrl    *string `json:"image_url,omitempty"`
-	Name        string  `json:"name"`
-	PriceCoins  int     `json:"price_coins"`
-	Stock       *int    `json:"stock,omitempty"`
+// PoolFormRequest defines model for PoolFormRequest.
+type PoolFormRequest struct {
+	Size *int `json:"size,omitempty"`
 }
 
-// User defines model for User.
-type User struct {
-	AuditRatio  *float32   `json:"audit_ratio,omitempty"`
-	Coins       *int       `json:"coins,omitempty"`
-	CreatedAt   *time.Time `json:"created_at,omitempty"`
-	FirstName   *string    `json:"first_name,omitempty"`
-	Id          int64      `json:"id"`
-	LastName    *string    `json:"last_name,omitempty"`
-	PhotoUrl    *string    `json:"photo_url,omitempty"`
-	Role        UserRole   `json:"role"`
-	SchoolLevel *int       `json:"school_level,omitempty"`
-	SchoolLogin *string    `json:"school_login,omitempty"`
-	SchoolXp    *int64     `json:"school_xp,omitempty"`
-	TelegramId  int64      `json:"telegram_id"`
-	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
-	Username    *string    `json:"username,omitempty"`
+// PrizePayout defines model for PrizePayout.
+type PrizePayout struct {
+	Coins     int        `json:"coins"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Placement int        `json:"placement"`
+	TeamId    int64      `json:"team_id"`
+	User      *User      `json:"user,omitempty"`
+	UserId    int64      `json:"user_id"`
 }
 
-// UserRole defines model for User.Role.
-type UserRole string
+// PrizeTier defines model for PrizeTier.
+type PrizeTier struct {
+	// Coins Prize for the whole team
+	Coins     int `json:"coins"`
+	Placement int `json:"placement"`
+}
 
-// ListHackathonsParams defines parameters for ListHackathons.
-type ListHackathonsParams struct {
-	Status *ListHackathonsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+// PrizeTiersRequest defines model for PrizeTiersRequest.
+type PrizeTiersRequest struct {
+	Prizes []PrizeTier `json:"prizes"`
 }
 
-// ListHackathonsParamsStatus defines parameters for ListHackathons.
-type ListHackathonsParamsStatus string
+// ProjectScoreRequest defines model for ProjectScoreRequest.
+type ProjectScoreRequest struct {
+	Comment *string          `json:"comment,omitempty"`
+	Scores  []CriterionScore `json:"scores"`
+}
 
-// ListNewsParams defines parameters for ListNews.
-type ListNewsParams struct {
-	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+// ProjectSubmission defines model for ProjectSubmission.
+type ProjectSubmission struct {
+	// Attachments Links to slides, videos and other material
+	Attachments []string  `json:"attachments"`
+	CreatedAt   time.Time `json:"created_at"`
+	DemoUrl     *string   `json:"demo_url,omitempty"`
+	Description *string   `json:"description,omitempty"`
+
+	// FinalScore Set when results are published
+	FinalScore  *float64 `json:"final_score,omitempty"`
+	HackathonId int64    `json:"hackathon_id"`
+	Id          int64    `json:"id"`
+
+	// Members Included in organiser listings
+	Members       *[]TeamMember `json:"members,omitempty"`
+	Placement     *int          `json:"placement,omitempty"`
+	RepositoryUrl *string       `json:"repository_url,omitempty"`
+	TeamId        int64         `json:"team_id"`
+	TeamName      string        `json:"team_name"`
+	Title         string        `json:"title"`
+	UpdatedAt     time.Time     `json:"updated_at"`
+	UpdatedBy     *int64        `json:"updated_by,omitempty"`
 }
 
-// AttendanceCheckInJSONRequestBody defines body for AttendanceCheckIn for application/json ContentType.
-type AttendanceCheckInJSONRequestBody = CheckInRequest
+// ProjectSubmissionRequest defines model for ProjectSubmissionRequest.
+type ProjectSubmissionRequest struct {
+	// Attachments Up to 5 http(s) links
+	Attachments   *[]string `json:"attachments,omitempty"`
+	DemoUrl       *string   `json:"demo_url,omitempty"`
+	Description   *string   `json:"description,omitempty"`
+	RepositoryUrl *string   `json:"repository_url,omitempty"`
+	Title         string    `json:"title"`
+}
 
-// AuthAdminJSONRequestBody defines body for AuthAdmin for application/json ContentType.
-type AuthAdminJSONRequestBody = AdminAuthRequest
+// PromoCode defines model for PromoCode.
+type PromoCode struct {
+	Code         string                `json:"code"`
+	CreatedAt    *time.Time            `json:"created_at,omitempty"`
+	DiscountType PromoCodeDiscountType `json:"discount_type"`
+
+	// DiscountValue Percent off, or coins off for fixed discounts
+	DiscountValue int        `json:"discount_value"`
+	ExpiresAt     *time.Time `json:"expires_at,omitempty"`
+	Id            int64      `json:"id"`
+
+	// ItemId Restricts the code to a single item
+	ItemId         *int64 `json:"item_id,omitempty"`
+	MaxUses        *int   `json:"max_uses,omitempty"`
+	MaxUsesPerUser *int   `json:"max_uses_per_user,omitempty"`
+	UsedCount      int    `json:"used_count"`
+}
 
-// AuthSchoolJSONRequestBody defines body for AuthSchool for application/json ContentType.
-type AuthSchoolJSONRequestBody = SchoolAuthRequest
+// PromoCodeDiscountType defines model for PromoCode.DiscountType.
+type PromoCodeDiscountType string
+
+// PromoCodeCreateRequest defines model for PromoCodeCreateRequest.
+type PromoCodeCreateRequest struct {
+	Code           string                             `json:"code"`
+	DiscountType   PromoCodeCreateRequestDiscountType `json:"discount_type"`
+	DiscountValue  int                                `json:"discount_value"`
+	ExpiresAt      *time.Time                         `json:"expires_at,omitempty"`
+	ItemId         *int64                             `json:"item_id,omitempty"`
+	MaxUses        *int                               `json:"max_uses,omitempty"`
+	MaxUsesPerUser *int                               `json:"max_uses_per_user,omitempty"`
+}
 
-// AuthTelegramJSONRequestBody defines body for AuthTelegram for application/json ContentType.
-type AuthTelegramJSONRequestBody = AuthRequest
+// PromoCodeCreateRequestDiscountType defines model for PromoCodeCreateRequest.DiscountType.
+type PromoCodeCreateRequestDiscountType string
 
-// CreateClubJSONRequestBody defines body for CreateClub for application/json ContentType.
-type CreateClubJSONRequestBody = ClubCreateRequest
+// PublicUser What other students may see of a user
+type PublicUser struct {
+	FirstName   *string `json:"first_name,omitempty"`
+	Id          int64   `json:"id"`
+	LastName    *string `json:"last_name,omitempty"`
+	PhotoUrl    *string `json:"photo_url,omitempty"`
+	SchoolLevel *int    `json:"school_level,omitempty"`
+	Username    *string `json:"username,omitempty"`
+}
 
-// CreateGovMemberJSONRequestBody defines body for CreateGovMember for application/json ContentType.
-type CreateGovMemberJSONRequestBody = GovMemberCreateRequest
+// Purchase defines model for Purchase.
+type Purchase struct {
+	CreatedAt     *time.Time `json:"created_at,omitempty"`
+	DiscountCoins *int       `json:"discount_coins,omitempty"`
+	Id            int64      `json:"id"`
+	ItemId        int64      `json:"item_id"`
+	ItemName      *string    `json:"item_name,omitempty"`
+	OrderId       *int64     `json:"order_id,omitempty"`
+
+	// PriceCoins Coins paid for the whole line
+	PriceCoins  *int   `json:"price_coins,omitempty"`
+	PromoCodeId *int64 `json:"promo_code_id,omitempty"`
+	Quantity    *int   `json:"quantity,omitempty"`
+	User        *User  `json:"user,omitempty"`
+	UserId      int64  `json:"user_id"`
+}
 
-// CreateHackathonJSONRequestBody defines body for CreateHackathon for application/json ContentType.
-type CreateHackathonJSONRequestBody = HackathonCreateRequest
+// Quest defines model for Quest.
+type Quest struct {
+	Active      bool       `json:"active"`
+	Completions int        `json:"completions"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	Deadline    *time.Time `json:"deadline,omitempty"`
+	Description *string    `json:"description,omitempty"`
+	Id          int64      `json:"id"`
 
-// ApplyToHackathonJSONRequestBody defines body for ApplyToHackathon for application/json ContentType.
-type ApplyToHackathonJSONRequestBody = HackathonApplyRequest
+	// MaxCompletions Approved submissions allowed in total; unlimited if absent
+	MaxCompletions *int `json:"max_completions,omitempty"`
 
-// CreateNewsJSONRequestBody defines body for CreateNews for application/json ContentType.
-type CreateNewsJSONRequestBody = NewsCreateRequest
+	// MyStatus Status of the caller's latest submission
+	MyStatus *QuestMyStatus `json:"my_status,omitempty"`
 
-// UpdateNewsJSONRequestBody defines body for UpdateNews for application/json ContentType.
-type UpdateNewsJSONRequestBody = NewsCreateRequest
+	// Open Whether the quest still takes submissions
+	Open        bool   `json:"open"`
+	RewardCoins int    `json:"reward_coins"`
+	Title       string `json:"title"`
+}
 
-// CreateShopItemJSONRequestBody defines body for CreateShopItem for application/json ContentType.
-type CreateShopItemJSONRequestBody = ShopItemCreateRequest
+// QuestMyStatus Status of the caller's latest submission
+type QuestMyStatus string
+
+// QuestRequest defines model for QuestRequest.
+type QuestRequest struct {
+	Active         *bool      `json:"active,omitempty"`
+	Deadline       *time.Time `json:"deadline,omitempty"`
+	Description    *string    `json:"description,omitempty"`
+	MaxCompletions *int       `json:"max_completions,omitempty"`
+	RewardCoins    int        `json:"reward_coins"`
+	Title          string     `json:"title"`
+}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Check in a student via QR (admin only)
-	// (POST /api/attendance/check-in)
-	AttendanceCheckIn(w http.ResponseWriter, r *http.Request)
-	// Get current user attendance history
-	// (GET /api/attendance/history)
+// QuestReviewRequest defines model for QuestReviewRequest.
+type QuestReviewRequest struct {
+	// Note Shown to the student
+	Note *string `json:"note,omitempty"`
+}
+
+// QuestSubmission defines model for QuestSubmission.
+type QuestSubmission struct {
+	CreatedAt  *time.Time            `json:"created_at,omitempty"`
+	Id         int64                 `json:"id"`
+	ProofText  *string               `json:"proof_text,omitempty"`
+	ProofUrl   *string               `json:"proof_url,omitempty"`
+	QuestId    int64                 `json:"quest_id"`
+	QuestTitle string                `json:"quest_title"`
+	ReviewNote *string               `json:"review_note,omitempty"`
+	ReviewedAt *time.Time            `json:"reviewed_at,omitempty"`
+	ReviewedBy *int64                `json:"reviewed_by,omitempty"`
+	Status     QuestSubmissionStatus `json:"status"`
+	UserId     int64                 `json:"user_id"`
+}
+
+// QuestSubmissionStatus defines model for QuestSubmission.Status.
+type QuestSubmissionStatus string
+
+// QuestSubmissionRequest At least one of proof_text and proof_url is required
+type QuestSubmissionRequest struct {
+	ProofText *string `json:"proof_text,omitempty"`
+	ProofUrl  *string `json:"proof_url,omitempty"`
+}
+
+// Raffle defines model for Raffle.
+type Raffle struct {
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	Description       *string    `json:"description,omitempty"`
+	DrawnAt           *time.Time `json:"drawn_at,omitempty"`
+	Id                int64      `json:"id"`
+	ImageUrl          *string    `json:"image_url,omitempty"`
+	MaxTicketsPerUser *int       `json:"max_tickets_per_user,omitempty"`
+	MyTickets         *int       `json:"my_tickets,omitempty"`
+	Prize             *string    `json:"prize,omitempty"`
+
+	// Seed Revealed once the raffle is drawn
+	Seed *string `json:"seed,omitempty"`
+
+	// SeedHash Hex SHA-256 of the seed, published on creation
+	SeedHash         string       `json:"seed_hash"`
+	Status           RaffleStatus `json:"status"`
+	TicketPriceCoins int          `json:"ticket_price_coins"`
+	TicketsSold      int          `json:"tickets_sold"`
+	Title            string       `json:"title"`
+	Winner           *User        `json:"winner,omitempty"`
+	WinningTicket    *int         `json:"winning_ticket,omitempty"`
+}
+
+// RaffleStatus defines model for Raffle.Status.
+type RaffleStatus string
+
+// RaffleCreateRequest defines model for RaffleCreateRequest.
+type RaffleCreateRequest struct {
+	Description       *string `json:"description,omitempty"`
+	ImageUrl          *string `json:"image_url,omitempty"`
+	MaxTicketsPerUser *int    `json:"max_tickets_per_user,omitempty"`
+	Prize             *string `json:"prize,omitempty"`
+	TicketPriceCoins  int     `json:"ticket_price_coins"`
+	Title             string  `json:"title"`
+}
+
+// RaffleTicket defines model for RaffleTicket.
+type RaffleTicket struct {
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	Id           int64      `json:"id"`
+	RaffleId     int64      `json:"raffle_id"`
+	TicketNumber int        `json:"ticket_number"`
+	UserId       int64      `json:"user_id"`
+}
+
+// RaffleTicketRequest defines model for RaffleTicketRequest.
+type RaffleTicketRequest struct {
+	Quantity *int `json:"quantity,omitempty"`
+}
+
+// Referral defines model for Referral.
+type Referral struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	FirstName *string    `json:"first_name,omitempty"`
+	Id        int64      `json:"id"`
+	LastName  *string    `json:"last_name,omitempty"`
+
+	// Note Why the referral was rejected
+	Note        *string    `json:"note,omitempty"`
+	PhotoUrl    *string    `json:"photo_url,omitempty"`
+	RefereeId   int64      `json:"referee_id"`
+	RewardCoins int        `json:"reward_coins"`
+	RewardedAt  *time.Time `json:"rewarded_at,omitempty"`
+
+	// Status Pending until the referred user verifies as a student
+	Status   ReferralStatus `json:"status"`
+	Username *string        `json:"username,omitempty"`
+}
+
+// ReferralStatus Pending until the referred user verifies as a student
+type ReferralStatus string
+
+// ReferralSummary defines model for ReferralSummary.
+type ReferralSummary struct {
+	Code string `json:"code"`
+
+	// Link Deep link with startapp set; absent if MINI_APP_URL is not configured
+	Link      *string    `json:"link,omitempty"`
+	Referrals []Referral `json:"referrals"`
+
+	// RewardCoins Coins each side gets when a referral is rewarded
+	RewardCoins int `json:"reward_coins"`
+
+	// StartParam Mini App start parameter, ref_<code>
+	StartParam string `json:"start_param"`
+}
+
+// SalesReport defines model for SalesReport.
+type SalesReport struct {
+	Items      []ItemSales  `json:"items"`
+	TopBuyers  []BuyerStats `json:"top_buyers"`
+	TotalCoins int          `json:"total_coins"`
+	TotalUnits int          `json:"total_units"`
+}
+
+// SchoolAuthRequest defines model for SchoolAuthRequest.
+type SchoolAuthRequest struct {
+	Password string `json:"password"`
+	Username string `json:"username"`
+}
+
+// Season defines model for Season.
+type Season struct {
+	// CarryOverCoins When false, balances are reset to zero at close
+	CarryOverCoins bool         `json:"carry_over_coins"`
+	ClosedAt       *time.Time   `json:"closed_at,omitempty"`
+	CreatedAt      *time.Time   `json:"created_at,omitempty"`
+	EndsAt         time.Time    `json:"ends_at"`
+	Id             int64        `json:"id"`
+	Name           string       `json:"name"`
+	StartsAt       time.Time    `json:"starts_at"`
+	Status         SeasonStatus `json:"status"`
+}
+
+// SeasonStatus defines model for Season.Status.
+type SeasonStatus string
+
+// SeasonRequest defines model for SeasonRequest.
+type SeasonRequest struct {
+	CarryOverCoins *bool     `json:"carry_over_coins,omitempty"`
+	EndsAt         time.Time `json:"ends_at"`
+	Name           string    `json:"name"`
+	StartsAt       time.Time `json:"starts_at"`
+}
+
+// SeasonStandings defines model for SeasonStandings.
+type SeasonStandings struct {
+	Season    Season             `json:"season"`
+	Standings []LeaderboardEntry `json:"standings"`
+}
+
+// ShopItem defines model for ShopItem.
+type ShopItem struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CurrentPriceCoins Price right now, including an active sale
+	CurrentPriceCoins *int       `json:"current_price_coins,omitempty"`
+	Description       *string    `json:"description,omitempty"`
+	Id                int64      `json:"id"`
+	ImageUrl          *string    `json:"image_url,omitempty"`
+	Name              string     `json:"name"`
+	PriceCoins        int        `json:"price_coins"`
+	SaleEndsAt        *time.Time `json:"sale_ends_at,omitempty"`
+	SalePriceCoins    *int       `json:"sale_price_coins,omitempty"`
+	SaleStartsAt      *time.Time `json:"sale_starts_at,omitempty"`
+	Stock             *int       `json:"stock,omitempty"`
+}
+
+// ShopItemCreateRequest defines model for ShopItemCreateRequest.
+type ShopItemCreateRequest struct {
+	Description *string `json:"description,omitempty"`
+	ImageUrl    *string `json:"image_url,omitempty"`
+	Name        string  `json:"name"`
+	PriceCoins  int     `json:"price_coins"`
+	Stock       *int    `json:"stock,omitempty"`
+}
+
+// ShopItemSaleRequest defines model for ShopItemSaleRequest.
+type ShopItemSaleRequest struct {
+	EndsAt         *time.Time `json:"ends_at,omitempty"`
+	SalePriceCoins int        `json:"sale_price_coins"`
+	StartsAt       *time.Time `json:"starts_at,omitempty"`
+}
+
+// Streak Consecutive campus weeks with at least one check-in
+type Streak struct {
+	ActiveThisWeek bool `json:"active_this_week"`
+	BestWeeks      int  `json:"best_weeks"`
+	CurrentWeeks   int  `json:"current_weeks"`
+
+	// NextMultiplier Coin multiplier the next check-in would get
+	NextMultiplier float64 `json:"next_multiplier"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	ApplicationId     *int64     `json:"application_id,omitempty"`
+	ApplicationStatus *string    `json:"application_status,omitempty"`
+	CaptainId         int64      `json:"captain_id"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	HackathonId       int64      `json:"hackathon_id"`
+	HackathonTitle    *string    `json:"hackathon_title,omitempty"`
+	Id                int64      `json:"id"`
+
+	// InviteCode Shown to members and admins only
+	InviteCode *string `json:"invite_code,omitempty"`
+
+	// InviteLink Mini app deep link that joins the team
+	InviteLink *string      `json:"invite_link,omitempty"`
+	MaxSize    int          `json:"max_size"`
+	Members    []TeamMember `json:"members"`
+	Name       string       `json:"name"`
+}
+
+// TeamCaptainRequest defines model for TeamCaptainRequest.
+type TeamCaptainRequest struct {
+	UserId int64 `json:"user_id"`
+}
+
+// TeamCreateRequest defines model for TeamCreateRequest.
+type TeamCreateRequest struct {
+	MaxSize *int   `json:"max_size,omitempty"`
+	Name    string `json:"name"`
+}
+
+// TeamJoinRequest defines model for TeamJoinRequest.
+type TeamJoinRequest struct {
+	Code string `json:"code"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	JoinedAt time.Time `json:"joined_at"`
+
+	// User What other students may see of a user
+	User   *PublicUser `json:"user,omitempty"`
+	UserId int64       `json:"user_id"`
+}
+
+// TeamSuggestion defines model for TeamSuggestion.
+type TeamSuggestion struct {
+	AverageLevel float64     `json:"average_level"`
+	Members      []PoolEntry `json:"members"`
+	Roles        []string    `json:"roles"`
+	Skills       []string    `json:"skills"`
+}
+
+// Transfer defines model for Transfer.
+type Transfer struct {
+	Amount int `json:"amount"`
+
+	// Balance Sender balance after the transfer
+	Balance            int        `json:"balance"`
+	CreatedAt          *time.Time `json:"created_at,omitempty"`
+	Note               *string    `json:"note,omitempty"`
+	RecipientFirstName *string    `json:"recipient_first_name,omitempty"`
+	RecipientId        int64      `json:"recipient_id"`
+	RecipientUsername  *string    `json:"recipient_username,omitempty"`
+}
+
+// TransferRequest Identify the recipient by recipient_id or recipient_username.
+type TransferRequest struct {
+	Amount            int     `json:"amount"`
+	Note              *string `json:"note,omitempty"`
+	RecipientId       *int64  `json:"recipient_id,omitempty"`
+	RecipientUsername *string `json:"recipient_username,omitempty"`
+}
+
+// User defines model for User.
+type User struct {
+	AuditRatio *float32 `json:"audit_ratio,omitempty"`
+
+	// Badges Only returned by /api/users/me
+	Badges         *[]Badge `json:"badges,omitempty"`
+	Coins          *int     `json:"coins,omitempty"`
+	CommunityLevel *int     `json:"community_level,omitempty"`
+
+	// CommunityXp Non-spendable XP from attendance, quests, hackathons and clubs
+	CommunityXp *int64     `json:"community_xp,omitempty"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	FirstName   *string    `json:"first_name,omitempty"`
+	Id          int64      `json:"id"`
+	LastName    *string    `json:"last_name,omitempty"`
+
+	// NextLevelXp Community XP needed for the next level; only returned by /api/users/me, absent at the top level
+	NextLevelXp *int64   `json:"next_level_xp,omitempty"`
+	PhotoUrl    *string  `json:"photo_url,omitempty"`
+	Role        UserRole `json:"role"`
+	SchoolLevel *int     `json:"school_level,omitempty"`
+	SchoolLogin *string  `json:"school_login,omitempty"`
+	SchoolXp    *int64   `json:"school_xp,omitempty"`
+
+	// Streak Consecutive campus weeks with at least one check-in
+	Streak     *Streak    `json:"streak,omitempty"`
+	TelegramId int64      `json:"telegram_id"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+	Username   *string    `json:"username,omitempty"`
+}
+
+// UserRole defines model for User.Role.
+type UserRole string
+
+// UserProfile defines model for UserProfile.
+type UserProfile struct {
+	Badges         []Badge         `json:"badges"`
+	CommunityLevel int             `json:"community_level"`
+	CommunityXp    int64           `json:"community_xp"`
+	FirstName      *string         `json:"first_name,omitempty"`
+	Id             int64           `json:"id"`
+	LastName       *string         `json:"last_name,omitempty"`
+	PhotoUrl       *string         `json:"photo_url,omitempty"`
+	Role           UserProfileRole `json:"role"`
+	SchoolLevel    *int            `json:"school_level,omitempty"`
+
+	// Streak Consecutive campus weeks with at least one check-in
+	Streak   *Streak `json:"streak,omitempty"`
+	Username *string `json:"username,omitempty"`
+}
+
+// UserProfileRole defines model for UserProfile.Role.
+type UserProfileRole string
+
+// ListAdminAlertsParams defines parameters for ListAdminAlerts.
+type ListAdminAlertsParams struct {
+	IncludeResolved *bool `form:"include_resolved,omitempty" json:"include_resolved,omitempty"`
+	Limit           *int  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// BulkAwardCoinsParams defines parameters for BulkAwardCoins.
+type BulkAwardCoinsParams struct {
+	Commit *bool `form:"commit,omitempty" json:"commit,omitempty"`
+}
+
+// ListCoinAwardRequestsParams defines parameters for ListCoinAwardRequests.
+type ListCoinAwardRequestsParams struct {
+	Status *ListCoinAwardRequestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListCoinAwardRequestsParamsStatus defines parameters for ListCoinAwardRequests.
+type ListCoinAwardRequestsParamsStatus string
+
+// CoinHistoryParams defines parameters for CoinHistory.
+type CoinHistoryParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListHackathonsParams defines parameters for ListHackathons.
+type ListHackathonsParams struct {
+	Status *ListHackathonsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListHackathonsParamsStatus defines parameters for ListHackathons.
+type ListHackathonsParamsStatus string
+
+// SuggestPoolTeamsParams defines parameters for SuggestPoolTeams.
+type SuggestPoolTeamsParams struct {
+	// Size Team size, defaults to the hackathon's max_team_size or 4
+	Size *int `form:"size,omitempty" json:"size,omitempty"`
+}
+
+// GetLeaderboardParams defines parameters for GetLeaderboard.
+type GetLeaderboardParams struct {
+	// Period week and month are the last 7 and 30 days; season is the running season
+	Period *GetLeaderboardParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+	Metric *GetLeaderboardParamsMetric `form:"metric,omitempty" json:"metric,omitempty"`
+
+	// League Rank only users in this league (see /api/leaderboard/leagues)
+	League *string `form:"league,omitempty" json:"league,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// GetLeaderboardParamsPeriod defines parameters for GetLeaderboard.
+type GetLeaderboardParamsPeriod string
+
+// GetLeaderboardParamsMetric defines parameters for GetLeaderboard.
+type GetLeaderboardParamsMetric string
+
+// GetLeagueLeaderboardsParams defines parameters for GetLeagueLeaderboards.
+type GetLeagueLeaderboardsParams struct {
+	Period *GetLeagueLeaderboardsParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+	Metric *GetLeagueLeaderboardsParamsMetric `form:"metric,omitempty" json:"metric,omitempty"`
+
+	// Limit Entries per league
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetLeagueLeaderboardsParamsPeriod defines parameters for GetLeagueLeaderboards.
+type GetLeagueLeaderboardsParamsPeriod string
+
+// GetLeagueLeaderboardsParamsMetric defines parameters for GetLeagueLeaderboards.
+type GetLeagueLeaderboardsParamsMetric string
+
+// GetMyLeaderboardEntryParams defines parameters for GetMyLeaderboardEntry.
+type GetMyLeaderboardEntryParams struct {
+	Period *GetMyLeaderboardEntryParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+	Metric *GetMyLeaderboardEntryParamsMetric `form:"metric,omitempty" json:"metric,omitempty"`
+	League *string                            `form:"league,omitempty" json:"league,omitempty"`
+}
+
+// GetMyLeaderboardEntryParamsPeriod defines parameters for GetMyLeaderboardEntry.
+type GetMyLeaderboardEntryParamsPeriod string
+
+// GetMyLeaderboardEntryParamsMetric defines parameters for GetMyLeaderboardEntry.
+type GetMyLeaderboardEntryParamsMetric string
+
+// GetSeasonParams defines parameters for GetSeason.
+type GetSeasonParams struct {
+	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+}
+
+// ListNewsParams defines parameters for ListNews.
+type ListNewsParams struct {
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty"`
+}
+
+// ListQuestsParams defines parameters for ListQuests.
+type ListQuestsParams struct {
+	// IncludeClosed Also list inactive and expired quests
+	IncludeClosed *bool `form:"include_closed,omitempty" json:"include_closed,omitempty"`
+}
+
+// ListQuestSubmissionsParams defines parameters for ListQuestSubmissions.
+type ListQuestSubmissionsParams struct {
+	Status  *ListQuestSubmissionsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	QuestId *int64                            `form:"quest_id,omitempty" json:"quest_id,omitempty"`
+}
+
+// ListQuestSubmissionsParamsStatus defines parameters for ListQuestSubmissions.
+type ListQuestSubmissionsParamsStatus string
+
+// ListPurchasesParams defines parameters for ListPurchases.
+type ListPurchasesParams struct {
+	ItemId *int64 `form:"item_id,omitempty" json:"item_id,omitempty"`
+	UserId *int64 `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// From Include purchases made at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Include purchases made before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetSalesReportParams defines parameters for GetSalesReport.
+type GetSalesReportParams struct {
+	ItemId *int64 `form:"item_id,omitempty" json:"item_id,omitempty"`
+	UserId *int64 `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// From Include purchases made at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Include purchases made before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Top Number of top buyers to return
+	Top *int `form:"top,omitempty" json:"top,omitempty"`
+}
+
+// AttendanceCheckInJSONRequestBody defines body for AttendanceCheckIn for application/json ContentType.
+type AttendanceCheckInJSONRequestBody = CheckInRequest
+
+// AuthAdminJSONRequestBody defines body for AuthAdmin for application/json ContentType.
+type AuthAdminJSONRequestBody = AdminAuthRequest
+
+// AuthSchoolJSONRequestBody defines body for AuthSchool for application/json ContentType.
+type AuthSchoolJSONRequestBody = SchoolAuthRequest
+
+// AuthTelegramJSONRequestBody defines body for AuthTelegram for application/json ContentType.
+type AuthTelegramJSONRequestBody = AuthRequest
+
+// CreateClubJSONRequestBody defines body for CreateClub for application/json ContentType.
+type CreateClubJSONRequestBody = ClubCreateRequest
+
+// AdjustCoinsJSONRequestBody defines body for AdjustCoins for application/json ContentType.
+type AdjustCoinsJSONRequestBody = CoinAdjustmentRequest
+
+// TransferCoinsJSONRequestBody defines body for TransferCoins for application/json ContentType.
+type TransferCoinsJSONRequestBody = TransferRequest
+
+// CreateGovMemberJSONRequestBody defines body for CreateGovMember for application/json ContentType.
+type CreateGovMemberJSONRequestBody = GovMemberCreateRequest
+
+// CreateHackathonJSONRequestBody defines body for CreateHackathon for application/json ContentType.
+type CreateHackathonJSONRequestBody = HackathonCreateRequest
+
+// UpdateHackathonJSONRequestBody defines body for UpdateHackathon for application/json ContentType.
+type UpdateHackathonJSONRequestBody = HackathonCreateRequest
+
+// BulkReviewHackathonApplicationsJSONRequestBody defines body for BulkReviewHackathonApplications for application/json ContentType.
+type BulkReviewHackathonApplicationsJSONRequestBody = ApplicationBulkReviewRequest
+
+// ReviewHackathonApplicationJSONRequestBody defines body for ReviewHackathonApplication for application/json ContentType.
+type ReviewHackathonApplicationJSONRequestBody = ApplicationReviewRequest
+
+// ApplyToHackathonJSONRequestBody defines body for ApplyToHackathon for application/json ContentType.
+type ApplyToHackathonJSONRequestBody = HackathonApplyRequest
+
+// SetHackathonFormJSONRequestBody defines body for SetHackathonForm for application/json ContentType.
+type SetHackathonFormJSONRequestBody = FormRequest
+
+// AddHackathonJudgeJSONRequestBody defines body for AddHackathonJudge for application/json ContentType.
+type AddHackathonJudgeJSONRequestBody = JudgeAddRequest
+
+// AssignJudgeJSONRequestBody defines body for AssignJudge for application/json ContentType.
+type AssignJudgeJSONRequestBody = JudgeAssignRequest
+
+// AutoAssignJudgesJSONRequestBody defines body for AutoAssignJudges for application/json ContentType.
+type AutoAssignJudgesJSONRequestBody = JudgeAutoAssignRequest
+
+// SetJudgingCriteriaJSONRequestBody defines body for SetJudgingCriteria for application/json ContentType.
+type SetJudgingCriteriaJSONRequestBody = JudgingCriteriaRequest
+
+// ScoreProjectJSONRequestBody defines body for ScoreProject for application/json ContentType.
+type ScoreProjectJSONRequestBody = ProjectScoreRequest
+
+// EnterTeamPoolJSONRequestBody defines body for EnterTeamPool for application/json ContentType.
+type EnterTeamPoolJSONRequestBody = PoolEntryRequest
+
+// FormPoolTeamsJSONRequestBody defines body for FormPoolTeams for application/json ContentType.
+type FormPoolTeamsJSONRequestBody = PoolFormRequest
+
+// SetHackathonPrizesJSONRequestBody defines body for SetHackathonPrizes for application/json ContentType.
+type SetHackathonPrizesJSONRequestBody = PrizeTiersRequest
+
+// TransitionHackathonJSONRequestBody defines body for TransitionHackathon for application/json ContentType.
+type TransitionHackathonJSONRequestBody = HackathonStatusRequest
+
+// CreateTeamJSONRequestBody defines body for CreateTeam for application/json ContentType.
+type CreateTeamJSONRequestBody = TeamCreateRequest
+
+// CreateSeasonJSONRequestBody defines body for CreateSeason for application/json ContentType.
+type CreateSeasonJSONRequestBody = SeasonRequest
+
+// UpdateSeasonJSONRequestBody defines body for UpdateSeason for application/json ContentType.
+type UpdateSeasonJSONRequestBody = SeasonRequest
+
+// CreateNewsJSONRequestBody defines body for CreateNews for application/json ContentType.
+type CreateNewsJSONRequestBody = NewsCreateRequest
+
+// UpdateNewsJSONRequestBody defines body for UpdateNews for application/json ContentType.
+type UpdateNewsJSONRequestBody = NewsCreateRequest
+
+// CreatePromoCodeJSONRequestBody defines body for CreatePromoCode for application/json ContentType.
+type CreatePromoCodeJSONRequestBody = PromoCodeCreateRequest
+
+// CreateQuestJSONRequestBody defines body for CreateQuest for application/json ContentType.
+type CreateQuestJSONRequestBody = QuestRequest
+
+// ApproveQuestSubmissionJSONRequestBody defines body for ApproveQuestSubmission for application/json ContentType.
+type ApproveQuestSubmissionJSONRequestBody = QuestReviewRequest
+
+// RejectQuestSubmissionJSONRequestBody defines body for RejectQuestSubmission for application/json ContentType.
+type RejectQuestSubmissionJSONRequestBody = QuestReviewRequest
+
+// UpdateQuestJSONRequestBody defines body for UpdateQuest for application/json ContentType.
+type UpdateQuestJSONRequestBody = QuestRequest
+
+// SubmitQuestJSONRequestBody defines body for SubmitQuest for application/json ContentType.
+type SubmitQuestJSONRequestBody = QuestSubmissionRequest
+
+// CreateRaffleJSONRequestBody defines body for CreateRaffle for application/json ContentType.
+type CreateRaffleJSONRequestBody = RaffleCreateRequest
+
+// BuyRaffleTicketsJSONRequestBody defines body for BuyRaffleTickets for application/json ContentType.
+type BuyRaffleTicketsJSONRequestBody = RaffleTicketRequest
+
+// CreateShopItemJSONRequestBody defines body for CreateShopItem for application/json ContentType.
+type CreateShopItemJSONRequestBody = ShopItemCreateRequest
+
+// CheckoutShopJSONRequestBody defines body for CheckoutShop for application/json ContentType.
+type CheckoutShopJSONRequestBody = CheckoutRequest
+
+// BuyShopItemJSONRequestBody defines body for BuyShopItem for application/json ContentType.
+type BuyShopItemJSONRequestBody = BuyRequest
+
+// SetShopItemSaleJSONRequestBody defines body for SetShopItemSale for application/json ContentType.
+type SetShopItemSaleJSONRequestBody = ShopItemSaleRequest
+
+// JoinTeamJSONRequestBody defines body for JoinTeam for application/json ContentType.
+type JoinTeamJSONRequestBody = TeamJoinRequest
+
+// TransferTeamCaptainJSONRequestBody defines body for TransferTeamCaptain for application/json ContentType.
+type TransferTeamCaptainJSONRequestBody = TeamCaptainRequest
+
+// SaveTeamSubmissionJSONRequestBody defines body for SaveTeamSubmission for application/json ContentType.
+type SaveTeamSubmissionJSONRequestBody = ProjectSubmissionRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Anomalies flagged by the background scanner (admin only)
+	// (GET /api/admin/alerts)
+	ListAdminAlerts(w http.ResponseWriter, r *http.Request, params ListAdminAlertsParams)
+	// Mark an alert as handled (admin only)
+	// (POST /api/admin/alerts/{id}/resolve)
+	ResolveAdminAlert(w http.ResponseWriter, r *http.Request, id int64)
+	// Check in a student via QR (admin only)
+	// (POST /api/attendance/check-in)
+	AttendanceCheckIn(w http.ResponseWriter, r *http.Request)
+	// Get current user attendance history
+	// (GET /api/attendance/history)
 	AttendanceHistory(w http.ResponseWriter, r *http.Request)
 	// Authenticate as admin with credentials
 	// (POST /api/auth/admin)
@@ -335,6 +1575,27 @@ type ServerInterface interface {
 	// Leave a club
 	// (DELETE /api/clubs/{id}/leave)
 	LeaveClub(w http.ResponseWriter, r *http.Request, id int64)
+	// Credit or debit a user's coins (admin only)
+	// (POST /api/coins/adjustments)
+	AdjustCoins(w http.ResponseWriter, r *http.Request)
+	// Award coins to many users from a CSV (admin only)
+	// (POST /api/coins/adjustments/bulk)
+	BulkAwardCoins(w http.ResponseWriter, r *http.Request, params BulkAwardCoinsParams)
+	// List awards waiting for a second admin (admin only)
+	// (GET /api/coins/award-requests)
+	ListCoinAwardRequests(w http.ResponseWriter, r *http.Request, params ListCoinAwardRequestsParams)
+	// Approve and apply a pending award (admin only, not the requester)
+	// (POST /api/coins/award-requests/{id}/approve)
+	ApproveCoinAwardRequest(w http.ResponseWriter, r *http.Request, id int64)
+	// Reject a pending award (admin only, not the requester)
+	// (POST /api/coins/award-requests/{id}/reject)
+	RejectCoinAwardRequest(w http.ResponseWriter, r *http.Request, id int64)
+	// Get current user coin ledger
+	// (GET /api/coins/history)
+	CoinHistory(w http.ResponseWriter, r *http.Request, params CoinHistoryParams)
+	// Send coins to another user
+	// (POST /api/coins/transfer)
+	TransferCoins(w http.ResponseWriter, r *http.Request)
 	// List government members
 	// (GET /api/gov)
 	ListGovMembers(w http.ResponseWriter, r *http.Request)
@@ -350,24 +1611,147 @@ type ServerInterface interface {
 	// Create a hackathon (admin only)
 	// (POST /api/hackathons)
 	CreateHackathon(w http.ResponseWriter, r *http.Request)
+	// List the current user's hackathon applications and their statuses
+	// (GET /api/hackathons/applications/me)
+	ListMyHackathonApplications(w http.ResponseWriter, r *http.Request)
 	// Delete a hackathon (admin only)
 	// (DELETE /api/hackathons/{id})
 	DeleteHackathon(w http.ResponseWriter, r *http.Request, id int64)
 	// Get a single hackathon
 	// (GET /api/hackathons/{id})
 	GetHackathon(w http.ResponseWriter, r *http.Request, id int64)
+	// Update a hackathon (admin only)
+	// (PUT /api/hackathons/{id})
+	UpdateHackathon(w http.ResponseWriter, r *http.Request, id int64)
 	// List applications for a hackathon (admin only)
 	// (GET /api/hackathons/{id}/applications)
 	ListHackathonApplications(w http.ResponseWriter, r *http.Request, id int64)
+	// Export a hackathon's applications with form answers as CSV (admin only)
+	// (GET /api/hackathons/{id}/applications/export)
+	ExportHackathonApplications(w http.ResponseWriter, r *http.Request, id int64)
+	// Review several applications at once (admin only)
+	// (POST /api/hackathons/{id}/applications/review)
+	BulkReviewHackathonApplications(w http.ResponseWriter, r *http.Request, id int64)
+	// Approve, reject or waitlist an application (admin only)
+	// (POST /api/hackathons/{id}/applications/{applicationId}/review)
+	ReviewHackathonApplication(w http.ResponseWriter, r *http.Request, id int64, applicationId int64)
+	// Withdraw the current user's application
+	// (DELETE /api/hackathons/{id}/apply)
+	WithdrawFromHackathon(w http.ResponseWriter, r *http.Request, id int64)
 	// Apply to a hackathon
 	// (POST /api/hackathons/{id}/apply)
 	ApplyToHackathon(w http.ResponseWriter, r *http.Request, id int64)
+	// Get the hackathon's application form
+	// (GET /api/hackathons/{id}/form)
+	GetHackathonForm(w http.ResponseWriter, r *http.Request, id int64)
+	// Replace the hackathon's application form (admin only)
+	// (PUT /api/hackathons/{id}/form)
+	SetHackathonForm(w http.ResponseWriter, r *http.Request, id int64)
+	// List the hackathon's judges with their progress (admin only)
+	// (GET /api/hackathons/{id}/judges)
+	ListHackathonJudges(w http.ResponseWriter, r *http.Request, id int64)
+	// Make a user a judge for the hackathon (admin only)
+	// (POST /api/hackathons/{id}/judges)
+	AddHackathonJudge(w http.ResponseWriter, r *http.Request, id int64)
+	// Remove a judge and their scores (admin only)
+	// (DELETE /api/hackathons/{id}/judges/{userId})
+	RemoveHackathonJudge(w http.ResponseWriter, r *http.Request, id int64, userId int64)
+	// List all judge assignments with scores (admin only)
+	// (GET /api/hackathons/{id}/judging/assignments)
+	ListJudgeAssignments(w http.ResponseWriter, r *http.Request, id int64)
+	// Assign projects to a judge (admin only)
+	// (POST /api/hackathons/{id}/judging/assignments)
+	AssignJudge(w http.ResponseWriter, r *http.Request, id int64)
+	// Spread projects over the judges (admin only)
+	// (POST /api/hackathons/{id}/judging/assignments/auto)
+	AutoAssignJudges(w http.ResponseWriter, r *http.Request, id int64)
+	// List the projects the current user has to judge
+	// (GET /api/hackathons/{id}/judging/assignments/me)
+	ListMyJudgeAssignments(w http.ResponseWriter, r *http.Request, id int64)
+	// Get the hackathon's judging rubric
+	// (GET /api/hackathons/{id}/judging/criteria)
+	ListJudgingCriteria(w http.ResponseWriter, r *http.Request, id int64)
+	// Replace the judging rubric (admin only)
+	// (PUT /api/hackathons/{id}/judging/criteria)
+	SetJudgingCriteria(w http.ResponseWriter, r *http.Request, id int64)
+	// Score an assigned project
+	// (PUT /api/hackathons/{id}/judging/projects/{projectId}/score)
+	ScoreProject(w http.ResponseWriter, r *http.Request, id int64, projectId int64)
+	// Leave the "looking for team" pool
+	// (DELETE /api/hackathons/{id}/pool)
+	LeaveTeamPool(w http.ResponseWriter, r *http.Request, id int64)
+	// List the students looking for a team
+	// (GET /api/hackathons/{id}/pool)
+	ListTeamPool(w http.ResponseWriter, r *http.Request, id int64)
+	// Join the "looking for team" pool, or update the current user's entry
+	// (PUT /api/hackathons/{id}/pool)
+	EnterTeamPool(w http.ResponseWriter, r *http.Request, id int64)
+	// Form the suggested teams from the pool (admin only)
+	// (POST /api/hackathons/{id}/pool/form)
+	FormPoolTeams(w http.ResponseWriter, r *http.Request, id int64)
+	// Suggest balanced teams from the pool (admin only)
+	// (GET /api/hackathons/{id}/pool/suggestions)
+	SuggestPoolTeams(w http.ResponseWriter, r *http.Request, id int64, params SuggestPoolTeamsParams)
+	// Coin prize per final placement
+	// (GET /api/hackathons/{id}/prizes)
+	ListHackathonPrizes(w http.ResponseWriter, r *http.Request, id int64)
+	// Replace the prize tiers (admin only)
+	// (PUT /api/hackathons/{id}/prizes)
+	SetHackathonPrizes(w http.ResponseWriter, r *http.Request, id int64)
+	// Prizes paid when results were published (admin only)
+	// (GET /api/hackathons/{id}/prizes/payouts)
+	ListHackathonPrizePayouts(w http.ResponseWriter, r *http.Request, id int64)
+	// Get the hackathon's ranking
+	// (GET /api/hackathons/{id}/results)
+	GetHackathonResults(w http.ResponseWriter, r *http.Request, id int64)
+	// Publish the ranking and finish the hackathon (admin only)
+	// (POST /api/hackathons/{id}/results/publish)
+	PublishHackathonResults(w http.ResponseWriter, r *http.Request, id int64)
+	// Move a hackathon to another lifecycle status (admin only)
+	// (POST /api/hackathons/{id}/status)
+	TransitionHackathon(w http.ResponseWriter, r *http.Request, id int64)
+	// List a hackathon's project submissions with team members (admin only)
+	// (GET /api/hackathons/{id}/submissions)
+	ListHackathonSubmissions(w http.ResponseWriter, r *http.Request, id int64)
+	// Export a hackathon's project submissions as CSV (admin only)
+	// (GET /api/hackathons/{id}/submissions/export)
+	ExportHackathonSubmissions(w http.ResponseWriter, r *http.Request, id int64)
+	// List the teams formed for a hackathon
+	// (GET /api/hackathons/{id}/teams)
+	ListHackathonTeams(w http.ResponseWriter, r *http.Request, id int64)
+	// Create a team for a hackathon, captained by the current user
+	// (POST /api/hackathons/{id}/teams)
+	CreateTeam(w http.ResponseWriter, r *http.Request, id int64)
 	// Health check endpoint
 	// (GET /api/health)
 	GetHealth(w http.ResponseWriter, r *http.Request)
-	// Get top users by coins
+	// Get the leaderboard for a period and metric
 	// (GET /api/leaderboard)
-	GetLeaderboard(w http.ResponseWriter, r *http.Request)
+	GetLeaderboard(w http.ResponseWriter, r *http.Request, params GetLeaderboardParams)
+	// Top users of every league
+	// (GET /api/leaderboard/leagues)
+	GetLeagueLeaderboards(w http.ResponseWriter, r *http.Request, params GetLeagueLeaderboardsParams)
+	// Get the caller's leaderboard entry
+	// (GET /api/leaderboard/me)
+	GetMyLeaderboardEntry(w http.ResponseWriter, r *http.Request, params GetMyLeaderboardEntryParams)
+	// List leaderboard seasons
+	// (GET /api/leaderboard/seasons)
+	ListSeasons(w http.ResponseWriter, r *http.Request)
+	// Create a season (admin only)
+	// (POST /api/leaderboard/seasons)
+	CreateSeason(w http.ResponseWriter, r *http.Request)
+	// Delete a season that has not been closed (admin only)
+	// (DELETE /api/leaderboard/seasons/{id})
+	DeleteSeason(w http.ResponseWriter, r *http.Request, id int64)
+	// Get a season with its standings
+	// (GET /api/leaderboard/seasons/{id})
+	GetSeason(w http.ResponseWriter, r *http.Request, id int64, params GetSeasonParams)
+	// Update a season that has not been closed (admin only)
+	// (PUT /api/leaderboard/seasons/{id})
+	UpdateSeason(w http.ResponseWriter, r *http.Request, id int64)
+	// Close a season now (admin only)
+	// (POST /api/leaderboard/seasons/{id}/close)
+	CloseSeason(w http.ResponseWriter, r *http.Request, id int64)
 	// List news articles
 	// (GET /api/news)
 	ListNews(w http.ResponseWriter, r *http.Request, params ListNewsParams)
@@ -386,21 +1770,114 @@ type ServerInterface interface {
 	// Get AI-generated summary for a news article
 	// (GET /api/news/{id}/summary)
 	GetNewsSummary(w http.ResponseWriter, r *http.Request, id int64)
+	// List promo codes (admin only)
+	// (GET /api/promo-codes)
+	ListPromoCodes(w http.ResponseWriter, r *http.Request)
+	// Create a promo code (admin only)
+	// (POST /api/promo-codes)
+	CreatePromoCode(w http.ResponseWriter, r *http.Request)
+	// Delete a promo code (admin only)
+	// (DELETE /api/promo-codes/{id})
+	DeletePromoCode(w http.ResponseWriter, r *http.Request, id int64)
+	// List quests still taking submissions
+	// (GET /api/quests)
+	ListQuests(w http.ResponseWriter, r *http.Request, params ListQuestsParams)
+	// Post a quest (admin only)
+	// (POST /api/quests)
+	CreateQuest(w http.ResponseWriter, r *http.Request)
+	// Quest submission review queue (admin only)
+	// (GET /api/quests/submissions)
+	ListQuestSubmissions(w http.ResponseWriter, r *http.Request, params ListQuestSubmissionsParams)
+	// Approve a submission and pay the reward (admin only)
+	// (POST /api/quests/submissions/{id}/approve)
+	ApproveQuestSubmission(w http.ResponseWriter, r *http.Request, id int64)
+	// Reject a submission (admin only)
+	// (POST /api/quests/submissions/{id}/reject)
+	RejectQuestSubmission(w http.ResponseWriter, r *http.Request, id int64)
+	// Get a single quest
+	// (GET /api/quests/{id})
+	GetQuest(w http.ResponseWriter, r *http.Request, id int64)
+	// Update a quest (admin only)
+	// (PUT /api/quests/{id})
+	UpdateQuest(w http.ResponseWriter, r *http.Request, id int64)
+	// Submit proof of completing a quest
+	// (POST /api/quests/{id}/submissions)
+	SubmitQuest(w http.ResponseWriter, r *http.Request, id int64)
+	// List raffles, open ones first
+	// (GET /api/raffles)
+	ListRaffles(w http.ResponseWriter, r *http.Request)
+	// Create a raffle (admin only)
+	// (POST /api/raffles)
+	CreateRaffle(w http.ResponseWriter, r *http.Request)
+	// Get a single raffle
+	// (GET /api/raffles/{id})
+	GetRaffle(w http.ResponseWriter, r *http.Request, id int64)
+	// Close a raffle and draw the winner (admin only)
+	// (POST /api/raffles/{id}/draw)
+	DrawRaffle(w http.ResponseWriter, r *http.Request, id int64)
+	// Buy raffle tickets with coins
+	// (POST /api/raffles/{id}/tickets)
+	BuyRaffleTickets(w http.ResponseWriter, r *http.Request, id int64)
 	// List shop items
 	// (GET /api/shop)
 	ListShopItems(w http.ResponseWriter, r *http.Request)
 	// Create a shop item (admin only)
 	// (POST /api/shop)
 	CreateShopItem(w http.ResponseWriter, r *http.Request)
+	// Buy several shop items in a single order
+	// (POST /api/shop/checkout)
+	CheckoutShop(w http.ResponseWriter, r *http.Request)
+	// List all purchases (admin only)
+	// (GET /api/shop/purchases)
+	ListPurchases(w http.ResponseWriter, r *http.Request, params ListPurchasesParams)
+	// List purchases of the current user
+	// (GET /api/shop/purchases/me)
+	ListMyPurchases(w http.ResponseWriter, r *http.Request)
+	// Aggregate shop sales per item and top buyers (admin only)
+	// (GET /api/shop/reports/sales)
+	GetSalesReport(w http.ResponseWriter, r *http.Request, params GetSalesReportParams)
 	// Delete a shop item (admin only)
 	// (DELETE /api/shop/{id})
 	DeleteShopItem(w http.ResponseWriter, r *http.Request, id int64)
 	// Buy a shop item with coins
 	// (POST /api/shop/{id}/buy)
 	BuyShopItem(w http.ResponseWriter, r *http.Request, id int64)
-	// Get current authenticated user
+	// Remove the sale price of a shop item (admin only)
+	// (DELETE /api/shop/{id}/sale)
+	ClearShopItemSale(w http.ResponseWriter, r *http.Request, id int64)
+	// Schedule a sale price for a shop item (admin only)
+	// (PUT /api/shop/{id}/sale)
+	SetShopItemSale(w http.ResponseWriter, r *http.Request, id int64)
+	// Join a team with its invite code
+	// (POST /api/teams/join)
+	JoinTeam(w http.ResponseWriter, r *http.Request)
+	// List the current user's teams
+	// (GET /api/teams/me)
+	ListMyTeams(w http.ResponseWriter, r *http.Request)
+	// Get a team
+	// (GET /api/teams/{id})
+	GetTeam(w http.ResponseWriter, r *http.Request, id int64)
+	// Hand the team over to another member (captain only)
+	// (POST /api/teams/{id}/captain)
+	TransferTeamCaptain(w http.ResponseWriter, r *http.Request, id int64)
+	// Leave a team
+	// (DELETE /api/teams/{id}/leave)
+	LeaveTeam(w http.ResponseWriter, r *http.Request, id int64)
+	// Get the team's project submission (members and admins)
+	// (GET /api/teams/{id}/submission)
+	GetTeamSubmission(w http.ResponseWriter, r *http.Request, id int64)
+	// Submit or edit the team's project
+	// (PUT /api/teams/{id}/submission)
+	SaveTeamSubmission(w http.ResponseWriter, r *http.Request, id int64)
+	// Get current authenticated user, with badges
 	// (GET /api/users/me)
 	GetMe(w http.ResponseWriter, r *http.Request)
+	// Get the current user's referral link and referred users
+	// (GET /api/users/me/referrals)
+	GetMyReferrals(w http.ResponseWriter, r *http.Request)
+	// Get another user's public profile
+	// (GET /api/users/{id})
+	GetUserProfile(w http.ResponseWriter, r *http.Request, id int64)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -412,11 +1889,32 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// AttendanceCheckIn operation middleware
-func (siw *ServerInterfaceWrapper) AttendanceCheckIn(w http.ResponseWriter, r *http.Request) {
+// ListAdminAlerts operation middleware
+func (siw *ServerInterfaceWrapper) ListAdminAlerts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAdminAlertsParams
+
+	// ------------- Optional query parameter "include_resolved" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_resolved", r.URL.Query(), &params.IncludeResolved)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_resolved", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AttendanceCheckIn(w, r)
+		siw.Handler.ListAdminAlerts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -426,11 +1924,22 @@ func (siw *ServerInterfaceWrapper) AttendanceCheckIn(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// AttendanceHistory operation middleware
-func (siw *ServerInterfaceWrapper) AttendanceHistory(w http.ResponseWriter, r *http.Request) {
+// ResolveAdminAlert operation middleware
+func (siw *ServerInterfaceWrapper) ResolveAdminAlert(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AttendanceHistory(w, r)
+		siw.Handler.ResolveAdminAlert(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -440,11 +1949,2051 @@ func (siw *ServerInterfaceWrapper) AttendanceHistory(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// AuthAdmin operation middleware
-func (siw *ServerInterfaceWrapper) AuthAdmin(w http.ResponseWriter, r *http.Request) {
+// AttendanceCheckIn operation middleware
+func (siw *ServerInterfaceWrapper) AttendanceCheckIn(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AttendanceCheckIn(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AttendanceHistory operation middleware
+func (siw *ServerInterfaceWrapper) AttendanceHistory(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AttendanceHistory(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthAdmin operation middleware
+func (siw *ServerInterfaceWrapper) AuthAdmin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthAdmin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthSchool operation middleware
+func (siw *ServerInterfaceWrapper) AuthSchool(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthSchool(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AuthTelegram operation middleware
+func (siw *ServerInterfaceWrapper) AuthTelegram(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AuthTelegram(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListClubs operation middleware
+func (siw *ServerInterfaceWrapper) ListClubs(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListClubs(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateClub operation middleware
+func (siw *ServerInterfaceWrapper) CreateClub(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateClub(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteClub operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClub(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteClub(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetClub operation middleware
+func (siw *ServerInterfaceWrapper) GetClub(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetClub(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// JoinClub operation middleware
+func (siw *ServerInterfaceWrapper) JoinClub(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.JoinClub(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LeaveClub operation middleware
+func (siw *ServerInterfaceWrapper) LeaveClub(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LeaveClub(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AdjustCoins operation middleware
+func (siw *ServerInterfaceWrapper) AdjustCoins(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AdjustCoins(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BulkAwardCoins operation middleware
+func (siw *ServerInterfaceWrapper) BulkAwardCoins(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params BulkAwardCoinsParams
+
+	// ------------- Optional query parameter "commit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "commit", r.URL.Query(), &params.Commit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkAwardCoins(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCoinAwardRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListCoinAwardRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCoinAwardRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCoinAwardRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveCoinAwardRequest operation middleware
+func (siw *ServerInterfaceWrapper) ApproveCoinAwardRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveCoinAwardRequest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectCoinAwardRequest operation middleware
+func (siw *ServerInterfaceWrapper) RejectCoinAwardRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectCoinAwardRequest(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CoinHistory operation middleware
+func (siw *ServerInterfaceWrapper) CoinHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CoinHistoryParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CoinHistory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransferCoins operation middleware
+func (siw *ServerInterfaceWrapper) TransferCoins(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransferCoins(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListGovMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListGovMembers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListGovMembers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateGovMember operation middleware
+func (siw *ServerInterfaceWrapper) CreateGovMember(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateGovMember(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteGovMember operation middleware
+func (siw *ServerInterfaceWrapper) DeleteGovMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteGovMember(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHackathons operation middleware
+func (siw *ServerInterfaceWrapper) ListHackathons(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListHackathonsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHackathons(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateHackathon operation middleware
+func (siw *ServerInterfaceWrapper) CreateHackathon(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateHackathon(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMyHackathonApplications operation middleware
+func (siw *ServerInterfaceWrapper) ListMyHackathonApplications(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyHackathonApplications(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteHackathon operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHackathon(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteHackathon(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHackathon operation middleware
+func (siw *ServerInterfaceWrapper) GetHackathon(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHackathon(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateHackathon operation middleware
+func (siw *ServerInterfaceWrapper) UpdateHackathon(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateHackathon(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHackathonApplications operation middleware
+func (siw *ServerInterfaceWrapper) ListHackathonApplications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHackathonApplications(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportHackathonApplications operation middleware
+func (siw *ServerInterfaceWrapper) ExportHackathonApplications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportHackathonApplications(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BulkReviewHackathonApplications operation middleware
+func (siw *ServerInterfaceWrapper) BulkReviewHackathonApplications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkReviewHackathonApplications(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReviewHackathonApplication operation middleware
+func (siw *ServerInterfaceWrapper) ReviewHackathonApplication(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "applicationId" -------------
+	var applicationId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "applicationId", r.PathValue("applicationId"), &applicationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "applicationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReviewHackathonApplication(w, r, id, applicationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WithdrawFromHackathon operation middleware
+func (siw *ServerInterfaceWrapper) WithdrawFromHackathon(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WithdrawFromHackathon(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApplyToHackathon operation middleware
+func (siw *ServerInterfaceWrapper) ApplyToHackathon(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApplyToHackathon(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHackathonForm operation middleware
+func (siw *ServerInterfaceWrapper) GetHackathonForm(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHackathonForm(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetHackathonForm operation middleware
+func (siw *ServerInterfaceWrapper) SetHackathonForm(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetHackathonForm(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHackathonJudges operation middleware
+func (siw *ServerInterfaceWrapper) ListHackathonJudges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHackathonJudges(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddHackathonJudge operation middleware
+func (siw *ServerInterfaceWrapper) AddHackathonJudge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddHackathonJudge(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveHackathonJudge operation middleware
+func (siw *ServerInterfaceWrapper) RemoveHackathonJudge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveHackathonJudge(w, r, id, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListJudgeAssignments operation middleware
+func (siw *ServerInterfaceWrapper) ListJudgeAssignments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListJudgeAssignments(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AssignJudge operation middleware
+func (siw *ServerInterfaceWrapper) AssignJudge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AssignJudge(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AutoAssignJudges operation middleware
+func (siw *ServerInterfaceWrapper) AutoAssignJudges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AutoAssignJudges(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMyJudgeAssignments operation middleware
+func (siw *ServerInterfaceWrapper) ListMyJudgeAssignments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyJudgeAssignments(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListJudgingCriteria operation middleware
+func (siw *ServerInterfaceWrapper) ListJudgingCriteria(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListJudgingCriteria(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetJudgingCriteria operation middleware
+func (siw *ServerInterfaceWrapper) SetJudgingCriteria(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetJudgingCriteria(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ScoreProject operation middleware
+func (siw *ServerInterfaceWrapper) ScoreProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ScoreProject(w, r, id, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LeaveTeamPool operation middleware
+func (siw *ServerInterfaceWrapper) LeaveTeamPool(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LeaveTeamPool(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTeamPool operation middleware
+func (siw *ServerInterfaceWrapper) ListTeamPool(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTeamPool(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EnterTeamPool operation middleware
+func (siw *ServerInterfaceWrapper) EnterTeamPool(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnterTeamPool(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// FormPoolTeams operation middleware
+func (siw *ServerInterfaceWrapper) FormPoolTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.FormPoolTeams(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SuggestPoolTeams operation middleware
+func (siw *ServerInterfaceWrapper) SuggestPoolTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SuggestPoolTeamsParams
+
+	// ------------- Optional query parameter "size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "size", r.URL.Query(), &params.Size)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SuggestPoolTeams(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHackathonPrizes operation middleware
+func (siw *ServerInterfaceWrapper) ListHackathonPrizes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHackathonPrizes(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetHackathonPrizes operation middleware
+func (siw *ServerInterfaceWrapper) SetHackathonPrizes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetHackathonPrizes(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHackathonPrizePayouts operation middleware
+func (siw *ServerInterfaceWrapper) ListHackathonPrizePayouts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHackathonPrizePayouts(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHackathonResults operation middleware
+func (siw *ServerInterfaceWrapper) GetHackathonResults(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHackathonResults(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PublishHackathonResults operation middleware
+func (siw *ServerInterfaceWrapper) PublishHackathonResults(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishHackathonResults(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TransitionHackathon operation middleware
+func (siw *ServerInterfaceWrapper) TransitionHackathon(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TransitionHackathon(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHackathonSubmissions operation middleware
+func (siw *ServerInterfaceWrapper) ListHackathonSubmissions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHackathonSubmissions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportHackathonSubmissions operation middleware
+func (siw *ServerInterfaceWrapper) ExportHackathonSubmissions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportHackathonSubmissions(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListHackathonTeams operation middleware
+func (siw *ServerInterfaceWrapper) ListHackathonTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListHackathonTeams(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTeam operation middleware
+func (siw *ServerInterfaceWrapper) CreateTeam(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTeam(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLeaderboard operation middleware
+func (siw *ServerInterfaceWrapper) GetLeaderboard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLeaderboardParams
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "metric" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metric", r.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metric", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "league" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "league", r.URL.Query(), &params.League)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "league", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLeaderboard(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLeagueLeaderboards operation middleware
+func (siw *ServerInterfaceWrapper) GetLeagueLeaderboards(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLeagueLeaderboardsParams
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "metric" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metric", r.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metric", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLeagueLeaderboards(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyLeaderboardEntry operation middleware
+func (siw *ServerInterfaceWrapper) GetMyLeaderboardEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMyLeaderboardEntryParams
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "metric" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "metric", r.URL.Query(), &params.Metric)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "metric", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "league" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "league", r.URL.Query(), &params.League)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "league", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyLeaderboardEntry(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSeasons operation middleware
+func (siw *ServerInterfaceWrapper) ListSeasons(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSeasons(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSeason operation middleware
+func (siw *ServerInterfaceWrapper) CreateSeason(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSeason(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSeason operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSeason(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSeason(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSeason operation middleware
+func (siw *ServerInterfaceWrapper) GetSeason(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSeasonParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSeason(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSeason operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSeason(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSeason(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloseSeason operation middleware
+func (siw *ServerInterfaceWrapper) CloseSeason(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloseSeason(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListNews operation middleware
+func (siw *ServerInterfaceWrapper) ListNews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNewsParams
+
+	// ------------- Optional query parameter "tag" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListNews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateNews operation middleware
+func (siw *ServerInterfaceWrapper) CreateNews(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateNews(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteNews operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteNews(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNews operation middleware
+func (siw *ServerInterfaceWrapper) GetNews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNews(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateNews operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateNews(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNewsSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetNewsSummary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNewsSummary(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPromoCodes operation middleware
+func (siw *ServerInterfaceWrapper) ListPromoCodes(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPromoCodes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePromoCode operation middleware
+func (siw *ServerInterfaceWrapper) CreatePromoCode(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePromoCode(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePromoCode operation middleware
+func (siw *ServerInterfaceWrapper) DeletePromoCode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePromoCode(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListQuests operation middleware
+func (siw *ServerInterfaceWrapper) ListQuests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListQuestsParams
+
+	// ------------- Optional query parameter "include_closed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_closed", r.URL.Query(), &params.IncludeClosed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_closed", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQuests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateQuest operation middleware
+func (siw *ServerInterfaceWrapper) CreateQuest(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateQuest(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListQuestSubmissions operation middleware
+func (siw *ServerInterfaceWrapper) ListQuestSubmissions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListQuestSubmissionsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "quest_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "quest_id", r.URL.Query(), &params.QuestId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "quest_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListQuestSubmissions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveQuestSubmission operation middleware
+func (siw *ServerInterfaceWrapper) ApproveQuestSubmission(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveQuestSubmission(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectQuestSubmission operation middleware
+func (siw *ServerInterfaceWrapper) RejectQuestSubmission(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectQuestSubmission(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetQuest operation middleware
+func (siw *ServerInterfaceWrapper) GetQuest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthAdmin(w, r)
+		siw.Handler.GetQuest(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -454,11 +4003,22 @@ func (siw *ServerInterfaceWrapper) AuthAdmin(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// AuthSchool operation middleware
-func (siw *ServerInterfaceWrapper) AuthSchool(w http.ResponseWriter, r *http.Request) {
+// UpdateQuest operation middleware
+func (siw *ServerInterfaceWrapper) UpdateQuest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthSchool(w, r)
+		siw.Handler.UpdateQuest(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -468,11 +4028,22 @@ func (siw *ServerInterfaceWrapper) AuthSchool(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// AuthTelegram operation middleware
-func (siw *ServerInterfaceWrapper) AuthTelegram(w http.ResponseWriter, r *http.Request) {
+// SubmitQuest operation middleware
+func (siw *ServerInterfaceWrapper) SubmitQuest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AuthTelegram(w, r)
+		siw.Handler.SubmitQuest(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -482,11 +4053,11 @@ func (siw *ServerInterfaceWrapper) AuthTelegram(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListClubs operation middleware
-func (siw *ServerInterfaceWrapper) ListClubs(w http.ResponseWriter, r *http.Request) {
+// ListRaffles operation middleware
+func (siw *ServerInterfaceWrapper) ListRaffles(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListClubs(w, r)
+		siw.Handler.ListRaffles(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -496,11 +4067,11 @@ func (siw *ServerInterfaceWrapper) ListClubs(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// CreateClub operation middleware
-func (siw *ServerInterfaceWrapper) CreateClub(w http.ResponseWriter, r *http.Request) {
+// CreateRaffle operation middleware
+func (siw *ServerInterfaceWrapper) CreateRaffle(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateClub(w, r)
+		siw.Handler.CreateRaffle(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -510,8 +4081,8 @@ func (siw *ServerInterfaceWrapper) CreateClub(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteClub operation middleware
-func (siw *ServerInterfaceWrapper) DeleteClub(w http.ResponseWriter, r *http.Request) {
+// GetRaffle operation middleware
+func (siw *ServerInterfaceWrapper) GetRaffle(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -525,7 +4096,7 @@ func (siw *ServerInterfaceWrapper) DeleteClub(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteClub(w, r, id)
+		siw.Handler.GetRaffle(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -535,8 +4106,8 @@ func (siw *ServerInterfaceWrapper) DeleteClub(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetClub operation middleware
-func (siw *ServerInterfaceWrapper) GetClub(w http.ResponseWriter, r *http.Request) {
+// DrawRaffle operation middleware
+func (siw *ServerInterfaceWrapper) DrawRaffle(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -550,7 +4121,7 @@ func (siw *ServerInterfaceWrapper) GetClub(w http.ResponseWriter, r *http.Reques
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetClub(w, r, id)
+		siw.Handler.DrawRaffle(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -560,8 +4131,8 @@ func (siw *ServerInterfaceWrapper) GetClub(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// JoinClub operation middleware
-func (siw *ServerInterfaceWrapper) JoinClub(w http.ResponseWriter, r *http.Request) {
+// BuyRaffleTickets operation middleware
+func (siw *ServerInterfaceWrapper) BuyRaffleTickets(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -575,7 +4146,7 @@ func (siw *ServerInterfaceWrapper) JoinClub(w http.ResponseWriter, r *http.Reque
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.JoinClub(w, r, id)
+		siw.Handler.BuyRaffleTickets(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -585,22 +4156,11 @@ func (siw *ServerInterfaceWrapper) JoinClub(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// LeaveClub operation middleware
-func (siw *ServerInterfaceWrapper) LeaveClub(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// ListShopItems operation middleware
+func (siw *ServerInterfaceWrapper) ListShopItems(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.LeaveClub(w, r, id)
+		siw.Handler.ListShopItems(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -610,11 +4170,11 @@ func (siw *ServerInterfaceWrapper) LeaveClub(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ListGovMembers operation middleware
-func (siw *ServerInterfaceWrapper) ListGovMembers(w http.ResponseWriter, r *http.Request) {
+// CreateShopItem operation middleware
+func (siw *ServerInterfaceWrapper) CreateShopItem(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListGovMembers(w, r)
+		siw.Handler.CreateShopItem(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -624,11 +4184,11 @@ func (siw *ServerInterfaceWrapper) ListGovMembers(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CreateGovMember operation middleware
-func (siw *ServerInterfaceWrapper) CreateGovMember(w http.ResponseWriter, r *http.Request) {
+// CheckoutShop operation middleware
+func (siw *ServerInterfaceWrapper) CheckoutShop(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateGovMember(w, r)
+		siw.Handler.CheckoutShop(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -638,22 +4198,48 @@ func (siw *ServerInterfaceWrapper) CreateGovMember(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteGovMember operation middleware
-func (siw *ServerInterfaceWrapper) DeleteGovMember(w http.ResponseWriter, r *http.Request) {
+// ListPurchases operation middleware
+func (siw *ServerInterfaceWrapper) ListPurchases(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id int64
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPurchasesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "item_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "item_id", r.URL.Query(), &params.ItemId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteGovMember(w, r, id)
+		siw.Handler.ListPurchases(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -663,38 +4249,70 @@ func (siw *ServerInterfaceWrapper) DeleteGovMember(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListHackathons operation middleware
-func (siw *ServerInterfaceWrapper) ListHackathons(w http.ResponseWriter, r *http.Request) {
+// ListMyPurchases operation middleware
+func (siw *ServerInterfaceWrapper) ListMyPurchases(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMyPurchases(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSalesReport operation middleware
+func (siw *ServerInterfaceWrapper) GetSalesReport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListHackathonsParams
+	var params GetSalesReportParams
 
-	// ------------- Optional query parameter "status" -------------
+	// ------------- Optional query parameter "item_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	err = runtime.BindQueryParameter("form", true, false, "item_id", r.URL.Query(), &params.ItemId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListHackathons(w, r, params)
-	}))
+	// ------------- Optional query parameter "user_id" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Optional query parameter "from" -------------
 
-// CreateHackathon operation middleware
-func (siw *ServerInterfaceWrapper) CreateHackathon(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "top" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "top", r.URL.Query(), &params.Top)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "top", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateHackathon(w, r)
+		siw.Handler.GetSalesReport(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -704,8 +4322,8 @@ func (siw *ServerInterfaceWrapper) CreateHackathon(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteHackathon operation middleware
-func (siw *ServerInterfaceWrapper) DeleteHackathon(w http.ResponseWriter, r *http.Request) {
+// DeleteShopItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteShopItem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -719,7 +4337,7 @@ func (siw *ServerInterfaceWrapper) DeleteHackathon(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteHackathon(w, r, id)
+		siw.Handler.DeleteShopItem(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -729,8 +4347,8 @@ func (siw *ServerInterfaceWrapper) DeleteHackathon(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetHackathon operation middleware
-func (siw *ServerInterfaceWrapper) GetHackathon(w http.ResponseWriter, r *http.Request) {
+// BuyShopItem operation middleware
+func (siw *ServerInterfaceWrapper) BuyShopItem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -744,7 +4362,7 @@ func (siw *ServerInterfaceWrapper) GetHackathon(w http.ResponseWriter, r *http.R
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHackathon(w, r, id)
+		siw.Handler.BuyShopItem(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -754,8 +4372,8 @@ func (siw *ServerInterfaceWrapper) GetHackathon(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListHackathonApplications operation middleware
-func (siw *ServerInterfaceWrapper) ListHackathonApplications(w http.ResponseWriter, r *http.Request) {
+// ClearShopItemSale operation middleware
+func (siw *ServerInterfaceWrapper) ClearShopItemSale(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -769,7 +4387,7 @@ func (siw *ServerInterfaceWrapper) ListHackathonApplications(w http.ResponseWrit
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListHackathonApplications(w, r, id)
+		siw.Handler.ClearShopItemSale(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -779,8 +4397,8 @@ func (siw *ServerInterfaceWrapper) ListHackathonApplications(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// ApplyToHackathon operation middleware
-func (siw *ServerInterfaceWrapper) ApplyToHackathon(w http.ResponseWriter, r *http.Request) {
+// SetShopItemSale operation middleware
+func (siw *ServerInterfaceWrapper) SetShopItemSale(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -794,7 +4412,7 @@ func (siw *ServerInterfaceWrapper) ApplyToHackathon(w http.ResponseWriter, r *ht
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ApplyToHackathon(w, r, id)
+		siw.Handler.SetShopItemSale(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -804,11 +4422,11 @@ func (siw *ServerInterfaceWrapper) ApplyToHackathon(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+// JoinTeam operation middleware
+func (siw *ServerInterfaceWrapper) JoinTeam(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
+		siw.Handler.JoinTeam(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -818,11 +4436,11 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetLeaderboard operation middleware
-func (siw *ServerInterfaceWrapper) GetLeaderboard(w http.ResponseWriter, r *http.Request) {
+// ListMyTeams operation middleware
+func (siw *ServerInterfaceWrapper) ListMyTeams(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetLeaderboard(w, r)
+		siw.Handler.ListMyTeams(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -832,38 +4450,22 @@ func (siw *ServerInterfaceWrapper) GetLeaderboard(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// ListNews operation middleware
-func (siw *ServerInterfaceWrapper) ListNews(w http.ResponseWriter, r *http.Request) {
+// GetTeam operation middleware
+func (siw *ServerInterfaceWrapper) GetTeam(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListNewsParams
-
-	// ------------- Optional query parameter "tag" -------------
+	// ------------- Path parameter "id" -------------
+	var id int64
 
-	err = runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tag", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListNews(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// CreateNews operation middleware
-func (siw *ServerInterfaceWrapper) CreateNews(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateNews(w, r)
+		siw.Handler.GetTeam(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -873,8 +4475,8 @@ func (siw *ServerInterfaceWrapper) CreateNews(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteNews operation middleware
-func (siw *ServerInterfaceWrapper) DeleteNews(w http.ResponseWriter, r *http.Request) {
+// TransferTeamCaptain operation middleware
+func (siw *ServerInterfaceWrapper) TransferTeamCaptain(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -888,7 +4490,7 @@ func (siw *ServerInterfaceWrapper) DeleteNews(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteNews(w, r, id)
+		siw.Handler.TransferTeamCaptain(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -898,8 +4500,8 @@ func (siw *ServerInterfaceWrapper) DeleteNews(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetNews operation middleware
-func (siw *ServerInterfaceWrapper) GetNews(w http.ResponseWriter, r *http.Request) {
+// LeaveTeam operation middleware
+func (siw *ServerInterfaceWrapper) LeaveTeam(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -913,7 +4515,7 @@ func (siw *ServerInterfaceWrapper) GetNews(w http.ResponseWriter, r *http.Reques
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNews(w, r, id)
+		siw.Handler.LeaveTeam(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -923,8 +4525,8 @@ func (siw *ServerInterfaceWrapper) GetNews(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateNews operation middleware
-func (siw *ServerInterfaceWrapper) UpdateNews(w http.ResponseWriter, r *http.Request) {
+// GetTeamSubmission operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSubmission(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -938,7 +4540,7 @@ func (siw *ServerInterfaceWrapper) UpdateNews(w http.ResponseWriter, r *http.Req
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateNews(w, r, id)
+		siw.Handler.GetTeamSubmission(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -948,8 +4550,8 @@ func (siw *ServerInterfaceWrapper) UpdateNews(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetNewsSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetNewsSummary(w http.ResponseWriter, r *http.Request) {
+// SaveTeamSubmission operation middleware
+func (siw *ServerInterfaceWrapper) SaveTeamSubmission(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -963,21 +4565,7 @@ func (siw *ServerInterfaceWrapper) GetNewsSummary(w http.ResponseWriter, r *http
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNewsSummary(w, r, id)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// ListShopItems operation middleware
-func (siw *ServerInterfaceWrapper) ListShopItems(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListShopItems(w, r)
+		siw.Handler.SaveTeamSubmission(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -987,11 +4575,11 @@ func (siw *ServerInterfaceWrapper) ListShopItems(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// CreateShopItem operation middleware
-func (siw *ServerInterfaceWrapper) CreateShopItem(w http.ResponseWriter, r *http.Request) {
+// GetMe operation middleware
+func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateShopItem(w, r)
+		siw.Handler.GetMe(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1001,22 +4589,11 @@ func (siw *ServerInterfaceWrapper) CreateShopItem(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteShopItem operation middleware
-func (siw *ServerInterfaceWrapper) DeleteShopItem(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id int64
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+// GetMyReferrals operation middleware
+func (siw *ServerInterfaceWrapper) GetMyReferrals(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteShopItem(w, r, id)
+		siw.Handler.GetMyReferrals(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1026,8 +4603,8 @@ func (siw *ServerInterfaceWrapper) DeleteShopItem(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// BuyShopItem operation middleware
-func (siw *ServerInterfaceWrapper) BuyShopItem(w http.ResponseWriter, r *http.Request) {
+// GetUserProfile operation middleware
+func (siw *ServerInterfaceWrapper) GetUserProfile(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -1041,21 +4618,7 @@ func (siw *ServerInterfaceWrapper) BuyShopItem(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.BuyShopItem(w, r, id)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
-
-// GetMe operation middleware
-func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMe(w, r)
+		siw.Handler.GetUserProfile(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1185,6 +4748,8 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("GET "+options.BaseURL+"/api/admin/alerts", wrapper.ListAdminAlerts)
+	m.HandleFunc("POST "+options.BaseURL+"/api/admin/alerts/{id}/resolve", wrapper.ResolveAdminAlert)
 	m.HandleFunc("POST "+options.BaseURL+"/api/attendance/check-in", wrapper.AttendanceCheckIn)
 	m.HandleFunc("GET "+options.BaseURL+"/api/attendance/history", wrapper.AttendanceHistory)
 	m.HandleFunc("POST "+options.BaseURL+"/api/auth/admin", wrapper.AuthAdmin)
@@ -1196,28 +4761,107 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("GET "+options.BaseURL+"/api/clubs/{id}", wrapper.GetClub)
 	m.HandleFunc("POST "+options.BaseURL+"/api/clubs/{id}/join", wrapper.JoinClub)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/clubs/{id}/leave", wrapper.LeaveClub)
+	m.HandleFunc("POST "+options.BaseURL+"/api/coins/adjustments", wrapper.AdjustCoins)
+	m.HandleFunc("POST "+options.BaseURL+"/api/coins/adjustments/bulk", wrapper.BulkAwardCoins)
+	m.HandleFunc("GET "+options.BaseURL+"/api/coins/award-requests", wrapper.ListCoinAwardRequests)
+	m.HandleFunc("POST "+options.BaseURL+"/api/coins/award-requests/{id}/approve", wrapper.ApproveCoinAwardRequest)
+	m.HandleFunc("POST "+options.BaseURL+"/api/coins/award-requests/{id}/reject", wrapper.RejectCoinAwardRequest)
+	m.HandleFunc("GET "+options.BaseURL+"/api/coins/history", wrapper.CoinHistory)
+	m.HandleFunc("POST "+options.BaseURL+"/api/coins/transfer", wrapper.TransferCoins)
 	m.HandleFunc("GET "+options.BaseURL+"/api/gov", wrapper.ListGovMembers)
 	m.HandleFunc("POST "+options.BaseURL+"/api/gov", wrapper.CreateGovMember)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/gov/{id}", wrapper.DeleteGovMember)
 	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons", wrapper.ListHackathons)
 	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons", wrapper.CreateHackathon)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/applications/me", wrapper.ListMyHackathonApplications)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/hackathons/{id}", wrapper.DeleteHackathon)
 	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}", wrapper.GetHackathon)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/hackathons/{id}", wrapper.UpdateHackathon)
 	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/applications", wrapper.ListHackathonApplications)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/applications/export", wrapper.ExportHackathonApplications)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons/{id}/applications/review", wrapper.BulkReviewHackathonApplications)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons/{id}/applications/{applicationId}/review", wrapper.ReviewHackathonApplication)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/hackathons/{id}/apply", wrapper.WithdrawFromHackathon)
 	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons/{id}/apply", wrapper.ApplyToHackathon)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/form", wrapper.GetHackathonForm)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/hackathons/{id}/form", wrapper.SetHackathonForm)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/judges", wrapper.ListHackathonJudges)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons/{id}/judges", wrapper.AddHackathonJudge)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/hackathons/{id}/judges/{userId}", wrapper.RemoveHackathonJudge)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/judging/assignments", wrapper.ListJudgeAssignments)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons/{id}/judging/assignments", wrapper.AssignJudge)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons/{id}/judging/assignments/auto", wrapper.AutoAssignJudges)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/judging/assignments/me", wrapper.ListMyJudgeAssignments)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/judging/criteria", wrapper.ListJudgingCriteria)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/hackathons/{id}/judging/criteria", wrapper.SetJudgingCriteria)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/hackathons/{id}/judging/projects/{projectId}/score", wrapper.ScoreProject)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/hackathons/{id}/pool", wrapper.LeaveTeamPool)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/pool", wrapper.ListTeamPool)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/hackathons/{id}/pool", wrapper.EnterTeamPool)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons/{id}/pool/form", wrapper.FormPoolTeams)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/pool/suggestions", wrapper.SuggestPoolTeams)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/prizes", wrapper.ListHackathonPrizes)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/hackathons/{id}/prizes", wrapper.SetHackathonPrizes)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/prizes/payouts", wrapper.ListHackathonPrizePayouts)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/results", wrapper.GetHackathonResults)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons/{id}/results/publish", wrapper.PublishHackathonResults)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons/{id}/status", wrapper.TransitionHackathon)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/submissions", wrapper.ListHackathonSubmissions)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/submissions/export", wrapper.ExportHackathonSubmissions)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hackathons/{id}/teams", wrapper.ListHackathonTeams)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hackathons/{id}/teams", wrapper.CreateTeam)
 	m.HandleFunc("GET "+options.BaseURL+"/api/health", wrapper.GetHealth)
 	m.HandleFunc("GET "+options.BaseURL+"/api/leaderboard", wrapper.GetLeaderboard)
+	m.HandleFunc("GET "+options.BaseURL+"/api/leaderboard/leagues", wrapper.GetLeagueLeaderboards)
+	m.HandleFunc("GET "+options.BaseURL+"/api/leaderboard/me", wrapper.GetMyLeaderboardEntry)
+	m.HandleFunc("GET "+options.BaseURL+"/api/leaderboard/seasons", wrapper.ListSeasons)
+	m.HandleFunc("POST "+options.BaseURL+"/api/leaderboard/seasons", wrapper.CreateSeason)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/leaderboard/seasons/{id}", wrapper.DeleteSeason)
+	m.HandleFunc("GET "+options.BaseURL+"/api/leaderboard/seasons/{id}", wrapper.GetSeason)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/leaderboard/seasons/{id}", wrapper.UpdateSeason)
+	m.HandleFunc("POST "+options.BaseURL+"/api/leaderboard/seasons/{id}/close", wrapper.CloseSeason)
 	m.HandleFunc("GET "+options.BaseURL+"/api/news", wrapper.ListNews)
 	m.HandleFunc("POST "+options.BaseURL+"/api/news", wrapper.CreateNews)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/news/{id}", wrapper.DeleteNews)
 	m.HandleFunc("GET "+options.BaseURL+"/api/news/{id}", wrapper.GetNews)
 	m.HandleFunc("PUT "+options.BaseURL+"/api/news/{id}", wrapper.UpdateNews)
 	m.HandleFunc("GET "+options.BaseURL+"/api/news/{id}/summary", wrapper.GetNewsSummary)
+	m.HandleFunc("GET "+options.BaseURL+"/api/promo-codes", wrapper.ListPromoCodes)
+	m.HandleFunc("POST "+options.BaseURL+"/api/promo-codes", wrapper.CreatePromoCode)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/promo-codes/{id}", wrapper.DeletePromoCode)
+	m.HandleFunc("GET "+options.BaseURL+"/api/quests", wrapper.ListQuests)
+	m.HandleFunc("POST "+options.BaseURL+"/api/quests", wrapper.CreateQuest)
+	m.HandleFunc("GET "+options.BaseURL+"/api/quests/submissions", wrapper.ListQuestSubmissions)
+	m.HandleFunc("POST "+options.BaseURL+"/api/quests/submissions/{id}/approve", wrapper.ApproveQuestSubmission)
+	m.HandleFunc("POST "+options.BaseURL+"/api/quests/submissions/{id}/reject", wrapper.RejectQuestSubmission)
+	m.HandleFunc("GET "+options.BaseURL+"/api/quests/{id}", wrapper.GetQuest)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/quests/{id}", wrapper.UpdateQuest)
+	m.HandleFunc("POST "+options.BaseURL+"/api/quests/{id}/submissions", wrapper.SubmitQuest)
+	m.HandleFunc("GET "+options.BaseURL+"/api/raffles", wrapper.ListRaffles)
+	m.HandleFunc("POST "+options.BaseURL+"/api/raffles", wrapper.CreateRaffle)
+	m.HandleFunc("GET "+options.BaseURL+"/api/raffles/{id}", wrapper.GetRaffle)
+	m.HandleFunc("POST "+options.BaseURL+"/api/raffles/{id}/draw", wrapper.DrawRaffle)
+	m.HandleFunc("POST "+options.BaseURL+"/api/raffles/{id}/tickets", wrapper.BuyRaffleTickets)
 	m.HandleFunc("GET "+options.BaseURL+"/api/shop", wrapper.ListShopItems)
 	m.HandleFunc("POST "+options.BaseURL+"/api/shop", wrapper.CreateShopItem)
+	m.HandleFunc("POST "+options.BaseURL+"/api/shop/checkout", wrapper.CheckoutShop)
+	m.HandleFunc("GET "+options.BaseURL+"/api/shop/purchases", wrapper.ListPurchases)
+	m.HandleFunc("GET "+options.BaseURL+"/api/shop/purchases/me", wrapper.ListMyPurchases)
+	m.HandleFunc("GET "+options.BaseURL+"/api/shop/reports/sales", wrapper.GetSalesReport)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/shop/{id}", wrapper.DeleteShopItem)
 	m.HandleFunc("POST "+options.BaseURL+"/api/shop/{id}/buy", wrapper.BuyShopItem)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/shop/{id}/sale", wrapper.ClearShopItemSale)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/shop/{id}/sale", wrapper.SetShopItemSale)
+	m.HandleFunc("POST "+options.BaseURL+"/api/teams/join", wrapper.JoinTeam)
+	m.HandleFunc("GET "+options.BaseURL+"/api/teams/me", wrapper.ListMyTeams)
+	m.HandleFunc("GET "+options.BaseURL+"/api/teams/{id}", wrapper.GetTeam)
+	m.HandleFunc("POST "+options.BaseURL+"/api/teams/{id}/captain", wrapper.TransferTeamCaptain)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/teams/{id}/leave", wrapper.LeaveTeam)
+	m.HandleFunc("GET "+options.BaseURL+"/api/teams/{id}/submission", wrapper.GetTeamSubmission)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/teams/{id}/submission", wrapper.SaveTeamSubmission)
 	m.HandleFunc("GET "+options.BaseURL+"/api/users/me", wrapper.GetMe)
+	m.HandleFunc("GET "+options.BaseURL+"/api/users/me/referrals", wrapper.GetMyReferrals)
+	m.HandleFunc("GET "+options.BaseURL+"/api/users/{id}", wrapper.GetUserProfile)
 
 	return m
 }
@@ -1225,48 +4869,247 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xcX5PbthH/Khi2D+2MbCm1p5PqTb6k9nXs1PWfvnRuNBCxEuEjARoA5aie++4ZAKQI",
-	"iuC/O4li8pSLAAK7v9/uYhdc+nsQ8iTlDJiSwfJ7IMMIEmz+XJGEslWmog/wNQOp9G+p4CkIRcHMSLGU",
-	"37gg+m91SCFYBlIJynbBwyzIJAiGE/AMPswCAV8zKoAEy/+VM2flinez4iG++QKh0iuulAJGMAuhLkrI",
-	"KZNr/A0LAq48lCnYgdCPhwKwArLGRpMtF4n+KyBYwTNFzfY1JWAPTK0b1JgFlFSWokz9/WW5jLO3VnHd",
-	"c/YJOpQE5fMViWYnWnsxa+OPMqrWBCvczVE5tXkXmXImPdxo4fV//yxgGyyDP81Lk5vn9jb/LD2qmwd9",
-	"+91EEN7fskbFDC5+K+hg9AlEtXHk1yLONh7ZH2GmBGQoaKooZ0+zU5rgHawzEfuXkesEko1lMx/dcB4D",
-	"ZnrYjq1DnjHlB78Rdm0GJIt7BAsDsFmnCdIbA2CjbXRi1QrBGTRoFP5nIbhodiPQw93L22m+9V/z/bsj",
-	"facOwxQOVaPajzJKKtMYH9ZckIrFuObW1y4bYU8jrnij2ILHsFZUDTOsynOtQHaYWheqPRAaQfF+Or/B",
-	"4T1WkXWay0csYEQfN9B/xd62JBUWauDiUmGVWS9kWaJRw6Gi+zxlUQ5i5TND7M7OrcJy3LUisgNNK02r",
-	"NI1piAuAn85YVKy87n+aDKAkh7eOIuCk/bjul1o8PQerAOCmZLn0nXQcGgNFm5IPbcs+8awb7mWPcZ6e",
-	"jtDoA31NH3DcloY6PvwrTtLYPH1fF/hErBZ23wImIDYcC/IzU+IwKBHdUiFbEtEYt412RH/M7v2byjDi",
-	"PF7HsIfYP2OIlwwp8oxMrtc4+rclyb/AN1nHFWcq4gPk1CcxVLLSp+U2Z0qoFd75f2/wmFmQpWSgsG3H",
-	"TQGLlaQCRRMXPVKeJqDPi0VD9Kiq1KTFxyxJsM9hZTnQERbyib4d3mcijLAvBl3W1hQk62GzmwOMoCGs",
-	"W8LX+W40CrF9SH408WpKl1AfI57eKkh+V4X742mWiofes6SlenIXbYPwOoX62VTuqe1n6Su4cUaoWgud",
-	"n1cY3sYcq5Jhlpl6/WHWlkk8xvA6so/edvekNIXbwF6UVDtjB5qAjNgQHsbZZh2bLCuYBZgklHlLre60",
-	"ppjBd5Q13d/oCb+mPRVXEMNO4AEBd/jRPSR+2XPdESrHt26S+knKtrzmY8ErHN4DI2j1/hZtuUAqAvTp",
-	"I7rhSZIxqg7o3x/Rp3wL9I4yilZpekwnlsHp3NX722AW7EFIu/7i+YvnC60WT4HhlAbL4MXzxfMXJuaq",
-	"yJj3HKd0jo83/fMwgvD+mSUt5TZOaEcyle0tCZbOa4H8WjiwyIBUrzg5nGQluKyL51+kDS22UuyqI08u",
-	"nR+qDCiRgfnBlh9Gl78tfjjb7s7LD7NzlbmbHCUkIOTmZcDDLHi5eHG27at3kx4J/snFhhICzO78j/F2",
-	"XsUCMDkgYylAEGVIcYIPxkWO+ZyFSA9ilAcYtKcY/ecD+osJLIiz+PBXmzdKc8NTIn6nlzq1zIhKxW2q",
-	"uINWu3yTz6yZx2IQSjpRkkPs5Oj6WAiDRx274+zccuQJaq9BoTATQsOlgxEqAUDRUa12yDIVzW3sbvbh",
-	"TEXmfeOFfLf2LrOX9y7Otn/+equOv7E8jRAwlS+NZBaGIOU2i60v/TCeL92yPY4pQdYjQgHaTyiOT81i",
-	"VUoMCMt8/jeqospDjmVkKjq1CXvkthuFLQAuZBX16mIiZmEFQ3sQdDshq7CEtZjFf7XAh0p89TzTbhVF",
-	"DtNuF0Uacql4cT2bqLxL94WMyQULyqhCpjegJUhoWzjmjvqJn/QDjaagM3/ZeLS+pVLdmBljHKnmPX2P",
-	"w1RLhfgWWdmrWJgxHMf5YKm3/f87XSx5jd0WyUaEC6W1tVfmI2e2Fl5PThtnG5TXttdMZ6tZpJEHYcNj",
-	"U+ZYcFox5vl3Sh5szRWDfX1SZfon83vOdIoFTkCB0At+D3TuZGqk4pZlaQu+KkszR/vuS7m7Gqcv6yWh",
-	"IcFKPB0SLFI9SZj5Q8hrUNeEejGO+xBQmOpjWjP3cjzmfuEKbXnGiKecwEhStovBsNfLa+ZfeFvx8C9O",
-	"2R+bS61h4X9XqKwxytu+qmRqqXIn7EdjDHgPbSHwrZ4wsQj4FrbK3ISFlpzKqa7l7YZgx/etqcyxn2ic",
-	"fKbsA+uR1LzmexAs0el8ksvoyWx29VklHFr9rgSnlOkyWU5Dy9bIqY6DfB1pO4IwIRM6aleEIFynt+nQ",
-	"tVQ7dt8z63Hpn4rj53wISPh+Qox8MPI8npRjG1N7efWmnOan5GsG5u4v56TsVTuq37dV7slHaq+oVzYw",
-	"DijlHLA8US9yMSrQdn7sCnqlSJcJeg1tYiMHPQf4OtDHwelWekdCm/yrwnjdzXqGQNcYphICS3YmWwIO",
-	"Y6e5GLw6/IuxHW7StWHk0DHEz+aO4D0PuJX7xO+C/GHnndsJPuDoqwA5Fbe316iOZKZP4Uwx2tjOoeW9",
-	"gx7+xK8RKS6YG1Q606+VGlRs1HMRUQ4jmW0SqtQ1r0P0FnAavgyOSHHXGjsN0LSsN4YpfSzZGZc8Lapt",
-	"8763kSD2NAREJbICn7ZW2CVsAwYCRlJOiw5cq7tVotQ7Lhvn25R3+utHuRip9fP3CZeOjPXzTPHUtE1I",
-	"tDkg28JXwuKiUGLD8ob3xoPLdMT3qslsU3cJwlXqLiPugHPH6O+J+vp3hIWiYQwuimZ+V6WVY3aJQFpv",
-	"ih85iFqAPXmWBmyyVZVLZ9OhnVPrekbPYqrFR65RRxkqJltCDaCiuX66JuKLcXwph2iaBZNLojc6Zh7e",
-	"PpuW5JGpm0wIHsls8r7vyfi9Jf2JIXjufCjVFhKKD63+EJGhUMaXIxdDE4oNq9tnO2CaFCAoH8rr5fZo",
-	"UbAtI562pqLFlzzjvLc8fno1IJvUKiC7uiendEZLEIzWXQnlUZYLdaZ6P5EaObEs8fb0/ilIpptcHnlt",
-	"Cms5x66h98wsHeKnkl0aLiabXT6ai/kma7mMe5Udrk3F+Q6X4wfDHoCLsVqn72LcUwYYz3aRvcRAXCCe",
-	"2QBrvpascv8qO1SIt98FnNx+nNBubknm9ku3poTiHQRXaMa/cT5/Gb3H+jOz/9QB/T+Qlk9znC9IgFhJ",
-	"S6QNtBpq/TiIfeElJycmD3GMCOwh5qnpKrBzg1lgPuAMIqXS5Xwe63kRl2r54+LHRfBw9/BbAAAA//+L",
-	"rHSsRFAAAA==",
+	"H4sIAAAAAAAC/+x925LjNrLgryC0G9F2rLqqbM+cPdsd+1Bu2+OecNs9XeWZE3HKIUMkJMFFATQAVrXs",
+	"06/7AfuJ+yUbmQBIkAIosi6S+vJWJZK4JDITec8/J5lcl1IwYfTk2Z8Tna3YmuKf5/mai/OCKQP/lUqW",
+	"TBnO8BmFZzOew98LqdbUTJ5NuDD/9pfJdGI2JbP/siVTk3fTSaYYNSyfUdP6IKeGPTV8zZqPtFFcLOGb",
+	"wYNfc4Gv5kxnipeGSzF5NmEnyxOiWMmomWUrll3PuJgSektVPtMlv47OuWZa0yWD0baeKaZlcTNyE/VH",
+	"883A3VSaqaGAxQl+r7hi+eTZfwLEHDSanfxSfyXnv7HMwBT2YCuzesN+r5iOHG9Jtb6VKo8CAlYo6DoG",
+	"pc6C6jenzYjRBZVlwTMKJ/d1VVy/YTec3SYXR5u3ZzzHn7hhaz0QwO4XqhTddM68jUEvRVZUOcsJF4Td",
+	"MLUhbmZhnmhyyQq2VHRNhDR84dYTQwFtqKlwcf9dscXk2eS/nTYkd+ro7TQAgd3+hf2sC9Lu5uvxd8B1",
+	"B0wHAcGs2DGAYMyGL+qZmajWDoBK3jCgEcXgO/zzlnJTcA3//BLZwLkxTORUZCyCjsBSagpvQw8pjdyu",
+	"JFnTnCEAkRc95QCnAcg6p5rNMsmF3h78BfxMmDBMsZzMN/Z8cMY5W0hl51tXheFlwZmKc2YYZOb2kJoj",
+	"UyznhuVTwhEduFji2NooRq93TnEH5s9umDCzBJcZcTcES2vNLat5EUwsqvXcfmC3NLtl7DoC8gu74YKJ",
+	"pVkRs+K6Pk+SyUoYlhMjAZj6UZi7/74FoO4hRgmjj9tzwc0sp4bu5ujNq+lZdCmFjhAKLH4XD/hZR7aO",
+	"H8bm+5rmyx6KHINwmczjqNZCgMhznqUeDMXRYXcpnjqu0n0RBUhVXJ/D7t8wXRWRo87kes2NYeHNPpey",
+	"YFTA90retu/TvrMC3mAnk7exi9VIQ4sZXQNdBNMFO7+hBY+upLN3XJZ/vTPwNNhTHCSbtJij5FrOEkf/",
+	"Lj4YU3Cn6BhogQh1yVK7rQQ3OvHoXqThh5621hADxgtgVi9FEiD1TbO9xB0c+R6crY+ppXchK/PSsPX2",
+	"HgB3h2smv1dUGG7c3b2gSDVfTCdrLvgaJIYvdnNmN2HfUtO811PaMJILd75FcpF1JQBYVPPI4d/hnt7J",
+	"HYeeA1/TJZtVqogPo2drhld0lG3ZZ7MszWmSeAuQzatiKANOcl4A6QsEYPKod8KqFwQPsIP04oGT579V",
+	"2qyZSONqw8pbW5m8lpobfsOIkU5UnBLBltT/lrM5N9FTV4zqBDQehKHUd4SbKLl5e2fu3PeBLBu17tKo",
+	"IVNngqH1sUUVlwbAXcXEf0bsK1MiFcr0yIMJoApZSFWLtzpu3UCIjTFvKFTLRltR3EeDp9Fbel/JBOgt",
+	"k2lUA4zB7oEsMS0gTXuQs1eVbwlbozCUKSVVgjkzAYo7iz8uuGDxIXeQ7Z0kGZyttaYaQlF4KG6Y4lJc",
+	"ZFKx2GXmng8XBbQfacfhtob2n8XW+C1APq0PpQ6mM519LTb+d1Ktz4W+ZWp78GwleRa5dyaXK0ZKnl2z",
+	"nEj8TRO5INQq8TP7GVlwVsDeaqFk65y7wj5+MRzWN7SoWHxxFHf0nFxNjKrY1QQY09VkQQsN/9Q8aS7f",
+	"sghT6gCvXlYKft/hToeD76cGZg5WVOQR4OlR0BsMt4LOWZEwT/tdb/F64p/VkCPrShsyZ8QgJkymEaHK",
+	"/tJPyTUEL+HlKBe0K3bDBc+nNYx7j+alKCvTez5jodyx6DADIkpVwgVEqCDsLdcGDFx4jHi614yVhBvt",
+	"UFMPM90NO6rHgHsL5L3QvXRz+UvSsLcGzkyK5cz97Uxj/rgm00mI7Pi7xanoHQozJeUqRyhDlZ8OTuxS",
+	"f9zosf3/Td68qtWJrgYsDM1MUgy/k5LEdVnQzUyqvHXVhurPPY1F00m5kkYml61kwWaGm3GKTuu7XkDu",
+	"UH12QXUAhPaw8WF7/p5m19SspIht84apWb8e9xhaNhM5GGXZI6gghcxoct41fTsrqTI84yV1DuSOatg8",
+	"JRktnxO55gYvcSFJwdeoF/bZWuwkhtH1TPM/IiLDT6MHlGpJBf8DDQaIlvHbY8fGrDqB94NXKEjgnNPT",
+	"2iFAl5QLbcgWtGKLKxX/g+nEtbHk2igcfpYVUjM9UosKvpclE2M/B5OynpXVvOB6NVaFq4rEtrShyoxE",
+	"32EOxZpSvSPRfzjLVlQsR+5AV/M11xqAlzOae+2ogxZKAp8gzcuaFDK7JtRYdxGMjro2NcSTLeELUgnN",
+	"TChY9K4lxdCmEyvIjNlZjPvb8duMp4Z668gC5tPLLAPPbER9dXLVGEnAKT4RMe8uHHbl1zlchWk+SZ/G",
+	"4MHKgmZsB/+07xBDr5mYoq0G2CIBtogMEP8LWVCPAWaWdPi/sg/IQsk1TmI/QF4HFqcp0UygzNwKB+g1",
+	"2+zL1tPeibP4TGv+PCXe3gMEGLj8YxQGN87g48O3e30jw2wi44xOTdjCrEQTbMzS98VTiCHILfYQaaM4",
+	"/HdwmIbcrnjBovDotW+1aCY0bPWYsVr8IO2YCxhCR5e1Dzz61Ut4okPMB3JYhwr43ZlJgAedlcAGLN0B",
+	"QFeysPT4nEhR2FiMjJaGQmQAhcgzkbsoIuutqFVwVvAlt9EI98S0d30A3ymh7xZdH14MfXTp8oScew6l",
+	"yZxtpMgJN4SqEN+fXwlFuQYWxw1Zyxumg6cNj9OkKgHzHGM5uRLHK7zeRYxsr+1N8B7xEs9z4pykSIIt",
+	"OeCeQmjXZpUrujAE30AIhR8SWhm5poZntCg2LdlqMj2wQNrexzcBtOyO+IJEYUC49jF2i8pUCqREs2Lq",
+	"lmu2/cUJebkUUsFNJpzx6gRlMWvHwakmEWhHjTSDJNt/rZggZUK8nZI5M7eMiQAlLMdzLKGNN/7Xe8u7",
+	"nWspKbaOlVZT4TO/VfkyJqb9HX/HQD90B+R4jg5aaYFvnYwVQfpNhePdSuHD/VDmA/IA82QzZnRGu5o7",
+	"+EPaazh7+sXZ2dQqLIDNQhIEC1lRHd/9gLC7hxS3BiJLAI9m/nDsRg1yx96LMtuRpmkijDFhmFtVQlgf",
+	"Jcxo/1pwgdr2ZDrJwPNbFAl3ZWclyZv+jrrz8CDc7xkt+uIAmwWwt3RdFvj19U7FtGdGCIe5oAW7S1TW",
+	"uHAhfDst6Atu9EzLIh/gRvQTh4O2htgdzoVsZ3vPVGu+FCx/uIgFS9b3jV17qKAOv716XUnYnOfpqI4H",
+	"WEt6XlxicmrkJ8ORruFU90x26GyjXkZ7ip27it+NfVjXmbh+dcdU/n7cjmFtk3ODqHeD7C70dVa9i1rq",
+	"uft9ege6GxEe2A6K2HX+rRswQAY3a/pwKiOHYLielUzNAhjvQIvIR6k1cLF0m6Vplda9MBh87YGlGOZn",
+	"rKfZvdaY7fPBIihB1UyGsPT4z24ZX66GHE/oF3QfhbMO2X7Cm78LBq2tpRWsL87GuUybnXdNZijhKlbU",
+	"IYwg0KI6Rurj3nlLtEEVA88PjOZMzSVV+bfCqE1PQHZHD6iUYsKQOS1cNGAss2e9BmliMyvYTSsSIXhp",
+	"wZXuieouaO9TRpfVnZyyVFzH16OzlZRF34oTePBPCCiCqBxrsnYhd2TNjOLZMNPaONPr8CxI3G1oHw2A",
+	"7sPc+8LIfqjB3MnZo28bQHXMJ3M00nvrpJElcYcV5Rxc9EF82C7ddpqx0lu5MBQNaBFxnQmj+Igbb4uC",
+	"IibcBk13jARvdbdVg82vLL6tfMlUgoRpZqSKm5Bj2YHgmByGrzvDsTFWDuOxdRCQDb9iRLZOsI1KGKZK",
+	"qszm+BO8m4joKSkrla2oZlOi6GKBMRzZNTNTYhQVegHUJ4J/ZBV1WglphsbI1AG8uMAYYvzIbiNITiuz",
+	"kiNYDVh6UwLvowK+3x1g6HKU/eWhndMeLHYlLVCkzmJAmFIK0A8Li4Thsr2l1C4uqvWaxliNbh70z+df",
+	"jM3wk4/Bun920CizynCm/9oRejrpsCeP7eFScMOppj3ZVq+lLFJ3A7iWYjG731OINiVc5PyG5xUtQvfm",
+	"lGgJ7oKCgYekMij2YChAtVwybQOUK2F4Af4srsktN6tc0VsRDfTdU7xEgrHaeLuYc0+xBVOK5QRfmBJk",
+	"+HOaXTOBDvycgQo6Ks5aX/OiGBk1PMS+9RpiorIHsHKlfep25R5avXiW5G+7j+AxQfkusebeGGHvN43A",
+	"bXswcJq8phtZjUttvQsB7PDfjPNn7N2E2rg7Qp9ROuMWIXvJ4wHTUQ0Vv4iER4x3hnU2MXLBuif527vI",
+	"h106NQh2m9Vw4PiyrCERdL0eKSRt33xcg2CP4W/bBLp9mRlDs9WaRUM1fuDi2sYMFDwHbn7DcyY1Oomt",
+	"YWVNYam0GMXQ7xZNvZZ3jnFZcEGLlDHqAoOpmCAuVhbjTOp42WGe0DvcrINftJFHur/Kj40w0UyRwmbC",
+	"6KGRVJeMrl1eReSodnBMxTCGTapNWsjeg5P4LkpK883AmMUB0XT9DumQ1FpE0Fr+IDpOx+D1kfPPGAj1",
+	"V7IypvxMf04KIO9RlHsvKhyCLGM0rwSk1vKFqw3S5dCJYjF3zA5Cm8vMdJKxSqYye88t+NuE07/+OJFQ",
+	"+dqOQeRigVHneGPCf3g147jEjxG3B7G3JVcjMw3GudNjprE3DEbLjLZWMZmjFZ4SCNIrGIHvhhnJwEha",
+	"aZaQ/PxT9Dl5KSwqn+Xp6hI9hXraZ7t1XK2Re3Fwp+EigZAPjFwPhB6jQi4e4AS7HrtBhxM9j0bPiwTI",
+	"UeNEGW2qHPgmWdMN0YzZDGtXq6ebA9nrgBmRGNw3TL87ZrfTZbizIxEVUZtsHqbqjD+pHqXuLjzo3gFA",
+	"mL84JvyAZzvK+5WU5x01ylVKiA3ny1ndrf7RwRTTjq2jr6DSPxLCSga+jXhCNay5YDYj5sH0/zBQds+l",
+	"koDldfa0lZpg8xHDMF1aFPLW1fEEw+VzUgkMOYffFoSixzA+32aWCm+2wYHe7QrR2Ew90aSghukwSjgI",
+	"TR5fhwVDJiPsliGvhYnxViTa8KLA9Cgd7jxq81QMCwH38I8xydJeLG+NOvVI6XbQRsQkbqfl8RrF6zpl",
+	"RlUstruHR84IzvXnKXQB3P/2SDdJa/AeSPaWvPXG0A5Gr+St8BEf7h6PuqXic/bZRx7Vg1IqKRe2ZkM8",
+	"HwQepwQAhNCYOwNeT2vQLsUwbW3+eGpA1ZBtQ21gtlwHpQJU7jB8A+Ed2hApUNZskAHta/Xhgy9INQVY",
+	"tophDsagGhaV4sOo4w265PdTdBBdXYfwiGO2FwYd7NIo1xv/4o78j605NGNRffmG0QLTgTIbSWJjIODE",
+	"O56/9lCzFdWriP+RvSUX358//fKv/+Zvd3h72lgzIfEID29nzW9Pfe4WtMuJEZuFyKwjFcduCwviVKB9",
+	"n3XvlgsxXKaFtznUwsEZhyr/nsYj+wmT6Gvod3b0S5J8HrXAZAp5+2/uNKLGT/NB5YDIFGngXdZnuMdr",
+	"2UUiDX7f7ci5Ax4lgKFZUiuYoTXxLigmUfCO5XS3J0PfPy0e5rj2Y2SJC5T/WtlMPeV2RG6pJkHngbGh",
+	"szAMG4FRuzUd+8ZIkKY0Ql8Xx4afNBtnOdq/yA1TfMGZJhDgEgjY2yKaX9YQEW1ECfUAgAEz3qlReIRM",
+	"xl4lTbDgGonFrbMSvSYYnGPTZmlZEs3Mc6eMg1r+6uWPL2fnr1/Pfn7zA9zlQhqSSbHgy0rF0cfj2XAn",
+	"cU1rES9NF3uiHShotiKa54wsmdHWAUobfEeZsz7KqJiuDCT603WkGgkXHFL4LYAIvsUMU1MYf3ZVnZ19",
+	"lQHk8a/d8YPO8htOuaW1NwCM4QGmGb5hpVT3LubdZC1G49jK2bzajKmKExSnv0tgnH0hWaS+ewUHb8dD",
+	"4Vp7iIISzc7H1Ifooq5r26FtqtRmhhUyEpSA2fFYo3TqkzKs918xbStM/sGUhEoFmOwbj8ODJyO7Vtzh",
+	"MmQifyRvXrpEOdCbvuP94m+Gqszk2mnv3rDGhOUqFnSR66EnhalZVAOT6fZR9yroFmHSbrko3uww3o0+",
+	"n4cDezylIwaoNDB6kjt0TV59bMwO49bfDPVA6SCdLeqg9LabK7q1lSzj3SbuQoGZzd6a9bp/XsNDoiB3",
+	"jAh5G7ZeooJYCiCaFnFX0J76MqQdjrt0eFj5bDSq41fDxr4Tz5HZ9chEyF26p8ecw/SG2A2qgVseuVsQ",
+	"a5J7fZBTrxXKs6RMeQ/OtzVjdLPYDCwmFQvNsgoJNKPrstIE24lZSZ+GttqgGVzM2TMzK66xF1ncszln",
+	"2jStyiKOTcdoel4R7K2Ztbukbcv4QYc3VOngo3rt5FZWRQ6y/5AAx65A3lpha0fTbSBsLzd2LhCGuKtr",
+	"5EDuF37USCTbDN0WenvcdLlDl6jk4oYbVnfLSrjNXIiprYubrzHaTBSb2IbcgHH9GBU/UIjzWlE2EGDz",
+	"G6qcvvTRZBq3Y6ayBlohsA8Q0zrC7tCJ7/TZvw3mBCtvlpnC7xf2u30XN8Gp+2+yfvCPyCVOzf93ycXY",
+	"QLiYGSA1fqoyPGDe2LDgfaYONbbcZqWpPV7UiWIRRnnDFAgbdTjYgKD1sVTVpMTFbE57SkYKgec3MO1s",
+	"f1Di1aVLKR7Xl8fXbtjmpUzkTHkzAqEL465dn7r8YFdKj4c+4yWHi3mH6bx5cYRB2n8yooRCOE2QeO1h",
+	"2HcqSa/5S9tqyJvn3QxQWS+cjkgV/O+XfLItsdVH3e/i8jBf07c/YBvZybMvz86mBwNtT48lH+fa2WiV",
+	"czPD0nWtZS0KSU2MOcxpvGjiT1AnVzFTKWH7F5/Skp/CyvUp4uswqyeMHk0N6kn4G1IapXnpbbm9+B+l",
+	"eKpLJnI6Lxj5j9e2XnZYjgCxTk+bGsVWKMqKaj6wg8wRu7pADEfYRYHzwoMOACMYy1kTwwqfEvzUVUpO",
+	"YsDU+0GwvquvYmK58oAd7O4LEloXl8gjppPGIwUHNSvQoOS77cWrpw4oWWPfkEsu+gKwLSgHRTZ5zbPX",
+	"kGbfAtJwjdpHZKTeJQlqnCMuXJQ7jxQTeq3kgseihxrWcl9ecQeGMACMxxDdv1dMH4mYY3230lXJCA5i",
+	"+/DqG2cbnd6h2rmQ2yzra1dX4Pz1y5pVXV6QhpP9dEEuHcYS75WsY32eTbrvnr9+OZlObpjSrmruyVcn",
+	"Zz6amZZ88mzy1cnZyVfokjIrRF3kfgj+U1owZf1wSxuzAliPdoiXOWbTaoPVg87te9NJ7RnVk2f/+eeE",
+	"w5y/V0xtvK75bGINyGymmJbFjaufCSfScktYJ1akI3d8UF+1PDLSX89QpbWyEMo4vVEgv8CB25qxuPEv",
+	"z846NVkCa8zpb86T0Mw7iAM0QIuoBF27+cRCd0oEu2XaEKRm+O4vZ1+NWlrfitpNIiOL+E6qOc9zhofQ",
+	"lHiZnAu5pgVnmiwKulzaCxTQFkpkLJWsRE50RoVginyGWIX37ee2uIxG2c+SOoy7hXunf/L83anDFeS8",
+	"Ukcw8Y19IYBrHBcByQNUzMNWgM4V1sBrt+p7X2QZiiMJnCA1DR0QG2Dmv+xvZrtxCEBZAG51sPEVVdfo",
+	"mMK3qCYrKnIISB2KerXkfFobxZNId16/7BraN212v5b55sFg0mmX/+7duy7WvtvCwy8eDg/rXcaO44U3",
+	"vyuWSQyueTedfHn25cNtvtsXO4YT8BwifOhc3jDfEUjJGwqxX4rplXQ9NKGfhm3kQIlmmfTmYYvGZ3tE",
+	"Y1wye5sxlltDss0pfkrxgbuwfDfsnPJiA71FDk3o/2ufhK4YzTfWweMzxnK66VA8IiA8rMP4yA2n5B9v",
+	"kiTf4HOU7ldcG6k2SaGnoYfv3Zt7kRgCKhwgMdRvO7rUHaj9jRninF42ILIBAFnV2+oHWWVW9qru4ZCV",
+	"WeE99kic0d6RQejWIN74cGTuWohvwx8xDyDEhHFDE11lGdN6URWWlr7YHy29FDe04I7XYTFOWBctumhx",
+	"3qyYYWgsvo8O4/CjADMqs+rihNXP+pHCBt09ElZsR/QdCVrYhbng4+PBCntgPWjxT1jwpsVfI9/0Y4W3",
+	"s/TjhddtH4tfHA4n7NQ9193RMQsuuCE5NbSPSQAu1AYJ+OIb+CCJCtbs3GdPeOEM049/pcJMQy5TWBU2",
+	"2seVtWGBz2hRuIfNvu3/v7ybJpDduq9xCY+kNBTVvO0j37PeYMEb0RiKak6cT+ForBgWUoTiOaYkR3+m",
+	"LWRGA4U1OBXMsO2T/gZ/dyd9EJvEXyKuEdimXfHxHIKF1MBDmMZZyN+YOSSoz/ZDPjkzlBd678aXHxNm",
+	"F1An6qpYmYX/bqo5hQiRtDwAETYf9ln+HSNkDqZZUxcm1zlMWJUjwmHHWDDqK4LEWeAP8MKRccAf2ML6",
+	"czN7OK1bHdY7AATg2j+l+W+VNnWBwoRsiy+9qHO7HuG+BztZvZQD3flhB4qoWuzXd1Bz4QvFcm7eL3vh",
+	"K66xM7DCTJGpy+PCoJk61cuHQMumywYsXBBrU0QfGezaWh2PS/qCA/EdQVyRuifa1YlMSQI2JD5Njqfz",
+	"qrgOabK9mm8hYbTgAqtT/GodBVMHUgvmX6eQRqqY8yIgxjTajvcaw7qdOoyxFSdX4pys0I9dD+/KXp2Q",
+	"f3Gzglr5v4K7mJv/DRT5K2JgVRaSohUbNkpKX2nmubW/cDO9EqBpKHlrc/pc1X4slitcWB7NbGV+wF0c",
+	"hi9cu28lb2FsVO1OyBt5G2D/lYihP6YN2ozn5w6FzEpqFrQDKDYnxB4enFWFDfnhRe1GhXN7op352g6R",
+	"0dJ2rG4zyK+r4hrp1vPIAZ5kC8Jx/uNf+rgvVJ05zfRNG+W7EQF7NRvUcHH9DiNU9dpiytQ7CyxYDMtd",
+	"/ee986qfhWLURqS9uPjngV0WX365z7N4gbCHrPBKgyucZbTSjGi5Zg3hcmtg6ZpVLHnYxALoxSQ2yGK0",
+	"i+kDWI5khTDiU4fuO6wunWtzIAU2VWS2KTCooXDXwlf7CcbYFhkGOFjwsDxojzQ0wxqnLD8GQQbkh21R",
+	"5j44ZeV/d5g90q99YQvQ779iN9g57S7rvfNi0Ngd2U0JJEe5o6tvC3cro+QIURVz1l7rRxFU4o4vGVbi",
+	"ENimk5UlCDMeqg6AARFNcZiwAaS6G11ZztgX9ATPP2aqqu+OA5IVEFJAWJ+opqYai58PTiq7AjQAfZrQ",
+	"jAFSzHsWPNoysgxwX+HrxDUO7Yoq/VEhAHBS4AA7j8WE6WdRhftvyNsIBINKQzQTTtw9Id+ggoi/AItV",
+	"LGNgvcCD0Y7jQv0SKOgkiwJw6cu/kJWslD7ZUiV9xtVjWtu6WV17trP56eMqCGgQGubYN1esw0ycXcpa",
+	"nLy5CRglHDwTslqu7NF3EPCCiUAFosL2Mah0L/ot5U2vbvM3efOqzqt8fPKspxtCnH8DrBZoEfW5nxEZ",
+	"frn9VgMO2P4uP3Ozpschh3r8g3qcA8hHDKj4hND8mAyf53lO6PbxplQye9QB3g90PofHfyz+F3ceiq3l",
+	"zRGdyBtcz90PpUmzDHhSe2pXNAlyI0pNFFtybeyRzWTJxLT9k60pNiWqwhrAeD/+VuVLLpbPr0RJtfEj",
+	"LbiwJZHhlQwcAkUBJudvFF24JnBoEi449qRHSwDsSMfsscB3vm/2cldrkLf81KXSYMG2+vLCIJp1dt/9",
+	"zW4ffrX7n0wnbvfYM0j4lnb1fg9mRqqhNSamKECXCN9fhQfg8S34cRfbb5b0OGy/Hv+gbD8A/Dag64fH",
+	"G3JUH2iKw7ROfJvRnAaLx5T1PmHo1aaGyHnw2WSvFBLMPIRYXm3CttNRa+sX+/Ru2Mb5/A+Wx4gWXTCB",
+	"EvVEBycc7gP5tFkxrojlnEyPOPRhN3/IAY7l5m9I8mgD0MaRZDoU7eDgP9s3lz14ZBoatilBAcNTmGsJ",
+	"5StXQ0SEDeJIB7GtgpNL3byViXXAwAazNqWr/uCJ9oB57mgd1sKXQirITdSMkVP7++cnV+IbatwABV+w",
+	"bJPhejRU27QNL0CUWyj5BxPPtuVHPaMG+29cifAZjgDPUZgMH+Q4HYDKFqSG/3EAL3NC4AFYZorCvYsO",
+	"SSeGESlOyCvs85W7dVPfBKvgjFCIhCDrCjtj0c2V4AK3tqhMpVhM9PwZiz4cgnSOS0LaO+26ahsHMxs5",
+	"ErEEbPEbcQp7FWQrKpYflVMqFWxr6eMhBEfvvW3EwD7RMSU4vgcX26OKp16XawHyuGIAQqnTRgA8PO6c",
+	"sre+HUEUhb7Fx0eNRIPjv7oVxDC4h5RMhaCe2tq6sqjWAp/BEsmCs+J4JF57KCE+PNFtdMHgQ1w5FfoW",
+	"a6rqvlCk8Xhjw9fSbqPzls7kYtedFGGFpql3LcxZIQVKJa51Yj0xyDCKEX3NyxKMYm+w3Jf2sb/NBCi+",
+	"uMvmhHyHF9KtDdq8Evi7tcJtzAr+4ItW+LAmubT+UG5IhQUUoRJpSZXhGS+pMDoV/Wj7RB6aPh4h57L5",
+	"stnmIwpCj8rrX1jEiPD6A8hL3VCehqdDEPENU4dkM0ciLFl0I5pBPdWiY3+xetLDcbI/g/9eYgBPP2e7",
+	"bJiPME475QtulTwfZz61LBgOeM20pksGTEfauPIlv2EYcF7zH9S00tznuYuZ8Q0cmgUj57sSKwax3wS1",
+	"WAK+EBs76JwG9Wo14cJIwk2MnaVZ2X442TQ6bOtwjp5XPjqfHM8eI1FYzWPi8xX2X0onFAmoCWWCT1yx",
+	"N55x6pgBgMoTeZcr3Jc5btpG6u7R2W7wTZu+5rRs84wTck4WirHccaSlZNoLdyDrMW2eutjmKxFjVJDC",
+	"I107dgwhgi+Bo2ID2HCrT7S3zVGRX4kEO47xu3+54b5Tcn2MFveQTOut751OL1vHC+ZIPGKWH9iFs2ea",
+	"bOxtddykV1/qwOsWsXrsinmWaOtq3eEi7mqtrkVzIB5AdpbtlEgziCToaEfwqKGxqU9Ma36clVJzeHM7",
+	"Kg9wcHMpPzCzLu7q0H7vEZe0ruY2N2zv1P8mdDk4RwwGfBzwTjxU3n2UzBGVMOxxt/MpdeECQSQNcKE/",
+	"8jt48cMx3cJ2vvOGtV06/He1GU5PMXlW5UztHQ0j18D9XJddh2PItxf2uMc4MhGcNo64VkC5Ijwn14yV",
+	"7j9nEnzuwEkKtjAE0pupYt67H3x9JdwHJ+RcuI9Zbj/2YeHW9EbgEAEcuZIlVGJYSZ4xm9dMWx9ciTnL",
+	"ML3SISm5XfGCdcVYEDcsAN2kcc314nA08vC3FGzg0Ia2UZR5QW9sYOL6YDY1i8gfkYrY8CGpHGGl83ms",
+	"DraL09xLZ4SwAjbQEfl3++6Hc4/hhoZQitv5UXkZu2hhTzK8PEoll4rpZEUPH9mbVlpAh6x0fR8O0s7P",
+	"87yNMe81U8cdnOf5KMYesQngOGE6xNkhClAhjnwy1XX5MKJ4usb9NXMlcjwEyWILgjtJrJ8Bn/4J47/s",
+	"jzG1yRKHIK64hd8ueR8WNUs9R5C8cjRuNpc2Y9ExiGzOpGL6ftjIxfKUas2Xoq6ylpQNLHsMXv7AhINm",
+	"a4PKlDSAmEJflt+8fHFEsUlF4ZGmWawVGobiTkpYsEKSVyznfgbmcRN7BTO6fqJBMgFHyAn59i3X1jka",
+	"rAa02WtWRjVGC+IPRa7AzRzI6dhaQarA0o/YzxHC7QS7DQ/pICUgagGmEtcC0MnhEdzhmRSLgmcYGgin",
+	"pz6u4hAR+1bX4ImH50FmM74dK3jI6wLKz8ueGAxZalcizx9eVcJarBw0K5ma+Qf2pykpeXaNQWYr5rro",
+	"Q+k+lrsXrgS4HIFpwNaljVRzYzzRyHKinKQyMuAm+gNgJ/WOPrGUuwZ2CY+Jn3hHWCiiVIzmDe+oPfvO",
+	"6PCgDGRnSuUnobObrhnKnSjLrTdenNOSLKg65HXttGUeJGYeZRZpczN2HP/ozfBX1J3QO1PcMMXpTl2K",
+	"i+UL/+6HhdXNzoaF/r6p5opnocdwpwvOp8gp/DSlu1Sp2sSZXx6Yw5B6cnJma4O+neH/5DNXqYp8cfa5",
+	"rfsgKxucCdhTSmUjO6WN0mB8uQIlBqyodk0drxuGw0LhUS/xAKLNGRNu+oTL7HCI8jiCS7CXQ/vP7oKp",
+	"P7Jbj3IHk2AcsUhrAQIi2Ar4+iTKbHnV2gzj/lKMv0FO/3R/QVQ6kjIqRJVJh2RaL/qWVZ4Lv8gT8q0t",
+	"LO4YSc2sMAJ9zhzLeH4lPAbQJcVGoUGGNKOq4MybCaPsBZ68tqs/pG25BuBxsi0HIYTW4ZUtKyqmHPz2",
+	"sA/Gm+z0tvSktwgip/LUtxWd9hGHpnb1LpQ6qGhMqWVNmsPZU+n6Q/b2i7lkdP1aymI/RD+uZwxu4KNC",
+	"DVCfuAg2H2mYAw+vJoWU177SuGF0fTWxXzQIAr/21E0B1ePQh/8IkhxsZ3DRVni5Kdlqb8mjrnjkeoNq",
+	"Eh4/RQSInnxU9ri45kVhS5EoWTDreAFxRGVUu8J2Ocsru1PIz8WyGq4IM3QTgz/trC7lw/iAEVmhJd4j",
+	"8HaA+rfCMHUAvHsEWcBj2oEEgQDTezB7czQh6Dbutskic2j76erfbgvXy+IRjraITCxXxR76NjPokxLq",
+	"iPa48wbJn3n/ja6WS4bZX8gAYn2RMloaykFumW/QJrLiyxXT5koU7IYVrtSnjTN28WXapd7CA92KNSNg",
+	"R8FdkBXcf0JeieCIyIaZ587V7Ob1taxduE7UDQQRs0Aklwie950PdWKQ92IzuXTUu+uSRQjDWawPWPRI",
+	"8z9iAXCfrCVxLoTJGyhwtIjd1STzt3vKcjKI5biReV8N34uy4M4wj/NhMjzVZMFu3XrkglBD1lIbPGJ4",
+	"WEqt+bxgU6LRfYU5q75zHLAfZGRQotdacqFPPEai2GZQ2lDDaslI5ERbcalkjgsLqgk3JKPihPwo5xJu",
+	"M03KCn3QTiqihRTsGaF1M3ebsnHDrPykCW3GooKwt0b5NqG+1klo4cYxcX+21jv2udOWf2Lx9luu2ZXQ",
+	"hm50qD+ckAsvMKJ7iIqgq1wroBxFQJdWErUP2dPaM8ec/hnhJY6WnUleb9WgeaLRcg8wmyHMpCIwerSW",
+	"Mv+DtSopH0ZpgW1d1PQwKIejTZcH5aufOGdourEH45uFPizfBKF1YNLGa/vuB6TYw4Yu+bCGD/gyMZwp",
+	"iMiE80BrONaZj7VkgW4eBMFrS4hxQYvmk9EuRcODvqrAWjVcZPW1s5YuqqkWdy3LRzYPs7qoJ8We42/6",
+	"SugVtbdUsBEGU91ygRI2Lv6E2GOP+Rttl0q7nLKaF1jSfVdy3t6x6DFM9g5v9KGdjKMw2NrwywaPD8bi",
+	"HRVJ1UIhr8jXqPTpFkh4G4MzvLurEQfRpyXdyMqMuQJeuy8+sIvAbmsIIYVlI0vKndViXfcOOooYfce2",
+	"cX23KyZqUoMs6obG7o4/brykpoVOZikY0YzpYEL33Qn5mi1ksxTsc4lr0WSJBb0LaLbiummfXAm8BmFZ",
+	"7ImPiGE+XsG6Bm20DS1ARJLk7OkXZ2eoz6wZFU8zJoxieR1weCWCKC3qQoTtOPmUaElWVOkVfl8wwZlw",
+	"r7i5rSpL1+x5HZx2u5LWZc7yphy5mwRz763aCQVh8C2okNQEF9c7sLc4FRh+bvSVsNM+sW/o54T9DqqW",
+	"/Y/ALc7COzx2/4YFJN64Y/sAy/++qbtm7xbm7NE7SS7wzuzVqm0J0pkgjY3X6tx+R3UHxULlFBXXtqnP",
+	"aNZx6vaaNhNfGKlqK3GXVoA0a7SfkhIsFSjA4o95kyGEl6WNpHPisa2Lb4sktjbUrpBvZN2baRqxSp+Q",
+	"y4bxowxzJYbmNr+2W/9Eli2y7F4SBy515lxNTejUJ4k0lDDsablLDbkA0qQlmYfKana9ydJFpl3YGxLz",
+	"M1eD5//9n/+73V+D/FfTXO35ldh+vvWV7WFG/qvu4dYzgns3NXNiBPcrfOWZTvhG/SO84FmRazTyNFdQ",
+	"Q9Zxsc9gHpShYCHwSbiKqXVJ4PEwkX9OaKHllVjRElZHKyPX1HCoWLSJMSvsWorl4T6wKnAXiFzH3dwj",
+	"yFTfJyOsj9yG21kq+5QwP3ll0+Ubzhb0u226/rh6pPepp4MV/7Qe3t3jIvjgQ9LNbXxsvblhvowaFJg+",
+	"jwo6bvu4UuhbkrRXFYODdzV4QinzgVBqZNOPI8StB+j50UQLHWtHjxhOPEAbD+sWSlluXoobbhjJZM6C",
+	"7rN6hWUXZKPviNz3oe3vQrvvgJijClNJxV2aIIal29onHngZFX8xowwU3OHOa1R96QbvddviHWoqbh2h",
+	"DdG6tHGg76+sBxs4aJdbiy1x7Gj3tv0UUXm8EZV7rjh86QUWjIZOdiBGyaDDPDqxkt14zr7gAEYLs+ot",
+	"Q2zfeEylCGfoA80FUzc8Q1O5XfCmAx07BMlWLLsGbbeUvOVwd9ts9l0wmjM1l1TlyTvxZ+1MfGjqsKD9",
+	"dc2M4tmvJ+Q7qciv6Jv/FQFeG/PxN8KogtPwYVRMcZlfic9whU+5CK5SQm+pyqHZaMlE7i0qaHNcMFU3",
+	"qcJk4c9PyK/UGCZyKjL265XAXzVphm1NiCP9msn1uhLcbGZvS7vW+hfyH6/J0uINRgCckF/hHfwMo9x+",
+	"JVivtw48cRFwpZILW7gXmi9ga9Rg2hPyE0oPPmoMhsuKak4s1EOYPvf+H3hnCbzaGVXrMDIM3LWFlZ9o",
+	"LAOFsckAaWpI9zBP1yzhDfmheWn7cmsf/C1j1/bKlMKsCHWbK6g25H/ig6/OSE43cGaMastOzaruwep+",
+	"TUSLWRi14sXcRT55NqFFMZnWnfhhIZPpBJcBH/hh4a1I6/xpvNW/RdnEhIitwZT+/7flZDpBFID5apSb",
+	"TCchOiVW0bl4qLi28mSFFIUoyjVgw7JirqNu9xztQ/15Aoj2cSzobhc0MPYxDowvzqYgXPE1gOKLM/iP",
+	"C/dfT+5pZwK5WGiWmCEc8uxQsmxACYNznIJv9i64/OzrdSHhTInFZ5BaLBb40jsNLTo6TLiyAjRzF2nA",
+	"LWti8VdH8Hb8/vComrxHfrDPybzKrplxRDDfkDCamGgU4TfAbktmbNcbUjKov57gZsuKBaeSUrY+dubz",
+	"rc3LQ92/ZhqPyxf2RcTLil0YiiKDHmQh8y8HwJhiyp42Pqb9MG7xNoUDOTu0aRPwpSwd9ciFcxDXRzqc",
+	"XFsVorZdgbWoAQJKXatXg1zeYUA2TsRCEYIyIeaeY2tAWRnNcys1GFlGKfjVZosPf6Lgnmt794X/yyPq",
+	"KNt3Zu8deaCkyXvclB+Ttn+51arFKiMJeaHmCMXWAY/hOxbo/Y6dC/fOPm4QO9egi8OuakoKaoLYqW0j",
+	"ZwggXW8lDqLGuhmzQV54XvUY9kE7+IFsgx7sKTAfzD74imsMIgCGOyVzmpMcjhtYBgRQFrT06V/W86lr",
+	"/DkKX0ptIHPMLeErGUyn6DXpK3zyDf5eI+qx1D1xWGRXvX8sctO32iV647KNlzkajLEH2GAMxgT73lMY",
+	"mGkXPAyVpnGR8oUdwiEVUdjKHq8VqrIVxx5KmCekvXT+3CZjWosSBkSDUFWHW9USKY74RFsqjYqY+8TN",
+	"8daWv7731pbdbD7Qz5KkgtnBoR63V1moS68RGagmkLqFaLPcntu9ilzuP2PBib3zzKMQIc72J0LYuh4H",
+	"Y/6Wbb4fMsRxkZulj4e6knZJN6c4WE+crb2h7E1U0/zUOazgeJdNg28jSx+uagNFpqQSBdM6uK2uREYV",
+	"muOskwxwYkoU08xon+usT4jTN1yGoJZ+062gVZuPijZTJqIpqHj1HlY+2yPNh8LVAeU9W6rRVUM5YuEP",
+	"kaMhNCFvx9GUYLf9qvyP8MIgi56hy0e1aw2yBuByhziCQM+37ReicU7wO8Em5QULxQN8f5fW72D2GBc2",
+	"DH3QmCAL4GgBaN3W+Y9JmQ6PM0Ug7mhDyhioPPfQyCFUZzyKluJ8TFrqiKOYJqN5Dgnxs/3QkgPR0WQP",
+	"OBXK5gaHhxjljmmtac9HdzQseE9o09KZjoDua1XgPiz4tB7vz16WcOFe+yA4g99MNEnEPToi3nD+8umS",
+	"CTgUlhP3yMWl9HMLf9qlkmv5FEP3eyXS1/DeC3xtT1k8drqB2flradMPjitfp2wWliI+vZLlTtG2gcaj",
+	"9RSw4x9UyA3OvO+MD+bj8tWIcq4xcvb4BO4G33agW4T6B0rdISoei+gd4MbRCuCjjwZJsJ8n/8O+siMg",
+	"+RyMYAWwIy5oZsAnhGndb0s4FPK7HyRmXeAiK6qcuWT1uKNkQQvN6qObS1kwKvZlefhH5Zqt7rojLKym",
+	"REspmDYkZzQvuGDpeAQLGKINLwrIqsDY7FZaoz85++ZOLm7X+jgcHMc+EN/+RzNpBOYHZ9e/Vwfux9su",
+	"giExjxcXleIENT61ecHgDG+E++4M3A6tu8IZURqfuOySIFiv+YWWpcKaA4BjkH3K8hGxebi3Gc8n970Y",
+	"Hou73COTXBZ5p1zUEWCgpcoGl4itmAYoWbF7oKTVGR0yhH6hTvNj+0IXtO+zbcIxX4DiA1bAH4WYfYhI",
+	"agI9RCtWxyemmH3VoMvUdk5flwWDl11VccVo9nEV8gyOKdk+3R4foSHRYjEzatM1FQOf6r1J1zLvNOW+",
+	"weefCHd/hFtfpwckXKDTNul+os52nV04ozZxjqREr3mnjKxecXjPzas79IScGcoLfZyul9/dEcRUvmiD",
+	"M/jYKttGElSR4Q9tZBnRJbcDMK0Bf98Hfwx66d7w7VBxbkejlx4JndW+qjvpxLHSZ/GANCjkFNxpXigO",
+	"+XbJVN0uBwQsnAMKNvuLGCu6ZlRA12PF8EsD7QkjzWrg0QdCv80teEgL02Cp6ZZy44PdrW57sHSYUkm5",
+	"mDrMdoGIQVhbjUB7J0bLAtMdZGBddvUQr+X1NOz+kbwKPWUqulgUO9yJb9w7+7Di2LnGxKf5HUSswu7R",
+	"FKsfATvwac8NQPzX6SJc50RRkcs10VhMS5PGhwtshxtNLr4/f/rlX/+NrKhe2eZevtQx1fjZDJ/MbU18",
+	"KjbEcKwQwDXRsshT9bkcMB6HW9jBD+pE9IcdKWGFTw5ujlY1Nh6X79CuK3X9NjjdofKdykuNcO+59rIT",
+	"sY5af1H+FIYd6Gmu6G1alHrDbhgtfHA+tGeGrOhbbgsY1YzoSnyGvJH8O5lvMJNj4fnaZ1eTq+rs7KsM",
+	"Pse/2DP7g/1az4CJ2QdXk8+B6VEy58unIMBhD0E888/JWuYk/IT8D/JFLJ7/G0VvPx5UVPRW7L9GoJ3c",
+	"CzfBIo4oZN+xOWwwDp1VjENcpu7G+k4d8qXNpl9XGwuZS/fm+6wPhDt5xBt+hFxn1zKovKqFP5nLarky",
+	"hyIPn9/muGTL72ErbBjCBCzR5jl18PjrauNx2GGeTYqz7/ZhLcaX9NaPWMnyJQJ+LxUk3Gxj5HLYArGj",
+	"R0Tz4OnI+Lp6LY+U7+mGP6hg3MA7Ipsatj7eNJL6XAdGTsG/p1jUUlY97qwX7g0AzCMdvJ/iQEf+k8qZ",
+	"ioEcH7i2R3vng9+uS7MhGVVmusXs0NlUWVo3MruOMD8NtbtoEVC7rTvsBF2Je+7HjbJS2YrqXRHP9VuD",
+	"wmdgKePDWBJBMZVm6k6DdXVODN8j9YbJmuaMUANwpguDne24JoavU5XtFkqu48vIoaeM+3JnJb3ESpz5",
+	"YtcijBy/hP00m3DbGdasym19CqH5xxcXhHcoLYrgjEbw2/qjdom8bbJ6tQkJ6z06ooMVONs+peaE5KKv",
+	"YHfsoBQrpYLF0D4bLRSAgRfe4NufOOD7yAG3lvBjBY04EGdkSebVBgsFSVdXKDlzeRSFTXtl2wBVo42s",
+	"C6aJcs+PhN2eL5eKLalhVpJBckQHJEq6WMW9OaQRjHhg5bFG4zmWLA5UQo42f+NOSggaiObVptc4dICj",
+	"eHhNB4xc+4mga+7z9P1NdJVlTOtFVRwkdG6kShNiV9SYk8Qt4Bp9xP6iYFR5BAM2+AHUsumxY8AOiWJB",
+	"B8SPOrLmDULClaDG3uI8Y4CIw9lZoqjABTMHwqrHs87BRg5Vk20XTsOLeVUc0G2NCHTLRS5vP1HW5MKd",
+	"B6EWMJaybN79WEkBuyqd/ia5SDtcsei0zBn2YcOqZnNGltjOl1o3bM5YSQourl3n3poeyWfYyc06V2EM",
+	"/It9vh0g8nfJhWvf9liN1WCKA9FYqq0aLOkQ/XIZXROuyaIqimmr9/Kn9moRSKUi1f4ua6g0FUZ504+y",
+	"p3uZpbud1irfjPJ42kS+sv1pj91I1bVLPdF22TuPZFdE0Z5bTO6ZH13WBH40MUTGAnz3qZ26ToL9Fxm8",
+	"/0STYDuuJ70rBirYrW9JOCW3K+kYIl9wDMAml6xgS0XXJ/G+8wumsIenW8p734vU7uPIbs3LmuF2Tuwg",
+	"+jayGo8xrsQ5sBx/lVLXg/jTxdk0vUQLo6NGrKUbNqa34CKfOaBuibA9LKBg9KZjk4g1ULHjrittyGp7",
+	"KTZssCkBvGn3UbTLm14JLsjtimcrklHNmjF8EHXIYqiqTQMn5DW4vjVZKMZyUomcqSsBX5dYL4uXVBhY",
+	"I1lKz5QgqaDg2pyQN1Ib34fSVt/3xYSvRNPlH6VwHYtE/AEAdMg77C+xLnOLpsv0/mXhKEY0iFDTdAPe",
+	"FdW+TvAnom7kLkCtURd2kNW7Q+I6SMr3Y9mQbZf8/oyi11ut9I8A077a78XqNKvw9jwYrrvgyFWdO2sM",
+	"y8mGmUTnLSdmllvHSD6z+wnaKOvP4y30Y6m952Lj70c0pVrdc4XhxcF9w3U02zCjLpXewM1VsCvRZmxN",
+	"W7UT0uCnJoXMrgk1W636m23NfEmrK/EZE/kMsyr5glRCM/N57Ca6cBfRB1LNYYuqDyQzD+IuF7Sde3o4",
+	"w267JIsOcI4q1qq8/4nzHcUt77IzpSIs5zFe13P1YwfUPqMXNBdlj9mwHxrjx7b8IgxhOiJbFlwn3owF",
+	"LzFhYBGgN2implYJtt1MArjDsxjcTxVbMKVoke74/CO7dY1qwfaBaaZwxK+44OS8dD1wukZ+xRZbJn6r",
+	"MymaXdus0XrqkyvxU93/5IYpvtjYBCuf+z6XZuXWsGTG1ReaoVf6OWE0W9Xtp+WSiyYhHsZHG43rsJLo",
+	"pv9q86aGwmOmSblJeko6+1es5wTEgRpIx2tdrVuLto2rKrEXh6m7sXOX3RUo97WSC/4hhBGEm4lGkswL",
+	"npHSv/ExqZgAmv6ebs5K5BCvbMMqgmYwAlM38fq0P8iMFiSHds+yXANC23cn00mlismzycqY8tnpaQHv",
+	"raQ2z/797N/PJu9+eff/BwByaqJxTLwBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
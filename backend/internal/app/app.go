@@ -16,6 +16,7 @@ import (
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/handler"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/middleware"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/service"
 )
@@ -24,6 +25,14 @@ type App struct {
 	cfg    *config.Config
 	pool   *pgxpool.Pool
 	server *http.Server
+	jobs   []job
+}
+
+// job is background work run every interval while the server is up.
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(context.Context) error
 }
 
 func New(cfg *config.Config) (*App, error) {
@@ -46,26 +55,80 @@ func New(cfg *config.Config) (*App, error) {
 
 	// Repositories
 	userRepo := repository.NewUserRepository(pool)
+	referralRepo := repository.NewReferralRepository(pool)
+	xpRepo := repository.NewXPRepository(pool)
 	newsRepo := repository.NewNewsRepository(pool)
 	hackathonRepo := repository.NewHackathonRepository(pool)
+	teamRepo := repository.NewTeamRepository(pool)
+	projectRepo := repository.NewProjectRepository(pool)
+	judgingRepo := repository.NewJudgingRepository(pool)
 	attendanceRepo := repository.NewAttendanceRepository(pool)
 	clubRepo := repository.NewClubRepository(pool)
 	govRepo := repository.NewGovRepository(pool)
 	shopRepo := repository.NewShopRepository(pool)
+	raffleRepo := repository.NewRaffleRepository(pool)
+	questRepo := repository.NewQuestRepository(pool)
+	coinRepo := repository.NewCoinRepository(pool)
+	awardRepo := repository.NewAwardRepository(pool)
+	leaderboardRepo := repository.NewLeaderboardRepository(pool)
+	seasonRepo := repository.NewSeasonRepository(pool)
+	badgeRepo := repository.NewBadgeRepository(pool)
 
 	// Services
-	authService := service.NewAuthService(cfg.BotToken, userRepo)
-	schoolService := service.NewSchoolService(schoolGW, userRepo)
+	events := service.NewEventBus()
+	referralService := service.NewReferralService(referralRepo, userRepo, telegramGW, cfg.ReferralRewardCoins, cfg.MiniAppURL)
+	authService := service.NewAuthService(cfg.BotToken, userRepo, referralService)
+	schoolService := service.NewSchoolService(schoolGW, userRepo, referralService)
 	newsService := service.NewNewsService(newsRepo)
-	hackathonService := service.NewHackathonService(hackathonRepo)
-	attendanceService := service.NewAttendanceService(attendanceRepo)
-	clubService := service.NewClubService(clubRepo)
+	hackathonService := service.NewHackathonService(hackathonRepo, teamRepo, userRepo, telegramGW, events)
+	teamService := service.NewTeamService(teamRepo, hackathonRepo, userRepo, telegramGW, cfg.MiniAppURL)
+	projectService := service.NewProjectService(projectRepo, teamRepo, hackathonRepo)
+	judgingService := service.NewJudgingService(judgingRepo, hackathonRepo, projectRepo, teamRepo, userRepo, telegramGW, events)
+	awardLimits := model.AwardLimits{
+		MaxSingle:         cfg.AwardMaxSingle,
+		ApprovalThreshold: cfg.AwardApprovalThreshold,
+		DailyCap:          cfg.AwardDailyCap,
+	}
+	streakMultipliers, err := model.ParseStreakMultipliers(cfg.StreakMultipliers)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("invalid STREAK_MULTIPLIERS: %w", err)
+	}
+	attendanceService := service.NewAttendanceService(attendanceRepo, awardRepo, awardLimits, events, cfg.CampusTimezone, streakMultipliers)
+	clubService := service.NewClubService(clubRepo, events)
 	govService := service.NewGovService(govRepo)
-	leaderboardService := service.NewLeaderboardService(userRepo)
-	shopService := service.NewShopService(shopRepo)
+	leagues, err := model.ParseLeagues(cfg.LeaderboardLeagues)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("invalid LEADERBOARD_LEAGUES: %w", err)
+	}
+	leaderboardService := service.NewLeaderboardService(leaderboardRepo, seasonRepo, leagues)
+	seasonService := service.NewSeasonService(seasonRepo, leaderboardService, cfg.SeasonBadgeTop)
+	shopService := service.NewShopService(shopRepo, events)
+	raffleService := service.NewRaffleService(raffleRepo, telegramGW)
+	questService := service.NewQuestService(questRepo, userRepo, telegramGW, awardLimits, events)
+	coinService := service.NewCoinService(coinRepo, userRepo, awardRepo, telegramGW, cfg.TransferDailySendLimit, cfg.TransferDailyReceiveLimit, awardLimits)
+	awardService := service.NewAwardService(awardRepo, userRepo, attendanceService, coinService, cfg.AnomalyRepeatCheckIns, cfg.AnomalySpikeMinCoins, cfg.AnomalySpikeFactor)
+	badgeService := service.NewBadgeService(badgeRepo, userRepo, telegramGW)
+	if err := badgeService.Sync(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	events.Subscribe(badgeService.HandleEvent)
+	communityLevels, err := model.ParseCommunityLevels(cfg.CommunityLevels)
+	if err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("invalid COMMUNITY_LEVELS: %w", err)
+	}
+	xpService := service.NewXPService(xpRepo, userRepo, telegramGW, communityLevels)
+	if err := xpService.Sync(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	events.Subscribe(xpService.HandleEvent)
 
 	// Handler
-	h := handler.NewHandler(cfg, authService, schoolService, newsService, hackathonService, attendanceService, clubService, govService, leaderboardService, shopService, aiGW, telegramGW, userRepo)
+	h := handler.NewHandler(cfg, authService, schoolService, newsService, hackathonService, teamService, projectService, judgingService, attendanceService, clubService, govService, leaderboardService, seasonService, shopService, raffleService, coinService, awardService, badgeService, questService, referralService, xpService, aiGW, telegramGW, userRepo)
 
 	// Router
 	mux := http.NewServeMux()
@@ -84,13 +147,25 @@ func New(cfg *config.Config) (*App, error) {
 		IdleTimeout:  60 * time.Second,
 	}
 
-	return &App{cfg: cfg, pool: pool, server: server}, nil
+	jobs := []job{
+		{name: "anomaly scan", interval: cfg.AnomalyScanInterval, run: awardService.ScanAnomalies},
+		{name: "season close", interval: cfg.SeasonCloseInterval, run: seasonService.CloseDue},
+		{name: "hackathon schedule", interval: cfg.HackathonScheduleInterval, run: hackathonService.Advance},
+	}
+
+	return &App{cfg: cfg, pool: pool, server: server, jobs: jobs}, nil
 }
 
 func (a *App) Run() error {
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	for _, j := range a.jobs {
+		go runJob(jobCtx, j)
+	}
+
 	go func() {
 		log.Printf("Server starting on :%s\n", a.cfg.Port)
 		if err := a.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -100,6 +175,7 @@ func (a *App) Run() error {
 
 	<-quit
 	log.Println("Shutting down server...")
+	stopJobs()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -112,3 +188,23 @@ func (a *App) Run() error {
 	log.Println("Server stopped gracefully")
 	return nil
 }
+
+// runJob calls j.run every j.interval until ctx is cancelled. A non-positive
+// interval disables the job.
+func runJob(ctx context.Context, j job) {
+	if j.interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.run(ctx); err != nil {
+				log.Printf("Background job %q failed: %v", j.name, err)
+			}
+		}
+	}
+}
@@ -3,6 +3,9 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"time"
+	_ "time/tzdata" // CAMPUS_TIMEZONE must resolve in minimal containers
 )
 
 type Config struct {
@@ -13,6 +16,44 @@ type Config struct {
 	OpenAIAPIKey  string
 	AdminUsername string
 	AdminPassword string
+
+	// Peer-to-peer coin transfer limits over a rolling 24 hours (0 = unlimited)
+	TransferDailySendLimit    int
+	TransferDailyReceiveLimit int
+
+	// Referrals: coins paid to both users once the referred user verifies
+	ReferralRewardCoins int
+	// Mini App link used to build referral deep links, e.g. "https://t.me/ts_bot/app"
+	MiniAppURL string
+
+	// Community XP needed for level 2, 3, ..., e.g. "100,250,500"
+	CommunityLevels string
+
+	// Campus timezone, e.g. for weekly attendance streaks
+	CampusTimezone string
+	// Streak multipliers as "weeks:factor" pairs, e.g. "2:1.1,4:1.25"
+	StreakMultipliers string
+
+	// How often hackathons are moved along their lifecycle as dates pass
+	HackathonScheduleInterval time.Duration
+
+	// Leaderboard leagues by school level, e.g. "bronze:0-4,silver:5-"
+	LeaderboardLeagues string
+
+	// Seasons: finishers ranked up to SeasonBadgeTop get a badge when a season closes
+	SeasonBadgeTop      int
+	SeasonCloseInterval time.Duration
+
+	// Admin award safeguards (0 = unlimited)
+	AwardMaxSingle         int // largest single award
+	AwardApprovalThreshold int // awards above this need a second admin
+	AwardDailyCap          int // per admin over a rolling 24 hours
+
+	// Background anomaly scanner feeding the admin alert feed
+	AnomalyScanInterval   time.Duration
+	AnomalyRepeatCheckIns int // same admin + student check-ins within 7 days
+	AnomalySpikeMinCoins  int // ignore spikes below this many coins in 24 hours
+	AnomalySpikeFactor    int // spike = 24h awards above factor x 30-day daily average
 }
 
 func Load() (*Config, error) {
@@ -24,6 +65,33 @@ func Load() (*Config, error) {
 		OpenAIAPIKey:  getEnv("OPENAI_API_KEY", ""),
 		AdminUsername: getEnv("ADMIN_USERNAME", "admin"),
 		AdminPassword: getEnv("ADMIN_PASSWORD", "admin"),
+
+		TransferDailySendLimit:    getEnvInt("TRANSFER_DAILY_SEND_LIMIT", 200),
+		TransferDailyReceiveLimit: getEnvInt("TRANSFER_DAILY_RECEIVE_LIMIT", 500),
+
+		ReferralRewardCoins: getEnvInt("REFERRAL_REWARD_COINS", 50),
+		MiniAppURL:          getEnv("MINI_APP_URL", ""),
+
+		CommunityLevels: getEnv("COMMUNITY_LEVELS", "100,250,500,1000,2000,3500,5000"),
+
+		CampusTimezone:    getEnv("CAMPUS_TIMEZONE", "Asia/Almaty"),
+		StreakMultipliers: getEnv("STREAK_MULTIPLIERS", "2:1.1,4:1.25,8:1.5"),
+
+		HackathonScheduleInterval: getEnvDuration("HACKATHON_SCHEDULE_INTERVAL", time.Minute),
+
+		LeaderboardLeagues: getEnv("LEADERBOARD_LEAGUES", "bronze:0-4,silver:5-9,gold:10-14,platinum:15-"),
+
+		SeasonBadgeTop:      getEnvInt("SEASON_BADGE_TOP", 3),
+		SeasonCloseInterval: getEnvDuration("SEASON_CLOSE_INTERVAL", 5*time.Minute),
+
+		AwardMaxSingle:         getEnvInt("AWARD_MAX_SINGLE", 1000),
+		AwardApprovalThreshold: getEnvInt("AWARD_APPROVAL_THRESHOLD", 200),
+		AwardDailyCap:          getEnvInt("AWARD_DAILY_CAP", 3000),
+
+		AnomalyScanInterval:   getEnvDuration("ANOMALY_SCAN_INTERVAL", 15*time.Minute),
+		AnomalyRepeatCheckIns: getEnvInt("ANOMALY_REPEAT_CHECKINS", 3),
+		AnomalySpikeMinCoins:  getEnvInt("ANOMALY_SPIKE_MIN_COINS", 500),
+		AnomalySpikeFactor:    getEnvInt("ANOMALY_SPIKE_FACTOR", 5),
 	}
 
 	if cfg.DatabaseURL == "" {
@@ -34,6 +102,10 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("BOT_TOKEN is required")
 	}
 
+	if _, err := time.LoadLocation(cfg.CampusTimezone); err != nil {
+		return nil, fmt.Errorf("invalid CAMPUS_TIMEZONE: %w", err)
+	}
+
 	return cfg, nil
 }
 
@@ -43,3 +115,21 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getEnvInt(key string, fallback int) int {
+	if value, ok := os.LookupEnv(key); ok {
+		if n, err := strconv.Atoi(value); err == nil {
+			return n
+		}
+	}
+	return fallback
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	if value, ok := os.LookupEnv(key); ok {
+		if d, err := time.ParseDuration(value); err == nil {
+			return d
+		}
+	}
+	return fallback
+}
@@ -1,10 +1,16 @@
 package handler
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/tomorrow-school/ts-hackathon/backend/generated"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/config"
@@ -21,11 +27,22 @@ type Handler struct {
 	schoolService      *service.SchoolService
 	newsService        *service.NewsService
 	hackathonService   *service.HackathonService
+	teamService        *service.TeamService
+	projectService     *service.ProjectService
+	judgingService     *service.JudgingService
 	attendanceService  *service.AttendanceService
 	clubService        *service.ClubService
 	govService         *service.GovService
 	leaderboardService *service.LeaderboardService
+	seasonService      *service.SeasonService
 	shopService        *service.ShopService
+	raffleService      *service.RaffleService
+	coinService        *service.CoinService
+	awardService       *service.AwardService
+	badgeService       *service.BadgeService
+	questService       *service.QuestService
+	referralService    *service.ReferralService
+	xpService          *service.XPService
 	aiGateway          *gateway.AIGateway
 	telegramGateway    *gateway.TelegramGateway
 	userRepo           *repository.UserRepository
@@ -39,11 +56,22 @@ func NewHandler(
 	schoolService *service.SchoolService,
 	newsService *service.NewsService,
 	hackathonService *service.HackathonService,
+	teamService *service.TeamService,
+	projectService *service.ProjectService,
+	judgingService *service.JudgingService,
 	attendanceService *service.AttendanceService,
 	clubService *service.ClubService,
 	govService *service.GovService,
 	leaderboardService *service.LeaderboardService,
+	seasonService *service.SeasonService,
 	shopService *service.ShopService,
+	raffleService *service.RaffleService,
+	coinService *service.CoinService,
+	awardService *service.AwardService,
+	badgeService *service.BadgeService,
+	questService *service.QuestService,
+	referralService *service.ReferralService,
+	xpService *service.XPService,
 	aiGateway *gateway.AIGateway,
 	telegramGateway *gateway.TelegramGateway,
 	userRepo *repository.UserRepository,
@@ -54,11 +82,22 @@ func NewHandler(
 		schoolService:      schoolService,
 		newsService:        newsService,
 		hackathonService:   hackathonService,
+		teamService:        teamService,
+		projectService:     projectService,
+		judgingService:     judgingService,
 		attendanceService:  attendanceService,
 		clubService:        clubService,
 		govService:         govService,
 		leaderboardService: leaderboardService,
+		seasonService:      seasonService,
 		shopService:        shopService,
+		raffleService:      raffleService,
+		coinService:        coinService,
+		awardService:       awardService,
+		badgeService:       badgeService,
+		questService:       questService,
+		referralService:    referralService,
+		xpService:          xpService,
 		aiGateway:          aiGateway,
 		telegramGateway:    telegramGateway,
 		userRepo:           userRepo,
@@ -137,7 +176,80 @@ func (h *Handler) GetMe(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
 		return
 	}
-	writeJSON(w, http.StatusOK, userToGenerated(user))
+	badges, err := h.badgeService.ListForUser(r.Context(), user.ID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	streak, err := h.attendanceService.Streak(r.Context(), user.ID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := userToGenerated(user)
+	userBadges := badgesToGenerated(badges)
+	result.Badges = &userBadges
+	result.Streak = streakToGenerated(streak)
+	result.NextLevelXp = h.xpService.NextLevelXP(user.CommunityXP)
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) GetUserProfile(w http.ResponseWriter, r *http.Request, id int64) {
+	if middleware.UserFromContext(r.Context()) == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	u, err := h.userRepo.FindByID(r.Context(), id)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if u == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "user not found"})
+		return
+	}
+	badges, err := h.badgeService.ListForUser(r.Context(), u.ID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	streak, err := h.attendanceService.Streak(r.Context(), u.ID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, generated.UserProfile{
+		Id: u.ID, Username: strPtr(u.Username), FirstName: strPtr(u.FirstName), LastName: strPtr(u.LastName),
+		PhotoUrl: strPtr(u.PhotoURL), Role: generated.UserProfileRole(u.Role), SchoolLevel: intPtr(u.SchoolLevel),
+		CommunityXp: u.CommunityXP, CommunityLevel: u.CommunityLevel,
+		Badges: badgesToGenerated(badges), Streak: streakToGenerated(streak),
+	})
+}
+
+func (h *Handler) GetMyReferrals(w http.ResponseWriter, r *http.Request) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	summary, err := h.referralService.Summary(r.Context(), user.ID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	referrals := make([]generated.Referral, len(summary.Referrals))
+	for i, ref := range summary.Referrals {
+		referrals[i] = generated.Referral{
+			Id: ref.ID, RefereeId: ref.Referee.ID, Username: strPtr(ref.Referee.Username),
+			FirstName: strPtr(ref.Referee.FirstName), LastName: strPtr(ref.Referee.LastName),
+			PhotoUrl: strPtr(ref.Referee.PhotoURL), Status: generated.ReferralStatus(ref.Status),
+			Note: strPtr(ref.Note), RewardCoins: ref.RewardCoins, CreatedAt: &ref.CreatedAt, RewardedAt: ref.RewardedAt,
+		}
+	}
+	writeJSON(w, http.StatusOK, generated.ReferralSummary{
+		Code: summary.Code, StartParam: summary.StartParam, Link: strPtr(summary.Link),
+		RewardCoins: summary.RewardCoins, Referrals: referrals,
+	})
 }
 
 // ─── News ────────────────────────────────────────────────────────────────────
@@ -250,7 +362,9 @@ func (h *Handler) ListHackathons(w http.ResponseWriter, r *http.Request, params
 	if params.Status != nil {
 		status = string(*params.Status)
 	}
-	list, err := h.hackathonService.List(r.Context(), status)
+	user := middleware.UserFromContext(r.Context())
+	isAdmin := user != nil && user.Role == model.RoleAdmin
+	list, err := h.hackathonService.List(r.Context(), status, isAdmin)
 	if err != nil {
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
@@ -268,7 +382,8 @@ func (h *Handler) GetHackathon(w http.ResponseWriter, r *http.Request, id int64)
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
-	if hack == nil {
+	user := middleware.UserFromContext(r.Context())
+	if hack == nil || (hack.Status == model.HackathonDraft && (user == nil || user.Role != model.RoleAdmin)) {
 		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "hackathon not found"})
 		return
 	}
@@ -284,14 +399,70 @@ func (h *Handler) CreateHackathon(w http.ResponseWriter, r *http.Request) {
 		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
 		return
 	}
-	result, err := h.hackathonService.Create(r.Context(), &model.Hackathon{Title: req.Title, Description: req.Description, StartDate: req.StartDate, EndDate: req.EndDate})
+	hack := hackathonFromRequest(&req)
+	if req.Status != nil {
+		hack.Status = string(*req.Status)
+	}
+	result, err := h.hackathonService.Create(r.Context(), hack)
 	if err != nil {
-		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		status := http.StatusBadRequest
+		if strings.HasPrefix(err.Error(), "failed to") {
+			status = http.StatusInternalServerError
+		}
+		writeJSON(w, status, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
 	writeJSON(w, http.StatusCreated, hackathonToGenerated(result))
 }
 
+func (h *Handler) UpdateHackathon(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.HackathonCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	hack := hackathonFromRequest(&req)
+	hack.ID = id
+	result, err := h.hackathonService.Update(r.Context(), hack)
+	if err != nil {
+		status := http.StatusBadRequest
+		if err.Error() == "hackathon not found" {
+			status = http.StatusNotFound
+		} else if strings.HasPrefix(err.Error(), "failed to") {
+			status = http.StatusInternalServerError
+		}
+		writeJSON(w, status, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, hackathonToGenerated(result))
+}
+
+func (h *Handler) TransitionHackathon(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.HackathonStatusRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	result, err := h.hackathonService.Transition(r.Context(), id, string(req.Status))
+	if err != nil {
+		status := http.StatusBadRequest
+		if err.Error() == "hackathon not found" {
+			status = http.StatusNotFound
+		} else if strings.HasPrefix(err.Error(), "failed to") {
+			status = http.StatusInternalServerError
+		}
+		writeJSON(w, status, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, hackathonToGenerated(result))
+}
+
 func (h *Handler) DeleteHackathon(w http.ResponseWriter, r *http.Request, id int64) {
 	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
 		return
@@ -318,18 +489,56 @@ func (h *Handler) ApplyToHackathon(w http.ResponseWriter, r *http.Request, id in
 	if req.TeamName != nil {
 		teamName = *req.TeamName
 	}
-	app, err := h.hackathonService.Apply(r.Context(), id, user.ID, teamName)
+	var answers []model.FormAnswer
+	if req.Answers != nil {
+		answers = make([]model.FormAnswer, len(*req.Answers))
+		for i, a := range *req.Answers {
+			answers[i] = model.FormAnswer{FieldID: a.FieldId}
+			if a.Value != nil {
+				answers[i].Value = *a.Value
+			}
+			if a.Choices != nil {
+				answers[i].Choices = *a.Choices
+			}
+		}
+	}
+	app, err := h.hackathonService.Apply(r.Context(), id, user.ID, teamName, req.TeamId, answers)
 	if err != nil {
-		if err.Error() == "already applied to this hackathon" {
+		switch {
+		case err.Error() == "already applied to this hackathon":
 			writeJSON(w, http.StatusConflict, generated.ErrorResponse{Error: err.Error()})
-			return
+		case err.Error() == "hackathon not found" || err.Error() == "team not found":
+			writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: err.Error()})
+		case strings.HasPrefix(err.Error(), "failed to"):
+			writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		default:
+			writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
 		}
-		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
 	writeJSON(w, http.StatusCreated, applicationToGenerated(app))
 }
 
+func (h *Handler) WithdrawFromHackathon(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	if err := h.hackathonService.Withdraw(r.Context(), id, user.ID); err != nil {
+		switch {
+		case err.Error() == "hackathon not found" || err.Error() == "you have not applied to this hackathon":
+			writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: err.Error()})
+		case strings.HasPrefix(err.Error(), "failed to"):
+			writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		default:
+			writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
 func (h *Handler) ListHackathonApplications(w http.ResponseWriter, r *http.Request, id int64) {
 	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
 		return
@@ -346,296 +555,1873 @@ func (h *Handler) ListHackathonApplications(w http.ResponseWriter, r *http.Reque
 	writeJSON(w, http.StatusOK, result)
 }
 
-// ─── Attendance ──────────────────────────────────────────────────────────────
-
-func (h *Handler) AttendanceCheckIn(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) ExportHackathonApplications(w http.ResponseWriter, r *http.Request, id int64) {
 	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
 		return
 	}
-	var req generated.CheckInRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+	var buf bytes.Buffer
+	if err := h.hackathonService.ExportApplicationsCSV(r.Context(), id, &buf); err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
-	a, err := h.attendanceService.CheckIn(r.Context(), req.UserId, req.EventName, req.Coins)
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="applications-%d.csv"`, id))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+func (h *Handler) GetHackathonForm(w http.ResponseWriter, r *http.Request, id int64) {
+	hack, err := h.hackathonService.GetByID(r.Context(), id)
 	if err != nil {
-		if err.Error() == "already checked in for this event today" {
-			writeJSON(w, http.StatusConflict, generated.ErrorResponse{Error: err.Error()})
-			return
-		}
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
-	writeJSON(w, http.StatusCreated, attendanceToGenerated(a))
-}
-
-func (h *Handler) AttendanceHistory(w http.ResponseWriter, r *http.Request) {
 	user := middleware.UserFromContext(r.Context())
-	if user == nil {
-		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+	if hack == nil || (hack.Status == model.HackathonDraft && (user == nil || user.Role != model.RoleAdmin)) {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "hackathon not found"})
 		return
 	}
-	list, err := h.attendanceService.History(r.Context(), user.ID)
+	fields, err := h.hackathonService.Form(r.Context(), id)
 	if err != nil {
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
-	result := make([]generated.Attendance, len(list))
-	for i, a := range list {
-		result[i] = attendanceToGenerated(&a)
-	}
-	writeJSON(w, http.StatusOK, result)
+	writeJSON(w, http.StatusOK, formToGenerated(fields))
 }
 
-// ─── Clubs ───────────────────────────────────────────────────────────────────
+func (h *Handler) SetHackathonForm(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.FormRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	fields := make([]model.FormField, len(req.Fields))
+	for i, f := range req.Fields {
+		fields[i] = model.FormField{Label: f.Label, Type: string(f.Type)}
+		if f.Id != nil {
+			fields[i].ID = *f.Id
+		}
+		if f.Required != nil {
+			fields[i].Required = *f.Required
+		}
+		if f.Choices != nil {
+			fields[i].Choices = *f.Choices
+		}
+	}
+	list, err := h.hackathonService.SetForm(r.Context(), id, fields)
+	if err != nil {
+		switch {
+		case strings.HasSuffix(err.Error(), "not found"):
+			writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: err.Error()})
+		case strings.HasPrefix(err.Error(), "failed to"):
+			writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		default:
+			writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		}
+		return
+	}
+	writeJSON(w, http.StatusOK, formToGenerated(list))
+}
 
-func (h *Handler) ListClubs(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) ListMyHackathonApplications(w http.ResponseWriter, r *http.Request) {
 	user := middleware.UserFromContext(r.Context())
-	var userID *int64
-	if user != nil {
-		userID = &user.ID
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
 	}
-	list, err := h.clubService.List(r.Context(), userID)
+	apps, err := h.hackathonService.MyApplications(r.Context(), user.ID)
 	if err != nil {
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
-	result := make([]generated.Club, len(list))
-	for i, c := range list {
-		result[i] = clubToGenerated(&c)
+	result := make([]generated.HackathonApplication, len(apps))
+	for i, a := range apps {
+		result[i] = applicationToGenerated(&a)
 	}
 	writeJSON(w, http.StatusOK, result)
 }
 
-func (h *Handler) GetClub(w http.ResponseWriter, r *http.Request, id int64) {
-	user := middleware.UserFromContext(r.Context())
-	var userID *int64
-	if user != nil {
-		userID = &user.ID
+func (h *Handler) ReviewHackathonApplication(w http.ResponseWriter, r *http.Request, id int64, applicationId int64) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
 	}
-	c, err := h.clubService.GetByID(r.Context(), id, userID)
-	if err != nil {
-		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+	var req generated.ApplicationReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
 		return
 	}
-	if c == nil {
-		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "club not found"})
+	message := ""
+	if req.Message != nil {
+		message = *req.Message
+	}
+	app, err := h.hackathonService.ReviewApplication(r.Context(), admin.ID, id, applicationId, string(req.Status), message)
+	if err != nil {
+		writeApplicationReviewError(w, err)
 		return
 	}
-	writeJSON(w, http.StatusOK, clubToGenerated(c))
+	writeJSON(w, http.StatusOK, applicationToGenerated(app))
 }
 
-func (h *Handler) CreateClub(w http.ResponseWriter, r *http.Request) {
-	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+func (h *Handler) BulkReviewHackathonApplications(w http.ResponseWriter, r *http.Request, id int64) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
 		return
 	}
-	var req generated.ClubCreateRequest
+	var req generated.ApplicationBulkReviewRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
 		return
 	}
-	c := &model.Club{Name: req.Name}
-	if req.Description != nil {
-		c.Description = *req.Description
-	}
-	if req.ImageUrl != nil {
-		c.ImageURL = *req.ImageUrl
-	}
-	if req.Schedule != nil {
-		c.Schedule = *req.Schedule
+	message := ""
+	if req.Message != nil {
+		message = *req.Message
 	}
-	result, err := h.clubService.Create(r.Context(), c)
+	apps, err := h.hackathonService.ReviewApplications(r.Context(), admin.ID, id, req.ApplicationIds, string(req.Status), message)
 	if err != nil {
-		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		writeApplicationReviewError(w, err)
 		return
 	}
-	writeJSON(w, http.StatusCreated, clubToGenerated(result))
+	result := make([]generated.HackathonApplication, len(apps))
+	for i, a := range apps {
+		result[i] = applicationToGenerated(&a)
+	}
+	writeJSON(w, http.StatusOK, result)
 }
 
-func (h *Handler) DeleteClub(w http.ResponseWriter, r *http.Request, id int64) {
-	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
-		return
-	}
-	if err := h.clubService.Delete(r.Context(), id); err != nil {
+func writeApplicationReviewError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasSuffix(err.Error(), "not found"):
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: err.Error()})
+	case strings.HasPrefix(err.Error(), "failed to"):
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
-		return
+	default:
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
 	}
-	w.WriteHeader(http.StatusNoContent)
 }
 
-func (h *Handler) JoinClub(w http.ResponseWriter, r *http.Request, id int64) {
+// ─── Teams ───────────────────────────────────────────────────────────────────
+
+func (h *Handler) CreateTeam(w http.ResponseWriter, r *http.Request, id int64) {
 	user := middleware.UserFromContext(r.Context())
 	if user == nil {
 		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
 		return
 	}
-	if err := h.clubService.Join(r.Context(), id, user.ID); err != nil {
+	var req generated.TeamCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	t, err := h.teamService.Create(r.Context(), user, id, req.Name, req.MaxSize)
+	if err != nil {
+		writeTeamError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, teamToGenerated(t))
+}
+
+func (h *Handler) ListHackathonTeams(w http.ResponseWriter, r *http.Request, id int64) {
+	list, err := h.teamService.ListForHackathon(r.Context(), middleware.UserFromContext(r.Context()), id)
+	if err != nil {
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
-	c, _ := h.clubService.GetByID(r.Context(), id, &user.ID)
-	if c != nil {
-		writeJSON(w, http.StatusOK, clubToGenerated(c))
-	} else {
-		w.WriteHeader(http.StatusOK)
+	result := make([]generated.Team, len(list))
+	for i, t := range list {
+		result[i] = teamToGenerated(&t)
 	}
+	writeJSON(w, http.StatusOK, result)
 }
 
-func (h *Handler) LeaveClub(w http.ResponseWriter, r *http.Request, id int64) {
+func (h *Handler) ListMyTeams(w http.ResponseWriter, r *http.Request) {
 	user := middleware.UserFromContext(r.Context())
 	if user == nil {
 		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
 		return
 	}
-	if err := h.clubService.Leave(r.Context(), id, user.ID); err != nil {
+	list, err := h.teamService.Mine(r.Context(), user)
+	if err != nil {
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
+	result := make([]generated.Team, len(list))
+	for i, t := range list {
+		result[i] = teamToGenerated(&t)
+	}
+	writeJSON(w, http.StatusOK, result)
 }
 
-// ─── Gov ─────────────────────────────────────────────────────────────────────
-
-func (h *Handler) ListGovMembers(w http.ResponseWriter, r *http.Request) {
-	list, err := h.govService.List(r.Context())
+func (h *Handler) GetTeam(w http.ResponseWriter, r *http.Request, id int64) {
+	t, err := h.teamService.Get(r.Context(), middleware.UserFromContext(r.Context()), id)
 	if err != nil {
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
-	result := make([]generated.GovMember, len(list))
-	for i, g := range list {
-		result[i] = govToGenerated(&g)
+	if t == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "team not found"})
+		return
 	}
-	writeJSON(w, http.StatusOK, result)
+	writeJSON(w, http.StatusOK, teamToGenerated(t))
 }
 
-func (h *Handler) CreateGovMember(w http.ResponseWriter, r *http.Request) {
-	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+func (h *Handler) JoinTeam(w http.ResponseWriter, r *http.Request) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
 		return
 	}
-	var req generated.GovMemberCreateRequest
+	var req generated.TeamJoinRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
 		return
 	}
-	g := &model.GovMember{Name: req.Name, RoleTitle: req.RoleTitle}
-	if req.PhotoUrl != nil {
-		g.PhotoURL = *req.PhotoUrl
-	}
-	if req.ContactUrl != nil {
-		g.ContactURL = *req.ContactUrl
-	}
-	if req.DisplayOrder != nil {
-		g.DisplayOrder = *req.DisplayOrder
-	}
-	result, err := h.govService.Create(r.Context(), g)
+	t, err := h.teamService.Join(r.Context(), user, req.Code)
 	if err != nil {
-		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		writeTeamError(w, err)
 		return
 	}
-	writeJSON(w, http.StatusCreated, govToGenerated(result))
+	writeJSON(w, http.StatusOK, teamToGenerated(t))
 }
 
-func (h *Handler) DeleteGovMember(w http.ResponseWriter, r *http.Request, id int64) {
-	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+func (h *Handler) LeaveTeam(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
 		return
 	}
-	if err := h.govService.Delete(r.Context(), id); err != nil {
-		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+	if err := h.teamService.Leave(r.Context(), user, id); err != nil {
+		writeTeamError(w, err)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// ─── Leaderboard ─────────────────────────────────────────────────────────────
-
-func (h *Handler) GetLeaderboard(w http.ResponseWriter, r *http.Request) {
-	entries, err := h.leaderboardService.GetTop(r.Context(), 10)
-	if err != nil {
-		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
-		return
-	}
+func (h *Handler) TransferTeamCaptain(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	var req generated.TeamCaptainRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	t, err := h.teamService.TransferCaptain(r.Context(), user, id, req.UserId)
+	if err != nil {
+		writeTeamError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, teamToGenerated(t))
+}
+
+func (h *Handler) ListTeamPool(w http.ResponseWriter, r *http.Request, id int64) {
+	if middleware.UserFromContext(r.Context()) == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	list, err := h.teamService.Pool(r.Context(), id)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.PoolEntry, len(list))
+	for i, e := range list {
+		result[i] = poolEntryToGenerated(&e)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) EnterTeamPool(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	var req generated.PoolEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	var skills, roles []string
+	if req.Skills != nil {
+		skills = *req.Skills
+	}
+	if req.Roles != nil {
+		roles = *req.Roles
+	}
+	note := ""
+	if req.Note != nil {
+		note = *req.Note
+	}
+	e, err := h.teamService.EnterPool(r.Context(), user, id, skills, roles, note)
+	if err != nil {
+		writeTeamError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, poolEntryToGenerated(e))
+}
+
+func (h *Handler) LeaveTeamPool(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	if err := h.teamService.LeavePool(r.Context(), user, id); err != nil {
+		status := http.StatusNotFound
+		if strings.HasPrefix(err.Error(), "failed to") {
+			status = http.StatusInternalServerError
+		}
+		writeJSON(w, status, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) SuggestPoolTeams(w http.ResponseWriter, r *http.Request, id int64, params generated.SuggestPoolTeamsParams) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	list, err := h.teamService.SuggestTeams(r.Context(), id, params.Size)
+	if err != nil {
+		writeTeamError(w, err)
+		return
+	}
+	result := make([]generated.TeamSuggestion, len(list))
+	for i, sug := range list {
+		result[i] = generated.TeamSuggestion{
+			AverageLevel: sug.AverageLevel, Members: make([]generated.PoolEntry, len(sug.Members)),
+			Roles: sug.Roles, Skills: sug.Skills,
+		}
+		for j, e := range sug.Members {
+			result[i].Members[j] = poolEntryToGenerated(&e)
+		}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) FormPoolTeams(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.PoolFormRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	list, err := h.teamService.FormTeams(r.Context(), id, req.Size)
+	if err != nil {
+		writeTeamError(w, err)
+		return
+	}
+	result := make([]generated.Team, len(list))
+	for i, t := range list {
+		result[i] = teamToGenerated(&t)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func writeTeamError(w http.ResponseWriter, err error) {
+	switch {
+	case err.Error() == "team not found" || err.Error() == "hackathon not found":
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: err.Error()})
+	case strings.HasPrefix(err.Error(), "a team called"):
+		writeJSON(w, http.StatusConflict, generated.ErrorResponse{Error: err.Error()})
+	case strings.HasPrefix(err.Error(), "failed to"):
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+	default:
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+	}
+}
+
+// ─── Project Submissions ─────────────────────────────────────────────────────
+
+func (h *Handler) GetTeamSubmission(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	p, err := h.projectService.Get(r.Context(), user, id)
+	if err != nil {
+		writeProjectError(w, err)
+		return
+	}
+	if p == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "nothing submitted yet"})
+		return
+	}
+	writeJSON(w, http.StatusOK, projectToGenerated(p))
+}
+
+func (h *Handler) SaveTeamSubmission(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	var req generated.ProjectSubmissionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	p := &model.Project{Title: req.Title}
+	if req.Description != nil {
+		p.Description = *req.Description
+	}
+	if req.RepositoryUrl != nil {
+		p.RepoURL = *req.RepositoryUrl
+	}
+	if req.DemoUrl != nil {
+		p.DemoURL = *req.DemoUrl
+	}
+	if req.Attachments != nil {
+		p.Attachments = *req.Attachments
+	}
+	result, err := h.projectService.Save(r.Context(), user, id, p)
+	if err != nil {
+		writeProjectError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, projectToGenerated(result))
+}
+
+func (h *Handler) ListHackathonSubmissions(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	list, err := h.projectService.List(r.Context(), id)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.ProjectSubmission, len(list))
+	for i, p := range list {
+		result[i] = projectToGenerated(&p)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) ExportHackathonSubmissions(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var buf bytes.Buffer
+	if err := h.projectService.ExportCSV(r.Context(), id, &buf); err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="submissions-%d.csv"`, id))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+func writeProjectError(w http.ResponseWriter, err error) {
+	switch {
+	case err.Error() == "team not found":
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: err.Error()})
+	case strings.HasPrefix(err.Error(), "only team members"):
+		writeJSON(w, http.StatusForbidden, generated.ErrorResponse{Error: err.Error()})
+	case strings.HasPrefix(err.Error(), "failed to"):
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+	default:
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+	}
+}
+
+// ─── Judging ─────────────────────────────────────────────────────────────────
+
+func (h *Handler) ListJudgingCriteria(w http.ResponseWriter, r *http.Request, id int64) {
+	list, err := h.judgingService.Criteria(r.Context(), id)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, criteriaToGenerated(list))
+}
+
+func (h *Handler) SetJudgingCriteria(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.JudgingCriteriaRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	criteria := make([]model.JudgingCriterion, len(req.Criteria))
+	for i, c := range req.Criteria {
+		criteria[i] = model.JudgingCriterion{Name: c.Name, Weight: c.Weight}
+		if c.Description != nil {
+			criteria[i].Description = *c.Description
+		}
+		if c.MaxScore != nil {
+			criteria[i].MaxScore = *c.MaxScore
+		}
+	}
+	list, err := h.judgingService.SetCriteria(r.Context(), id, criteria)
+	if err != nil {
+		writeJudgingError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, criteriaToGenerated(list))
+}
+
+func (h *Handler) ListHackathonJudges(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	list, err := h.judgingService.Judges(r.Context(), id)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.Judge, len(list))
+	for i, j := range list {
+		result[i] = generated.Judge{UserId: j.UserID, Assigned: j.Assigned, Scored: j.Scored, CreatedAt: &j.CreatedAt}
+		if j.User != nil {
+			u := userToGenerated(j.User)
+			result[i].User = &u
+		}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) AddHackathonJudge(w http.ResponseWriter, r *http.Request, id int64) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
+	}
+	var req generated.JudgeAddRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	if err := h.judgingService.AddJudge(r.Context(), admin, id, req.UserId); err != nil {
+		writeJudgingError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) RemoveHackathonJudge(w http.ResponseWriter, r *http.Request, id int64, userId int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	if err := h.judgingService.RemoveJudge(r.Context(), id, userId); err != nil {
+		writeJudgingError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) ListJudgeAssignments(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	list, err := h.judgingService.Assignments(r.Context(), id)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, assignmentsToGenerated(list))
+}
+
+func (h *Handler) AssignJudge(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.JudgeAssignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	n, err := h.judgingService.Assign(r.Context(), id, req.JudgeId, req.ProjectIds)
+	if err != nil {
+		writeJudgingError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, generated.JudgeAssignResult{Assigned: n})
+}
+
+func (h *Handler) AutoAssignJudges(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.JudgeAutoAssignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	n, err := h.judgingService.AutoAssign(r.Context(), id, req.JudgesPerProject)
+	if err != nil {
+		writeJudgingError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, generated.JudgeAssignResult{Assigned: n})
+}
+
+func (h *Handler) ListMyJudgeAssignments(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	list, err := h.judgingService.MyAssignments(r.Context(), user, id)
+	if err != nil {
+		writeJudgingError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, assignmentsToGenerated(list))
+}
+
+func (h *Handler) ScoreProject(w http.ResponseWriter, r *http.Request, id int64, projectId int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	var req generated.ProjectScoreRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	scores := make(map[int64]int, len(req.Scores))
+	for _, sc := range req.Scores {
+		scores[sc.CriterionId] = sc.Score
+	}
+	comment := ""
+	if req.Comment != nil {
+		comment = *req.Comment
+	}
+	a, err := h.judgingService.Score(r.Context(), user, id, projectId, scores, comment)
+	if err != nil {
+		writeJudgingError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, assignmentToGenerated(a))
+}
+
+func (h *Handler) GetHackathonResults(w http.ResponseWriter, r *http.Request, id int64) {
+	list, err := h.judgingService.Results(r.Context(), middleware.UserFromContext(r.Context()), id)
+	if err != nil {
+		writeJudgingError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resultsToGenerated(list))
+}
+
+func (h *Handler) PublishHackathonResults(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	list, err := h.judgingService.Publish(r.Context(), id)
+	if err != nil {
+		writeJudgingError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, resultsToGenerated(list))
+}
+
+func (h *Handler) ListHackathonPrizes(w http.ResponseWriter, r *http.Request, id int64) {
+	list, err := h.judgingService.Prizes(r.Context(), id)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, prizesToGenerated(list))
+}
+
+func (h *Handler) SetHackathonPrizes(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.PrizeTiersRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	tiers := make([]model.PrizeTier, len(req.Prizes))
+	for i, t := range req.Prizes {
+		tiers[i] = model.PrizeTier{Placement: t.Placement, Coins: t.Coins}
+	}
+	list, err := h.judgingService.SetPrizes(r.Context(), id, tiers)
+	if err != nil {
+		writeJudgingError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, prizesToGenerated(list))
+}
+
+func (h *Handler) ListHackathonPrizePayouts(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	list, err := h.judgingService.Payouts(r.Context(), id)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.PrizePayout, len(list))
+	for i, p := range list {
+		result[i] = generated.PrizePayout{
+			UserId: p.UserID, TeamId: p.TeamID, Placement: p.Placement, Coins: p.Coins, CreatedAt: &p.CreatedAt,
+		}
+		if p.User != nil {
+			u := userToGenerated(p.User)
+			result[i].User = &u
+		}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func writeJudgingError(w http.ResponseWriter, err error) {
+	switch {
+	case strings.HasSuffix(err.Error(), "not found"):
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: err.Error()})
+	case strings.HasPrefix(err.Error(), "failed to"):
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+	default:
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+	}
+}
+
+// ─── Attendance ──────────────────────────────────────────────────────────────
+
+func (h *Handler) AttendanceCheckIn(w http.ResponseWriter, r *http.Request) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
+	}
+	var req generated.CheckInRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	a, pending, err := h.attendanceService.CheckIn(r.Context(), admin.ID, req.UserId, req.EventName, req.Coins)
+	if err != nil {
+		if err.Error() == "already checked in for this event today" {
+			writeJSON(w, http.StatusConflict, generated.ErrorResponse{Error: err.Error()})
+			return
+		}
+		if !strings.HasPrefix(err.Error(), "failed to") {
+			writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if pending != nil {
+		writeJSON(w, http.StatusAccepted, awardRequestToGenerated(pending))
+		return
+	}
+	writeJSON(w, http.StatusCreated, attendanceToGenerated(a))
+}
+
+func (h *Handler) AttendanceHistory(w http.ResponseWriter, r *http.Request) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	list, err := h.attendanceService.History(r.Context(), user.ID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.Attendance, len(list))
+	for i, a := range list {
+		result[i] = attendanceToGenerated(&a)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// ─── Clubs ───────────────────────────────────────────────────────────────────
+
+func (h *Handler) ListClubs(w http.ResponseWriter, r *http.Request) {
+	user := middleware.UserFromContext(r.Context())
+	var userID *int64
+	if user != nil {
+		userID = &user.ID
+	}
+	list, err := h.clubService.List(r.Context(), userID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.Club, len(list))
+	for i, c := range list {
+		result[i] = clubToGenerated(&c)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) GetClub(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	var userID *int64
+	if user != nil {
+		userID = &user.ID
+	}
+	c, err := h.clubService.GetByID(r.Context(), id, userID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if c == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "club not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, clubToGenerated(c))
+}
+
+func (h *Handler) CreateClub(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.ClubCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	c := &model.Club{Name: req.Name}
+	if req.Description != nil {
+		c.Description = *req.Description
+	}
+	if req.ImageUrl != nil {
+		c.ImageURL = *req.ImageUrl
+	}
+	if req.Schedule != nil {
+		c.Schedule = *req.Schedule
+	}
+	result, err := h.clubService.Create(r.Context(), c)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, clubToGenerated(result))
+}
+
+func (h *Handler) DeleteClub(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	if err := h.clubService.Delete(r.Context(), id); err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) JoinClub(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	if err := h.clubService.Join(r.Context(), id, user.ID); err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	c, _ := h.clubService.GetByID(r.Context(), id, &user.ID)
+	if c != nil {
+		writeJSON(w, http.StatusOK, clubToGenerated(c))
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+func (h *Handler) LeaveClub(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	if err := h.clubService.Leave(r.Context(), id, user.ID); err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ─── Gov ─────────────────────────────────────────────────────────────────────
+
+func (h *Handler) ListGovMembers(w http.ResponseWriter, r *http.Request) {
+	list, err := h.govService.List(r.Context())
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.GovMember, len(list))
+	for i, g := range list {
+		result[i] = govToGenerated(&g)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) CreateGovMember(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.GovMemberCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	g := &model.GovMember{Name: req.Name, RoleTitle: req.RoleTitle}
+	if req.PhotoUrl != nil {
+		g.PhotoURL = *req.PhotoUrl
+	}
+	if req.ContactUrl != nil {
+		g.ContactURL = *req.ContactUrl
+	}
+	if req.DisplayOrder != nil {
+		g.DisplayOrder = *req.DisplayOrder
+	}
+	result, err := h.govService.Create(r.Context(), g)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, govToGenerated(result))
+}
+
+func (h *Handler) DeleteGovMember(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	if err := h.govService.Delete(r.Context(), id); err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ─── Leaderboard ─────────────────────────────────────────────────────────────
+
+func (h *Handler) GetLeaderboard(w http.ResponseWriter, r *http.Request, params generated.GetLeaderboardParams) {
+	q := model.LeaderboardQuery{}
+	if params.Period != nil {
+		q.Period = string(*params.Period)
+	}
+	if params.Metric != nil {
+		q.Metric = string(*params.Metric)
+	}
+	if params.Limit != nil {
+		q.Limit = *params.Limit
+	}
+	if params.Offset != nil {
+		q.Offset = *params.Offset
+	}
+	league := ""
+	if params.League != nil {
+		league = *params.League
+	}
+	entries, err := h.leaderboardService.Get(r.Context(), q, league)
+	if err != nil {
+		writeLeaderboardError(w, err)
+		return
+	}
 	result := make([]generated.LeaderboardEntry, len(entries))
 	for i, e := range entries {
-		result[i] = generated.LeaderboardEntry{
-			Rank:        e.Rank,
-			UserId:      e.UserID,
-			FirstName:   e.FirstName,
-			LastName:    strPtr(e.LastName),
-			Username:    strPtr(e.Username),
-			PhotoUrl:    strPtr(e.PhotoURL),
-			Coins:       e.Coins,
-			SchoolLevel: intPtr(e.SchoolLevel),
+		result[i] = leaderboardEntryToGenerated(&e)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) GetMyLeaderboardEntry(w http.ResponseWriter, r *http.Request, params generated.GetMyLeaderboardEntryParams) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	q := model.LeaderboardQuery{}
+	if params.Period != nil {
+		q.Period = string(*params.Period)
+	}
+	if params.Metric != nil {
+		q.Metric = string(*params.Metric)
+	}
+	league := ""
+	if params.League != nil {
+		league = *params.League
+	}
+	entry, err := h.leaderboardService.Rank(r.Context(), q, league, user.ID)
+	if err != nil {
+		writeLeaderboardError(w, err)
+		return
+	}
+	if entry == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "you are not on this leaderboard"})
+		return
+	}
+	writeJSON(w, http.StatusOK, leaderboardEntryToGenerated(entry))
+}
+
+func (h *Handler) GetLeagueLeaderboards(w http.ResponseWriter, r *http.Request, params generated.GetLeagueLeaderboardsParams) {
+	q := model.LeaderboardQuery{}
+	if params.Period != nil {
+		q.Period = string(*params.Period)
+	}
+	if params.Metric != nil {
+		q.Metric = string(*params.Metric)
+	}
+	if params.Limit != nil {
+		q.Limit = *params.Limit
+	}
+	standings, err := h.leaderboardService.TopByLeague(r.Context(), q)
+	if err != nil {
+		writeLeaderboardError(w, err)
+		return
+	}
+	result := make([]generated.LeagueStandings, len(standings))
+	for i, st := range standings {
+		entries := make([]generated.LeaderboardEntry, len(st.Entries))
+		for j, e := range st.Entries {
+			entries[j] = leaderboardEntryToGenerated(&e)
+		}
+		result[i] = generated.LeagueStandings{League: leagueToGenerated(&st.League), Entries: entries}
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func writeLeaderboardError(w http.ResponseWriter, err error) {
+	if errors.Is(err, service.ErrBadLeaderboardQuery) {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+}
+
+// ─── Seasons ─────────────────────────────────────────────────────────────────
+
+func (h *Handler) ListSeasons(w http.ResponseWriter, r *http.Request) {
+	list, err := h.seasonService.List(r.Context())
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.Season, len(list))
+	for i, season := range list {
+		result[i] = seasonToGenerated(&season)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) GetSeason(w http.ResponseWriter, r *http.Request, id int64, params generated.GetSeasonParams) {
+	limit, offset := 0, 0
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+	st, err := h.seasonService.Get(r.Context(), id, limit, offset)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if st == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "season not found"})
+		return
+	}
+	standings := make([]generated.LeaderboardEntry, len(st.Standings))
+	for i, e := range st.Standings {
+		standings[i] = leaderboardEntryToGenerated(&e)
+	}
+	writeJSON(w, http.StatusOK, generated.SeasonStandings{Season: seasonToGenerated(&st.Season), Standings: standings})
+}
+
+func (h *Handler) CreateSeason(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.SeasonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	season, err := h.seasonService.Create(r.Context(), seasonFromRequest(&req))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, seasonToGenerated(season))
+}
+
+func (h *Handler) UpdateSeason(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.SeasonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	season := seasonFromRequest(&req)
+	season.ID = id
+	updated, err := h.seasonService.Update(r.Context(), season)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if updated == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "season not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, seasonToGenerated(updated))
+}
+
+func (h *Handler) DeleteSeason(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	if err := h.seasonService.Delete(r.Context(), id); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) CloseSeason(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	season, err := h.seasonService.Close(r.Context(), id)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, seasonToGenerated(season))
+}
+
+// ─── Shop ────────────────────────────────────────────────────────────────────
+
+func (h *Handler) ListShopItems(w http.ResponseWriter, r *http.Request) {
+	items, err := h.shopService.ListItems(r.Context())
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.ShopItem, len(items))
+	for i, item := range items {
+		result[i] = shopItemToGenerated(&item)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) CreateShopItem(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.ShopItemCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	item := &model.ShopItem{Name: req.Name, PriceCoins: req.PriceCoins, Stock: -1}
+	if req.Description != nil {
+		item.Description = *req.Description
+	}
+	if req.ImageUrl != nil {
+		item.ImageURL = *req.ImageUrl
+	}
+	if req.Stock != nil {
+		item.Stock = *req.Stock
+	}
+	result, err := h.shopService.CreateItem(r.Context(), item)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, shopItemToGenerated(result))
+}
+
+func (h *Handler) DeleteShopItem(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	if err := h.shopService.DeleteItem(r.Context(), id); err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) BuyShopItem(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	// The body is optional; an empty one means no promo code.
+	var req generated.BuyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	promoCode := ""
+	if req.PromoCode != nil {
+		promoCode = *req.PromoCode
+	}
+	purchase, err := h.shopService.Buy(r.Context(), user.ID, id, promoCode)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, purchaseToGenerated(purchase))
+}
+
+func (h *Handler) SetShopItemSale(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.ShopItemSaleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	item, err := h.shopService.SetSale(r.Context(), id, &req.SalePriceCoins, req.StartsAt, req.EndsAt)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if item == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "item not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, shopItemToGenerated(item))
+}
+
+func (h *Handler) ClearShopItemSale(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	item, err := h.shopService.SetSale(r.Context(), id, nil, nil, nil)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if item == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "item not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, shopItemToGenerated(item))
+}
+
+func (h *Handler) ListPromoCodes(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	list, err := h.shopService.ListPromoCodes(r.Context())
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.PromoCode, len(list))
+	for i, p := range list {
+		result[i] = promoCodeToGenerated(&p)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) CreatePromoCode(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.PromoCodeCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	result, err := h.shopService.CreatePromoCode(r.Context(), &model.PromoCode{
+		Code: req.Code, DiscountType: string(req.DiscountType), DiscountValue: req.DiscountValue,
+		ItemID: req.ItemId, MaxUses: req.MaxUses, MaxUsesPerUser: req.MaxUsesPerUser, ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, promoCodeToGenerated(result))
+}
+
+func (h *Handler) DeletePromoCode(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	if err := h.shopService.DeletePromoCode(r.Context(), id); err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) CheckoutShop(w http.ResponseWriter, r *http.Request) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	var req generated.CheckoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	items := make([]model.CartItem, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = model.CartItem{ItemID: item.ItemId, Quantity: 1}
+		if item.Quantity != nil {
+			items[i].Quantity = *item.Quantity
+		}
+	}
+	order, err := h.shopService.Checkout(r.Context(), user.ID, items)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, orderToGenerated(order))
+}
+
+func (h *Handler) ListMyPurchases(w http.ResponseWriter, r *http.Request) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	list, err := h.shopService.ListPurchases(r.Context(), model.PurchaseFilter{UserID: &user.ID})
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.Purchase, len(list))
+	for i, p := range list {
+		p.User = nil
+		result[i] = purchaseToGenerated(&p)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) ListPurchases(w http.ResponseWriter, r *http.Request, params generated.ListPurchasesParams) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	list, err := h.shopService.ListPurchases(r.Context(), model.PurchaseFilter{
+		UserID: params.UserId, ItemID: params.ItemId, From: params.From, To: params.To,
+	})
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.Purchase, len(list))
+	for i, p := range list {
+		result[i] = purchaseToGenerated(&p)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) GetSalesReport(w http.ResponseWriter, r *http.Request, params generated.GetSalesReportParams) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	top := 10
+	if params.Top != nil && *params.Top > 0 && *params.Top <= 100 {
+		top = *params.Top
+	}
+	report, err := h.shopService.SalesReport(r.Context(), model.PurchaseFilter{
+		UserID: params.UserId, ItemID: params.ItemId, From: params.From, To: params.To,
+	}, top)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, salesReportToGenerated(report))
+}
+
+// ─── Coins ───────────────────────────────────────────────────────────────────
+
+func (h *Handler) TransferCoins(w http.ResponseWriter, r *http.Request) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	var req generated.TransferRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	username, note := "", ""
+	if req.RecipientUsername != nil {
+		username = *req.RecipientUsername
+	}
+	if req.Note != nil {
+		note = *req.Note
+	}
+	t, err := h.coinService.Transfer(r.Context(), user.ID, req.RecipientId, username, req.Amount, note)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, generated.Transfer{
+		RecipientId: t.Recipient.ID, RecipientFirstName: strPtr(t.Recipient.FirstName),
+		RecipientUsername: strPtr(t.Recipient.Username), Amount: t.Amount, Note: strPtr(t.Note),
+		Balance: t.Sender.Coins, CreatedAt: &t.CreatedAt,
+	})
+}
+
+func (h *Handler) CoinHistory(w http.ResponseWriter, r *http.Request, params generated.CoinHistoryParams) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	limit := 50
+	if params.Limit != nil && *params.Limit > 0 && *params.Limit <= 200 {
+		limit = *params.Limit
+	}
+	list, err := h.coinService.History(r.Context(), user.ID, limit)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.LedgerEntry, len(list))
+	for i, e := range list {
+		result[i] = ledgerEntryToGenerated(&e)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) AdjustCoins(w http.ResponseWriter, r *http.Request) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
+	}
+	var req generated.CoinAdjustmentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	entry, pending, err := h.coinService.Adjust(r.Context(), admin.ID, req.UserId, req.Amount, req.Reason)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if pending != nil {
+		writeJSON(w, http.StatusAccepted, awardRequestToGenerated(pending))
+		return
+	}
+	writeJSON(w, http.StatusCreated, ledgerEntryToGenerated(entry))
+}
+
+func (h *Handler) BulkAwardCoins(w http.ResponseWriter, r *http.Request, params generated.BulkAwardCoinsParams) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
+	}
+	commit := params.Commit != nil && *params.Commit
+	body := http.MaxBytesReader(w, r.Body, 1<<20)
+	result, err := h.coinService.BulkAward(r.Context(), admin.ID, body, commit)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	status := http.StatusOK
+	if commit && !result.Committed {
+		status = http.StatusUnprocessableEntity
+	}
+	writeJSON(w, status, bulkAwardToGenerated(result))
+}
+
+func (h *Handler) ListCoinAwardRequests(w http.ResponseWriter, r *http.Request, params generated.ListCoinAwardRequestsParams) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	status := model.AwardRequestPending
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	list, err := h.awardService.ListRequests(r.Context(), status)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.CoinAwardRequest, len(list))
+	for i, req := range list {
+		result[i] = awardRequestToGenerated(&req)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) ApproveCoinAwardRequest(w http.ResponseWriter, r *http.Request, id int64) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
+	}
+	req, err := h.awardService.Approve(r.Context(), admin.ID, id)
+	h.writeAwardReview(w, req, err)
+}
+
+func (h *Handler) RejectCoinAwardRequest(w http.ResponseWriter, r *http.Request, id int64) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
+	}
+	req, err := h.awardService.Reject(r.Context(), admin.ID, id)
+	h.writeAwardReview(w, req, err)
+}
+
+func (h *Handler) writeAwardReview(w http.ResponseWriter, req *model.CoinAwardRequest, err error) {
+	if err != nil {
+		if err.Error() == "award request not found" {
+			writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: err.Error()})
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, awardRequestToGenerated(req))
+}
+
+// ─── Admin Alerts ────────────────────────────────────────────────────────────
+
+func (h *Handler) ListAdminAlerts(w http.ResponseWriter, r *http.Request, params generated.ListAdminAlertsParams) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	includeResolved := params.IncludeResolved != nil && *params.IncludeResolved
+	limit := 50
+	if params.Limit != nil && *params.Limit > 0 && *params.Limit <= 200 {
+		limit = *params.Limit
+	}
+	list, err := h.awardService.ListAlerts(r.Context(), includeResolved, limit)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.AdminAlert, len(list))
+	for i, a := range list {
+		result[i] = adminAlertToGenerated(&a)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) ResolveAdminAlert(w http.ResponseWriter, r *http.Request, id int64) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
+	}
+	a, err := h.awardService.ResolveAlert(r.Context(), admin.ID, id)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if a == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "alert not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, adminAlertToGenerated(a))
+}
+
+// ─── Raffles ─────────────────────────────────────────────────────────────────
+
+func (h *Handler) ListRaffles(w http.ResponseWriter, r *http.Request) {
+	user := middleware.UserFromContext(r.Context())
+	var userID *int64
+	if user != nil {
+		userID = &user.ID
+	}
+	list, err := h.raffleService.List(r.Context(), userID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.Raffle, len(list))
+	for i, rf := range list {
+		result[i] = raffleToGenerated(&rf)
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *Handler) GetRaffle(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	var userID *int64
+	if user != nil {
+		userID = &user.ID
+	}
+	rf, err := h.raffleService.GetByID(r.Context(), id, userID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if rf == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "raffle not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, raffleToGenerated(rf))
+}
+
+func (h *Handler) CreateRaffle(w http.ResponseWriter, r *http.Request) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	var req generated.RaffleCreateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	rf := &model.Raffle{Title: req.Title, TicketPriceCoins: req.TicketPriceCoins, MaxTicketsPerUser: req.MaxTicketsPerUser}
+	if req.Description != nil {
+		rf.Description = *req.Description
+	}
+	if req.Prize != nil {
+		rf.Prize = *req.Prize
+	}
+	if req.ImageUrl != nil {
+		rf.ImageURL = *req.ImageUrl
+	}
+	result, err := h.raffleService.Create(r.Context(), rf)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, raffleToGenerated(result))
+}
+
+func (h *Handler) BuyRaffleTickets(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
+	}
+	var req generated.RaffleTicketRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	quantity := 1
+	if req.Quantity != nil {
+		quantity = *req.Quantity
+	}
+	tickets, err := h.raffleService.BuyTickets(r.Context(), id, user.ID, quantity)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	result := make([]generated.RaffleTicket, len(tickets))
+	for i, t := range tickets {
+		result[i] = generated.RaffleTicket{
+			Id: t.ID, RaffleId: t.RaffleID, UserId: t.UserID,
+			TicketNumber: t.TicketNumber, CreatedAt: &t.CreatedAt,
 		}
 	}
-	writeJSON(w, http.StatusOK, result)
+	writeJSON(w, http.StatusCreated, result)
 }
 
-// ─── Shop ────────────────────────────────────────────────────────────────────
+func (h *Handler) DrawRaffle(w http.ResponseWriter, r *http.Request, id int64) {
+	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
+		return
+	}
+	rf, err := h.raffleService.Draw(r.Context(), id)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, raffleToGenerated(rf))
+}
 
-func (h *Handler) ListShopItems(w http.ResponseWriter, r *http.Request) {
-	items, err := h.shopService.ListItems(r.Context())
+// ─── Quests ──────────────────────────────────────────────────────────────────
+
+func (h *Handler) ListQuests(w http.ResponseWriter, r *http.Request, params generated.ListQuestsParams) {
+	user := middleware.UserFromContext(r.Context())
+	var userID *int64
+	if user != nil {
+		userID = &user.ID
+	}
+	includeClosed := params.IncludeClosed != nil && *params.IncludeClosed
+	list, err := h.questService.List(r.Context(), userID, includeClosed)
 	if err != nil {
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
-	result := make([]generated.ShopItem, len(items))
-	for i, item := range items {
-		result[i] = shopItemToGenerated(&item)
+	now := time.Now()
+	result := make([]generated.Quest, len(list))
+	for i, q := range list {
+		result[i] = questToGenerated(&q, now)
 	}
 	writeJSON(w, http.StatusOK, result)
 }
 
-func (h *Handler) CreateShopItem(w http.ResponseWriter, r *http.Request) {
+func (h *Handler) GetQuest(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	var userID *int64
+	if user != nil {
+		userID = &user.ID
+	}
+	q, err := h.questService.GetByID(r.Context(), id, userID)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	if q == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "quest not found"})
+		return
+	}
+	writeJSON(w, http.StatusOK, questToGenerated(q, time.Now()))
+}
+
+func (h *Handler) CreateQuest(w http.ResponseWriter, r *http.Request) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
+	}
+	var req generated.QuestRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	q := questFromRequest(&req)
+	q.CreatedBy = &admin.ID
+	result, err := h.questService.Create(r.Context(), q)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusCreated, questToGenerated(result, time.Now()))
+}
+
+func (h *Handler) UpdateQuest(w http.ResponseWriter, r *http.Request, id int64) {
 	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
 		return
 	}
-	var req generated.ShopItemCreateRequest
+	var req generated.QuestRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
 		return
 	}
-	item := &model.ShopItem{Name: req.Name, PriceCoins: req.PriceCoins, Stock: -1}
-	if req.Description != nil {
-		item.Description = *req.Description
+	q := questFromRequest(&req)
+	q.ID = id
+	result, err := h.questService.Update(r.Context(), q)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		return
 	}
-	if req.ImageUrl != nil {
-		item.ImageURL = *req.ImageUrl
+	if result == nil {
+		writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: "quest not found"})
+		return
 	}
-	if req.Stock != nil {
-		item.Stock = *req.Stock
+	writeJSON(w, http.StatusOK, questToGenerated(result, time.Now()))
+}
+
+func (h *Handler) SubmitQuest(w http.ResponseWriter, r *http.Request, id int64) {
+	user := middleware.UserFromContext(r.Context())
+	if user == nil {
+		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+		return
 	}
-	result, err := h.shopService.CreateItem(r.Context(), item)
+	var req generated.QuestSubmissionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return
+	}
+	var proofText, proofURL string
+	if req.ProofText != nil {
+		proofText = *req.ProofText
+	}
+	if req.ProofUrl != nil {
+		proofURL = *req.ProofUrl
+	}
+	sub, err := h.questService.Submit(r.Context(), id, user.ID, proofText, proofURL)
 	if err != nil {
-		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		switch {
+		case err.Error() == "quest not found":
+			writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: err.Error()})
+		case strings.HasPrefix(err.Error(), "failed to"):
+			writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		default:
+			writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		}
 		return
 	}
-	writeJSON(w, http.StatusCreated, shopItemToGenerated(result))
+	writeJSON(w, http.StatusCreated, questSubmissionToGenerated(sub))
 }
 
-func (h *Handler) DeleteShopItem(w http.ResponseWriter, r *http.Request, id int64) {
+func (h *Handler) ListQuestSubmissions(w http.ResponseWriter, r *http.Request, params generated.ListQuestSubmissionsParams) {
 	if !requireAdmin(w, middleware.UserFromContext(r.Context())) {
 		return
 	}
-	if err := h.shopService.DeleteItem(r.Context(), id); err != nil {
+	status := model.QuestSubmissionPending
+	if params.Status != nil {
+		status = string(*params.Status)
+	}
+	list, err := h.questService.ListSubmissions(r.Context(), status, params.QuestId)
+	if err != nil {
 		writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
 		return
 	}
-	w.WriteHeader(http.StatusNoContent)
+	result := make([]generated.QuestSubmission, len(list))
+	for i, sub := range list {
+		result[i] = questSubmissionToGenerated(&sub)
+	}
+	writeJSON(w, http.StatusOK, result)
 }
 
-func (h *Handler) BuyShopItem(w http.ResponseWriter, r *http.Request, id int64) {
-	user := middleware.UserFromContext(r.Context())
-	if user == nil {
-		writeJSON(w, http.StatusUnauthorized, generated.ErrorResponse{Error: "unauthorized"})
+func (h *Handler) ApproveQuestSubmission(w http.ResponseWriter, r *http.Request, id int64) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
+	}
+	note, ok := decodeReviewNote(w, r)
+	if !ok {
+		return
+	}
+	sub, err := h.questService.Approve(r.Context(), admin.ID, id, note)
+	h.writeQuestReview(w, sub, err)
+}
+
+func (h *Handler) RejectQuestSubmission(w http.ResponseWriter, r *http.Request, id int64) {
+	admin := middleware.UserFromContext(r.Context())
+	if !requireAdmin(w, admin) {
+		return
+	}
+	note, ok := decodeReviewNote(w, r)
+	if !ok {
 		return
 	}
-	purchase, err := h.shopService.Buy(r.Context(), user.ID, id)
+	sub, err := h.questService.Reject(r.Context(), admin.ID, id, note)
+	h.writeQuestReview(w, sub, err)
+}
+
+// decodeReviewNote reads the optional review body.
+func decodeReviewNote(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var req generated.QuestReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: "invalid request body"})
+		return "", false
+	}
+	if req.Note == nil {
+		return "", true
+	}
+	return *req.Note, true
+}
+
+func (h *Handler) writeQuestReview(w http.ResponseWriter, sub *model.QuestSubmission, err error) {
 	if err != nil {
-		writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		switch {
+		case err.Error() == "quest submission not found":
+			writeJSON(w, http.StatusNotFound, generated.ErrorResponse{Error: err.Error()})
+		case strings.HasPrefix(err.Error(), "failed to"):
+			writeJSON(w, http.StatusInternalServerError, generated.ErrorResponse{Error: err.Error()})
+		default:
+			writeJSON(w, http.StatusBadRequest, generated.ErrorResponse{Error: err.Error()})
+		}
 		return
 	}
-	writeJSON(w, http.StatusOK, purchaseToGenerated(purchase))
+	writeJSON(w, http.StatusOK, questSubmissionToGenerated(sub))
 }
 
 // ─── AI Summary ──────────────────────────────────────────────────────────────
@@ -698,22 +2484,43 @@ func float32Ptr(f float64) *float32 {
 	return &v
 }
 
+func badgesToGenerated(list []model.Badge) []generated.Badge {
+	result := make([]generated.Badge, len(list))
+	for i, b := range list {
+		result[i] = generated.Badge{
+			Id: b.ID, Code: b.Code, Name: b.Name, Description: strPtr(b.Description),
+			Icon: strPtr(b.Icon), AwardedAt: &b.AwardedAt,
+		}
+	}
+	return result
+}
+
 func userToGenerated(u *model.User) generated.User {
 	return generated.User{
-		Id:          u.ID,
-		TelegramId:  u.TelegramID,
-		Username:    strPtr(u.Username),
-		FirstName:   strPtr(u.FirstName),
-		LastName:    strPtr(u.LastName),
-		PhotoUrl:    strPtr(u.PhotoURL),
-		Role:        generated.UserRole(u.Role),
-		SchoolLogin: strPtr(u.SchoolLogin),
-		SchoolLevel: intPtr(u.SchoolLevel),
-		SchoolXp:    &u.SchoolXP,
-		AuditRatio:  float32Ptr(u.AuditRatio),
-		Coins:       intPtr(u.Coins),
-		CreatedAt:   &u.CreatedAt,
-		UpdatedAt:   &u.UpdatedAt,
+		Id:             u.ID,
+		TelegramId:     u.TelegramID,
+		Username:       strPtr(u.Username),
+		FirstName:      strPtr(u.FirstName),
+		LastName:       strPtr(u.LastName),
+		PhotoUrl:       strPtr(u.PhotoURL),
+		Role:           generated.UserRole(u.Role),
+		SchoolLogin:    strPtr(u.SchoolLogin),
+		SchoolLevel:    intPtr(u.SchoolLevel),
+		SchoolXp:       &u.SchoolXP,
+		AuditRatio:     float32Ptr(u.AuditRatio),
+		Coins:          intPtr(u.Coins),
+		CommunityXp:    &u.CommunityXP,
+		CommunityLevel: intPtr(u.CommunityLevel),
+		CreatedAt:      &u.CreatedAt,
+		UpdatedAt:      &u.UpdatedAt,
+	}
+}
+
+// publicUserToGenerated leaves out what only the user and admins may see.
+func publicUserToGenerated(u *model.User) generated.PublicUser {
+	return generated.PublicUser{
+		Id: u.ID, Username: strPtr(u.Username), FirstName: strPtr(u.FirstName), LastName: strPtr(u.LastName),
+		PhotoUrl: strPtr(u.PhotoURL), SchoolLevel: intPtr(u.SchoolLevel),
 	}
 }
 
@@ -724,30 +2531,203 @@ func newsToGenerated(n *model.News) generated.News {
 	}
 }
 
+func hackathonFromRequest(req *generated.HackathonCreateRequest) *model.Hackathon {
+	h := &model.Hackathon{
+		Title: req.Title, Description: req.Description, StartDate: req.StartDate, EndDate: req.EndDate,
+		RegistrationOpensAt: req.RegistrationOpensAt, RegistrationClosesAt: req.RegistrationClosesAt,
+		SubmissionDeadline: req.SubmissionDeadline, MaxTeamSize: req.MaxTeamSize, MaxParticipants: req.MaxParticipants,
+	}
+	if req.Location != nil {
+		h.Location = *req.Location
+	}
+	if req.Prizes != nil {
+		h.Prizes = *req.Prizes
+	}
+	if req.Rules != nil {
+		h.Rules = *req.Rules
+	}
+	if req.CoverImageUrl != nil {
+		h.CoverImageURL = *req.CoverImageUrl
+	}
+	if req.OrganizerContacts != nil {
+		h.OrganizerContacts = *req.OrganizerContacts
+	}
+	return h
+}
+
 func hackathonToGenerated(h *model.Hackathon) generated.Hackathon {
 	return generated.Hackathon{
 		Id: h.ID, Title: h.Title, Description: h.Description,
 		Status: generated.HackathonStatus(h.Status), StartDate: h.StartDate,
-		EndDate: h.EndDate, CreatedAt: &h.CreatedAt,
+		EndDate: h.EndDate, RegistrationOpensAt: h.RegistrationOpensAt,
+		RegistrationClosesAt: h.RegistrationClosesAt, SubmissionDeadline: h.SubmissionDeadline,
+		Location: strPtr(h.Location), Prizes: strPtr(h.Prizes), Rules: strPtr(h.Rules), MaxTeamSize: h.MaxTeamSize,
+		MaxParticipants: h.MaxParticipants, Participants: intPtr(h.Participants),
+		CoverImageUrl: strPtr(h.CoverImageURL), OrganizerContacts: strPtr(h.OrganizerContacts),
+		StatusChangedAt: &h.StatusChangedAt, ResultsPublishedAt: h.ResultsPublishedAt,
+		CreatedAt: &h.CreatedAt, UpdatedAt: &h.UpdatedAt,
 	}
 }
 
 func applicationToGenerated(a *model.HackathonApplication) generated.HackathonApplication {
 	r := generated.HackathonApplication{
-		Id: a.ID, HackathonId: a.HackathonID, UserId: a.UserID,
-		TeamName: strPtr(a.TeamName), Status: a.Status, CreatedAt: &a.CreatedAt,
+		Id: a.ID, HackathonId: a.HackathonID, HackathonTitle: strPtr(a.HackathonTitle), UserId: a.UserID,
+		TeamName: strPtr(a.TeamName), TeamId: a.TeamID, Places: intPtr(a.Places), Status: a.Status, ReviewMessage: strPtr(a.ReviewMessage),
+		ReviewedBy: a.ReviewedBy, ReviewedAt: a.ReviewedAt, WaitlistPosition: a.WaitlistPos, CreatedAt: &a.CreatedAt,
 	}
 	if a.User != nil {
 		u := userToGenerated(a.User)
 		r.User = &u
 	}
+	if a.Answers != nil {
+		answers := make([]generated.FormAnswer, len(a.Answers))
+		for i, ans := range a.Answers {
+			answers[i] = generated.FormAnswer{FieldId: ans.FieldID, Value: strPtr(ans.Value)}
+			if ans.Choices != nil {
+				answers[i].Choices = &ans.Choices
+			}
+		}
+		r.Answers = &answers
+	}
 	return r
 }
 
+func formToGenerated(fields []model.FormField) []generated.FormField {
+	result := make([]generated.FormField, len(fields))
+	for i, f := range fields {
+		result[i] = generated.FormField{
+			Id: f.ID, Label: f.Label, Type: generated.FormFieldType(f.Type), Required: f.Required, Choices: f.Choices,
+		}
+		if result[i].Choices == nil {
+			result[i].Choices = []string{}
+		}
+	}
+	return result
+}
+
+func teamToGenerated(t *model.Team) generated.Team {
+	result := generated.Team{
+		Id: t.ID, HackathonId: t.HackathonID, HackathonTitle: strPtr(t.HackathonTitle), Name: t.Name,
+		CaptainId: t.CaptainID, InviteCode: strPtr(t.InviteCode), InviteLink: strPtr(t.InviteLink),
+		MaxSize: t.MaxSize, Members: make([]generated.TeamMember, len(t.Members)),
+		ApplicationId: t.ApplicationID, ApplicationStatus: strPtr(t.ApplicationStatus), CreatedAt: &t.CreatedAt,
+	}
+	for i, m := range t.Members {
+		result.Members[i] = teamMemberToGenerated(&m)
+	}
+	return result
+}
+
+func teamMemberToGenerated(m *model.TeamMember) generated.TeamMember {
+	result := generated.TeamMember{UserId: m.UserID, JoinedAt: m.JoinedAt}
+	if m.User != nil {
+		u := publicUserToGenerated(m.User)
+		result.User = &u
+	}
+	return result
+}
+
+func projectToGenerated(p *model.Project) generated.ProjectSubmission {
+	result := generated.ProjectSubmission{
+		Id: p.ID, HackathonId: p.HackathonID, TeamId: p.TeamID, TeamName: p.TeamName, Title: p.Title,
+		Description: strPtr(p.Description), RepositoryUrl: strPtr(p.RepoURL), DemoUrl: strPtr(p.DemoURL),
+		Attachments: p.Attachments, UpdatedBy: p.UpdatedBy, FinalScore: p.FinalScore, Placement: p.Placement,
+		CreatedAt: p.CreatedAt, UpdatedAt: p.UpdatedAt,
+	}
+	if p.Members != nil {
+		members := make([]generated.TeamMember, len(p.Members))
+		for i, m := range p.Members {
+			members[i] = teamMemberToGenerated(&m)
+		}
+		result.Members = &members
+	}
+	return result
+}
+
+func poolEntryToGenerated(e *model.PoolEntry) generated.PoolEntry {
+	result := generated.PoolEntry{
+		HackathonId: e.HackathonID, UserId: e.UserID, Skills: e.Skills, Roles: e.Roles,
+		Note: strPtr(e.Note), Applied: &e.Applied, CreatedAt: &e.CreatedAt,
+	}
+	if e.User != nil {
+		u := publicUserToGenerated(e.User)
+		result.User = &u
+	}
+	return result
+}
+
+func criteriaToGenerated(list []model.JudgingCriterion) []generated.JudgingCriterion {
+	result := make([]generated.JudgingCriterion, len(list))
+	for i, c := range list {
+		result[i] = generated.JudgingCriterion{
+			Id: c.ID, Name: c.Name, Description: strPtr(c.Description), Weight: c.Weight, MaxScore: c.MaxScore,
+		}
+	}
+	return result
+}
+
+func assignmentToGenerated(a *model.JudgeAssignment) generated.JudgeAssignment {
+	result := generated.JudgeAssignment{
+		ProjectId: a.ProjectID, JudgeId: a.JudgeID, Scores: make([]generated.CriterionScore, 0, len(a.Scores)),
+		Comment: strPtr(a.Comment), ScoredAt: a.ScoredAt,
+	}
+	if a.Project != nil {
+		p := projectToGenerated(a.Project)
+		result.Project = &p
+	}
+	for id, score := range a.Scores {
+		result.Scores = append(result.Scores, generated.CriterionScore{CriterionId: id, Score: score})
+	}
+	sort.Slice(result.Scores, func(i, j int) bool { return result.Scores[i].CriterionId < result.Scores[j].CriterionId })
+	return result
+}
+
+func assignmentsToGenerated(list []model.JudgeAssignment) []generated.JudgeAssignment {
+	result := make([]generated.JudgeAssignment, len(list))
+	for i, a := range list {
+		result[i] = assignmentToGenerated(&a)
+	}
+	return result
+}
+
+func resultsToGenerated(list []model.HackathonResult) []generated.HackathonResult {
+	result := make([]generated.HackathonResult, len(list))
+	for i, r := range list {
+		result[i] = generated.HackathonResult{
+			ProjectId: r.ProjectID, TeamId: r.TeamID, TeamName: r.TeamName, Title: r.Title,
+			Score: r.Score, Judges: r.Judges, Placement: r.Placement,
+		}
+		if r.Prize > 0 {
+			result[i].Prize = intPtr(r.Prize)
+		}
+	}
+	return result
+}
+
+func prizesToGenerated(list []model.PrizeTier) []generated.PrizeTier {
+	result := make([]generated.PrizeTier, len(list))
+	for i, t := range list {
+		result[i] = generated.PrizeTier{Placement: t.Placement, Coins: t.Coins}
+	}
+	return result
+}
+
 func attendanceToGenerated(a *model.Attendance) generated.Attendance {
-	return generated.Attendance{
+	result := generated.Attendance{
 		Id: a.ID, UserId: a.UserID, EventName: a.EventName,
-		CoinsAwarded: a.CoinsAwarded, CreatedAt: &a.CreatedAt,
+		CoinsAwarded: a.CoinsAwarded, BaseCoins: intPtr(a.BaseCoins), Multiplier: &a.Multiplier,
+		AwardedBy: a.AwardedBy, CreatedAt: &a.CreatedAt,
+	}
+	if a.StreakWeeks > 0 {
+		result.StreakWeeks = intPtr(a.StreakWeeks)
+	}
+	return result
+}
+
+func streakToGenerated(s *model.Streak) *generated.Streak {
+	return &generated.Streak{
+		CurrentWeeks: s.CurrentWeeks, BestWeeks: s.BestWeeks,
+		ActiveThisWeek: s.ActiveThisWeek, NextMultiplier: s.NextMultiplier,
 	}
 }
 
@@ -773,17 +2753,184 @@ func shopItemToGenerated(item *model.ShopItem) generated.ShopItem {
 		Id: item.ID, Name: item.Name, Description: strPtr(item.Description),
 		ImageUrl: strPtr(item.ImageURL), PriceCoins: item.PriceCoins,
 		Stock: intPtr(item.Stock), CreatedAt: &item.CreatedAt,
+		CurrentPriceCoins: intPtr(item.PriceAt(time.Now())), SalePriceCoins: item.SalePriceCoins,
+		SaleStartsAt: item.SaleStartsAt, SaleEndsAt: item.SaleEndsAt,
 	}
 }
 
-func purchaseToGenerated(p *model.Purchase) generated.Purchase {
-	return generated.Purchase{
-		Id: p.ID, UserId: p.UserID, ItemId: p.ItemID,
-		ItemName: strPtr(p.ItemName), PriceCoins: intPtr(p.PriceCoins),
+func promoCodeToGenerated(p *model.PromoCode) generated.PromoCode {
+	return generated.PromoCode{
+		Id: p.ID, Code: p.Code, DiscountType: generated.PromoCodeDiscountType(p.DiscountType),
+		DiscountValue: p.DiscountValue, ItemId: p.ItemID, MaxUses: p.MaxUses,
+		MaxUsesPerUser: p.MaxUsesPerUser, UsedCount: p.UsedCount, ExpiresAt: p.ExpiresAt,
 		CreatedAt: &p.CreatedAt,
 	}
 }
 
+func purchaseToGenerated(p *model.Purchase) generated.Purchase {
+	r := generated.Purchase{
+		Id: p.ID, UserId: p.UserID, ItemId: p.ItemID, OrderId: p.OrderID,
+		ItemName: strPtr(p.ItemName), Quantity: intPtr(p.Quantity),
+		PriceCoins: intPtr(p.PriceCoins), CreatedAt: &p.CreatedAt,
+		PromoCodeId: p.PromoCodeID, DiscountCoins: intPtr(p.DiscountCoins),
+	}
+	if p.User != nil {
+		u := userToGenerated(p.User)
+		r.User = &u
+	}
+	return r
+}
+
+func salesReportToGenerated(rep *model.SalesReport) generated.SalesReport {
+	items := make([]generated.ItemSales, len(rep.Items))
+	for i, s := range rep.Items {
+		items[i] = generated.ItemSales{
+			ItemId: s.ItemID, ItemName: s.ItemName, UnitsSold: s.UnitsSold, CoinsSpent: s.CoinsSpent,
+		}
+	}
+	buyers := make([]generated.BuyerStats, len(rep.TopBuyers))
+	for i, b := range rep.TopBuyers {
+		buyers[i] = generated.BuyerStats{User: userToGenerated(&b.User), Units: b.Units, CoinsSpent: b.CoinsSpent}
+	}
+	return generated.SalesReport{
+		TotalUnits: rep.TotalUnits, TotalCoins: rep.TotalCoins, Items: items, TopBuyers: buyers,
+	}
+}
+
+func orderToGenerated(o *model.Order) generated.Order {
+	items := make([]generated.Purchase, len(o.Items))
+	for i, p := range o.Items {
+		items[i] = purchaseToGenerated(&p)
+	}
+	return generated.Order{
+		Id: o.ID, UserId: o.UserID, TotalCoins: o.TotalCoins,
+		Items: items, CreatedAt: &o.CreatedAt,
+	}
+}
+
+func ledgerEntryToGenerated(e *model.LedgerEntry) generated.LedgerEntry {
+	return generated.LedgerEntry{
+		Id: e.ID, Amount: e.Amount, Kind: e.Kind, CounterpartyId: e.CounterpartyID,
+		ActorId: e.ActorID, Note: strPtr(e.Note), CreatedAt: &e.CreatedAt,
+	}
+}
+
+func bulkAwardToGenerated(res *model.BulkAwardResult) generated.BulkAwardResult {
+	rows := make([]generated.CoinAwardRow, len(res.Rows))
+	for i, row := range res.Rows {
+		rows[i] = generated.CoinAwardRow{
+			Line: row.Line, Identifier: row.Identifier, Amount: row.Amount,
+			Reason: strPtr(row.Reason), Error: strPtr(row.Error),
+		}
+		if row.User != nil {
+			u := userToGenerated(row.User)
+			rows[i].User = &u
+		}
+	}
+	return generated.BulkAwardResult{
+		Rows: rows, Valid: res.Valid, TotalAmount: res.TotalAmount, Committed: res.Committed,
+	}
+}
+
+func leaderboardEntryToGenerated(e *model.LeaderboardEntry) generated.LeaderboardEntry {
+	return generated.LeaderboardEntry{
+		Rank: e.Rank, UserId: e.UserID, FirstName: e.FirstName, LastName: strPtr(e.LastName),
+		Username: strPtr(e.Username), PhotoUrl: strPtr(e.PhotoURL), Coins: e.Coins,
+		SchoolLevel: intPtr(e.SchoolLevel), CommunityLevel: intPtr(e.CommunityLevel), Score: e.Score, League: strPtr(e.League),
+	}
+}
+
+func leagueToGenerated(l *model.League) generated.League {
+	return generated.League{Name: l.Name, MinLevel: l.MinLevel, MaxLevel: l.MaxLevel}
+}
+
+func seasonFromRequest(req *generated.SeasonRequest) *model.Season {
+	season := &model.Season{Name: req.Name, StartsAt: req.StartsAt, EndsAt: req.EndsAt, CarryOverCoins: true}
+	if req.CarryOverCoins != nil {
+		season.CarryOverCoins = *req.CarryOverCoins
+	}
+	return season
+}
+
+func seasonToGenerated(s *model.Season) generated.Season {
+	return generated.Season{
+		Id: s.ID, Name: s.Name, StartsAt: s.StartsAt, EndsAt: s.EndsAt,
+		CarryOverCoins: s.CarryOverCoins, Status: generated.SeasonStatus(s.Status(time.Now())),
+		ClosedAt: s.ClosedAt, CreatedAt: &s.CreatedAt,
+	}
+}
+
+func awardRequestToGenerated(req *model.CoinAwardRequest) generated.CoinAwardRequest {
+	return generated.CoinAwardRequest{
+		Id: req.ID, Kind: generated.CoinAwardRequestKind(req.Kind), RequestedBy: req.RequestedBy,
+		UserId: req.UserID, Amount: req.Amount, Reason: req.Reason,
+		Status: generated.CoinAwardRequestStatus(req.Status), ReviewedBy: req.ReviewedBy,
+		ReviewedAt: req.ReviewedAt, CreatedAt: &req.CreatedAt,
+	}
+}
+
+func adminAlertToGenerated(a *model.AdminAlert) generated.AdminAlert {
+	return generated.AdminAlert{
+		Id: a.ID, Kind: a.Kind, Message: a.Message, AdminId: a.AdminID, UserId: a.UserID,
+		ResolvedBy: a.ResolvedBy, ResolvedAt: a.ResolvedAt, CreatedAt: &a.CreatedAt,
+	}
+}
+
+func raffleToGenerated(rf *model.Raffle) generated.Raffle {
+	r := generated.Raffle{
+		Id: rf.ID, Title: rf.Title, Description: strPtr(rf.Description), Prize: strPtr(rf.Prize),
+		ImageUrl: strPtr(rf.ImageURL), TicketPriceCoins: rf.TicketPriceCoins,
+		MaxTicketsPerUser: rf.MaxTicketsPerUser, Status: generated.RaffleStatus(rf.Status),
+		SeedHash: rf.SeedHash, TicketsSold: rf.TicketsSold, MyTickets: intPtr(rf.MyTickets),
+		WinningTicket: rf.WinningTicket, CreatedAt: &rf.CreatedAt, DrawnAt: rf.DrawnAt,
+	}
+	// The seed stays secret until the draw, otherwise the winner could be predicted.
+	if rf.Status == model.RaffleDrawn {
+		r.Seed = strPtr(rf.Seed)
+	}
+	if rf.Winner != nil {
+		u := userToGenerated(rf.Winner)
+		r.Winner = &u
+	}
+	return r
+}
+
+func questFromRequest(req *generated.QuestRequest) *model.Quest {
+	q := &model.Quest{
+		Title: req.Title, RewardCoins: req.RewardCoins, Deadline: req.Deadline,
+		MaxCompletions: req.MaxCompletions, Active: true,
+	}
+	if req.Description != nil {
+		q.Description = *req.Description
+	}
+	if req.Active != nil {
+		q.Active = *req.Active
+	}
+	return q
+}
+
+func questToGenerated(q *model.Quest, now time.Time) generated.Quest {
+	result := generated.Quest{
+		Id: q.ID, Title: q.Title, Description: strPtr(q.Description), RewardCoins: q.RewardCoins,
+		Deadline: q.Deadline, MaxCompletions: q.MaxCompletions, Active: q.Active, Open: q.Open(now),
+		Completions: q.Completions, CreatedAt: &q.CreatedAt,
+	}
+	if q.MyStatus != "" {
+		status := generated.QuestMyStatus(q.MyStatus)
+		result.MyStatus = &status
+	}
+	return result
+}
+
+func questSubmissionToGenerated(sub *model.QuestSubmission) generated.QuestSubmission {
+	return generated.QuestSubmission{
+		Id: sub.ID, QuestId: sub.QuestID, QuestTitle: sub.QuestTitle, UserId: sub.UserID,
+		ProofText: strPtr(sub.ProofText), ProofUrl: strPtr(sub.ProofURL),
+		Status: generated.QuestSubmissionStatus(sub.Status), ReviewNote: strPtr(sub.ReviewNote),
+		ReviewedBy: sub.ReviewedBy, ReviewedAt: sub.ReviewedAt, CreatedAt: &sub.CreatedAt,
+	}
+}
+
 func truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
 		return s
@@ -38,6 +38,7 @@ var publicGETPrefixes = []string{
 	"/api/gov",
 	"/api/leaderboard",
 	"/api/shop",
+	"/api/raffles",
 }
 
 func isPublic(method, path string) bool {
@@ -57,62 +58,70 @@ func isPublic(method, path string) bool {
 }
 
 // Auth validates the Telegram initData, resolves the user from the DB, and injects both into context.
-// Public routes skip authentication entirely.
+// Public routes never fail authentication, but still get the user injected when valid credentials are sent.
 func Auth(botToken string, userRepo *repository.UserRepository) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Skip auth for public routes
 			if isPublic(r.Method, r.URL.Path) {
+				if r.Header.Get("Authorization") != "" {
+					if ctx, _, errMsg := authenticate(r, botToken, userRepo); errMsg == "" {
+						r = r.WithContext(ctx)
+					}
+				}
 				next.ServeHTTP(w, r)
 				return
 			}
 
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
-				http.Error(w, `{"error":"missing authorization header"}`, http.StatusUnauthorized)
+			ctx, status, errMsg := authenticate(r, botToken, userRepo)
+			if errMsg != "" {
+				http.Error(w, `{"error":"`+errMsg+`"}`, status)
 				return
 			}
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
 
-			parts := strings.SplitN(authHeader, " ", 2)
-			if len(parts) != 2 || parts[0] != "tma" {
-				http.Error(w, `{"error":"invalid authorization format, expected: tma <initData>"}`, http.StatusUnauthorized)
-				return
-			}
+// authenticate resolves the caller from the Authorization header. On failure it
+// returns the HTTP status and error message to report.
+func authenticate(r *http.Request, botToken string, userRepo *repository.UserRepository) (context.Context, int, string) {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		return nil, http.StatusUnauthorized, "missing authorization header"
+	}
 
-			rawInitData := parts[1]
+	parts := strings.SplitN(authHeader, " ", 2)
+	if len(parts) != 2 || parts[0] != "tma" {
+		return nil, http.StatusUnauthorized, "invalid authorization format, expected: tma <initData>"
+	}
 
-			// Validate initData signature with 24h expiration
-			if err := initdata.Validate(rawInitData, botToken, 24*time.Hour); err != nil {
-				http.Error(w, `{"error":"invalid init data: `+err.Error()+`"}`, http.StatusUnauthorized)
-				return
-			}
+	rawInitData := parts[1]
 
-			// Parse the validated init data
-			parsed, err := initdata.Parse(rawInitData)
-			if err != nil {
-				http.Error(w, `{"error":"failed to parse init data"}`, http.StatusUnauthorized)
-				return
-			}
+	// Validate initData signature with 24h expiration
+	if err := initdata.Validate(rawInitData, botToken, 24*time.Hour); err != nil {
+		return nil, http.StatusUnauthorized, "invalid init data: " + err.Error()
+	}
 
-			if parsed.User.ID == 0 {
-				http.Error(w, `{"error":"init data missing user"}`, http.StatusUnauthorized)
-				return
-			}
+	// Parse the validated init data
+	parsed, err := initdata.Parse(rawInitData)
+	if err != nil {
+		return nil, http.StatusUnauthorized, "failed to parse init data"
+	}
 
-			// Resolve the full user from the database
-			user, err := userRepo.FindByTelegramID(r.Context(), parsed.User.ID)
-			if err != nil {
-				http.Error(w, `{"error":"failed to resolve user"}`, http.StatusInternalServerError)
-				return
-			}
-			if user == nil {
-				http.Error(w, `{"error":"user not found, please authenticate first"}`, http.StatusUnauthorized)
-				return
-			}
+	if parsed.User.ID == 0 {
+		return nil, http.StatusUnauthorized, "init data missing user"
+	}
 
-			ctx := context.WithValue(r.Context(), InitDataKey, parsed)
-			ctx = context.WithValue(ctx, UserKey, user)
-			next.ServeHTTP(w, r.WithContext(ctx))
-		})
+	// Resolve the full user from the database
+	user, err := userRepo.FindByTelegramID(r.Context(), parsed.User.ID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, "failed to resolve user"
+	}
+	if user == nil {
+		return nil, http.StatusUnauthorized, "user not found, please authenticate first"
 	}
+
+	ctx := context.WithValue(r.Context(), InitDataKey, parsed)
+	ctx = context.WithValue(ctx, UserKey, user)
+	return ctx, http.StatusOK, ""
 }
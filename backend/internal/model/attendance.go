@@ -7,5 +7,9 @@ type Attendance struct {
 	UserID       int64     `json:"user_id"`
 	EventName    string    `json:"event_name"`
 	CoinsAwarded int       `json:"coins_awarded"`
+	BaseCoins    int       `json:"base_coins"`
+	Multiplier   float64   `json:"multiplier"`
+	StreakWeeks  int       `json:"streak_weeks,omitempty"` // set on check-in
+	AwardedBy    *int64    `json:"awarded_by,omitempty"`
 	CreatedAt    time.Time `json:"created_at"`
 }
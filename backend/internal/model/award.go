@@ -0,0 +1,74 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// AwardLimits guard how many coins a single admin can hand out.
+// Zero disables the corresponding limit.
+type AwardLimits struct {
+	MaxSingle         int // hard ceiling for one award
+	ApprovalThreshold int // awards above this need a second admin
+	DailyCap          int // per admin, rolling 24 hours
+}
+
+// Check rejects awards above MaxSingle and reports whether amount needs approval.
+func (l AwardLimits) Check(amount int) (needsApproval bool, err error) {
+	if l.MaxSingle > 0 && amount > l.MaxSingle {
+		return false, fmt.Errorf("a single award cannot exceed %d coins", l.MaxSingle)
+	}
+	return l.ApprovalThreshold > 0 && amount > l.ApprovalThreshold, nil
+}
+
+const (
+	AwardRequestPending  = "pending"
+	AwardRequestApproved = "approved"
+	AwardRequestRejected = "rejected"
+)
+
+type CoinAwardRequest struct {
+	ID          int64      `json:"id"`
+	Kind        string     `json:"kind"`
+	RequestedBy int64      `json:"requested_by"`
+	UserID      int64      `json:"user_id"`
+	Amount      int        `json:"amount"`
+	Reason      string     `json:"reason"`
+	Status      string     `json:"status"`
+	ReviewedBy  *int64     `json:"reviewed_by,omitempty"`
+	ReviewedAt  *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// Admin alert kinds.
+const (
+	AlertRepeatCheckIn = "repeat_check_in"
+	AlertAwardSpike    = "award_spike"
+)
+
+type AdminAlert struct {
+	ID          int64      `json:"id"`
+	Kind        string     `json:"kind"`
+	Message     string     `json:"message"`
+	AdminID     *int64     `json:"admin_id,omitempty"`
+	UserID      *int64     `json:"user_id,omitempty"`
+	Fingerprint string     `json:"-"`
+	ResolvedBy  *int64     `json:"resolved_by,omitempty"`
+	ResolvedAt  *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+// RepeatCheckIn is an admin who checked in the same student several times recently.
+type RepeatCheckIn struct {
+	AdminID int64
+	UserID  int64
+	Count   int
+	Coins   int
+}
+
+// AwardSpike is an admin whose last 24 hours of awards far exceed their usual daily volume.
+type AwardSpike struct {
+	AdminID      int64
+	Awarded      int
+	DailyAverage float64
+}
@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+type Badge struct {
+	ID          int64     `json:"id"`
+	Code        string    `json:"code"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Icon        string    `json:"icon,omitempty"`
+	AwardedAt   time.Time `json:"awarded_at"` // set when listed for a user
+}
+
+// Badge counters, evaluated per user by the badge repository.
+const (
+	CounterCheckIns              = "check_ins"
+	CounterClubsJoined           = "clubs_joined"
+	CounterHackathonApplications = "hackathon_applications"
+	CounterHackathonFinals       = "hackathon_finals"
+	CounterPurchases             = "purchases"
+	CounterQuestsCompleted       = "quests_completed"
+)
+
+// BadgeDefinition is a declarative rule: when one of Events happens to a user
+// and Counter reaches Threshold for them, they earn the badge, plus
+// BonusCoins if set.
+type BadgeDefinition struct {
+	Code        string
+	Name        string
+	Description string
+	Icon        string
+	Events      []string
+	Counter     string
+	Threshold   int
+	BonusCoins  int
+}
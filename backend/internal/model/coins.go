@@ -0,0 +1,59 @@
+package model
+
+import "time"
+
+// Ledger entry kinds.
+const (
+	LedgerAttendance   = "attendance"
+	LedgerPurchase     = "purchase"
+	LedgerRaffleTicket = "raffle_ticket"
+	LedgerTransferOut  = "transfer_out"
+	LedgerTransferIn   = "transfer_in"
+	LedgerAdjustment   = "admin_adjustment"
+	LedgerBadgeBonus   = "badge_bonus"
+	LedgerQuestReward  = "quest_reward"
+)
+
+type LedgerEntry struct {
+	ID             int64     `json:"id"`
+	UserID         int64     `json:"user_id"`
+	Amount         int       `json:"amount"`
+	Kind           string    `json:"kind"`
+	RefID          *int64    `json:"ref_id,omitempty"`
+	CounterpartyID *int64    `json:"counterparty_id,omitempty"`
+	ActorID        *int64    `json:"actor_id,omitempty"`
+	Note           string    `json:"note"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type Transfer struct {
+	Sender    User      `json:"sender"`
+	Recipient User      `json:"recipient"`
+	Amount    int       `json:"amount"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CoinAdjustment is a manual credit (positive) or debit (negative) by an admin.
+type CoinAdjustment struct {
+	UserID int64  `json:"user_id"`
+	Amount int    `json:"amount"`
+	Reason string `json:"reason"`
+}
+
+// CoinAwardRow is one parsed line of a bulk award CSV.
+type CoinAwardRow struct {
+	Line       int    `json:"line"`
+	Identifier string `json:"identifier"`
+	Amount     int    `json:"amount"`
+	Reason     string `json:"reason"`
+	User       *User  `json:"user,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+type BulkAwardResult struct {
+	Rows        []CoinAwardRow `json:"rows"`
+	Valid       bool           `json:"valid"`
+	TotalAmount int            `json:"total_amount"`
+	Committed   bool           `json:"committed"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Domain event kinds published by services.
+const (
+	EventCheckedIn        = "attendance.checked_in"
+	EventClubJoined       = "club.joined"
+	EventHackathonApplied = "hackathon.applied"
+	EventHackathonResult  = "hackathon.result" // results were published for the user's team
+	EventPurchased        = "shop.purchased"
+	EventQuestCompleted   = "quest.completed"
+)
+
+// DomainEvent tells subscribers that something happened to a user. RefID
+// points at the row involved, e.g. the attendance or club id.
+type DomainEvent struct {
+	Kind       string
+	UserID     int64
+	RefID      int64
+	OccurredAt time.Time
+}
@@ -1,23 +1,201 @@
 package model
 
-import "time"
+import (
+	"slices"
+	"time"
+)
+
+// Hackathon lifecycle statuses.
+const (
+	HackathonDraft              = "draft"
+	HackathonRegistrationOpen   = "registration_open"
+	HackathonRegistrationClosed = "registration_closed"
+	HackathonRunning            = "running"
+	HackathonJudging            = "judging"
+	HackathonFinished           = "finished"
+	HackathonCancelled          = "cancelled"
+)
+
+var hackathonStatuses = []string{
+	HackathonDraft, HackathonRegistrationOpen, HackathonRegistrationClosed,
+	HackathonRunning, HackathonJudging, HackathonFinished, HackathonCancelled,
+}
+
+// Hackathon list filters grouping several statuses; drafts are in neither.
+const (
+	HackathonFilterActive = "active"
+	HackathonFilterPast   = "past"
+)
+
+var hackathonFilters = map[string][]string{
+	HackathonFilterActive: {HackathonRegistrationOpen, HackathonRegistrationClosed, HackathonRunning, HackathonJudging},
+	HackathonFilterPast:   {HackathonFinished, HackathonCancelled},
+}
+
+// hackathonTransitions lists the statuses each status may move to.
+var hackathonTransitions = map[string][]string{
+	HackathonDraft:              {HackathonRegistrationOpen, HackathonCancelled},
+	HackathonRegistrationOpen:   {HackathonRegistrationClosed, HackathonRunning, HackathonCancelled},
+	HackathonRegistrationClosed: {HackathonRegistrationOpen, HackathonRunning, HackathonCancelled},
+	HackathonRunning:            {HackathonJudging, HackathonCancelled},
+	HackathonJudging:            {HackathonFinished},
+}
+
+// HackathonStatusesFor resolves a list filter (active, past or a single
+// status) to statuses. ok is false for an unknown filter.
+func HackathonStatusesFor(filter string) (statuses []string, ok bool) {
+	if statuses, ok := hackathonFilters[filter]; ok {
+		return statuses, true
+	}
+	if slices.Contains(hackathonStatuses, filter) {
+		return []string{filter}, true
+	}
+	return nil, false
+}
+
+// HackathonSourcesFor returns the statuses that may move to status.
+func HackathonSourcesFor(status string) []string {
+	var from []string
+	for s, targets := range hackathonTransitions {
+		if slices.Contains(targets, status) {
+			from = append(from, s)
+		}
+	}
+	slices.Sort(from)
+	return from
+}
 
 type Hackathon struct {
-	ID          int64     `json:"id"`
-	Title       string    `json:"title"`
-	Description string    `json:"description"`
-	Status      string    `json:"status"`
-	StartDate   time.Time `json:"start_date"`
-	EndDate     time.Time `json:"end_date"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID                   int64      `json:"id"`
+	Title                string     `json:"title"`
+	Description          string     `json:"description"`
+	Status               string     `json:"status"`
+	StartDate            time.Time  `json:"start_date"`
+	EndDate              time.Time  `json:"end_date"`
+	RegistrationOpensAt  *time.Time `json:"registration_opens_at,omitempty"`
+	RegistrationClosesAt *time.Time `json:"registration_closes_at,omitempty"`
+	SubmissionDeadline   *time.Time `json:"submission_deadline,omitempty"` // nil = end_date
+	Location             string     `json:"location"`
+	Prizes               string     `json:"prizes"`
+	Rules                string     `json:"rules"`
+	MaxTeamSize          *int       `json:"max_team_size,omitempty"`    // nil = no limit
+	MaxParticipants      *int       `json:"max_participants,omitempty"` // nil = no limit
+	Participants         int        `json:"participants"`               // people in pending and approved applications
+	CoverImageURL        string     `json:"cover_image_url"`
+	OrganizerContacts    string     `json:"organizer_contacts"`
+	StatusChangedAt      time.Time  `json:"status_changed_at"`
+	ResultsPublishedAt   *time.Time `json:"results_published_at,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at"`
+}
+
+// CanMoveTo reports whether the lifecycle allows moving from the current status to status.
+func (h *Hackathon) CanMoveTo(status string) bool {
+	return slices.Contains(hackathonTransitions[h.Status], status)
+}
+
+// RegistrationOpen reports whether applications are accepted at now. The
+// opening is driven by the status alone, so admins can open early; the
+// deadline and start date are enforced even before the scheduler catches up.
+func (h *Hackathon) RegistrationOpen(now time.Time) bool {
+	return h.Status == HackathonRegistrationOpen && h.RegistrationDeadlineAhead(now)
+}
+
+// RegistrationDeadlineAhead reports whether registration may still be open at now.
+func (h *Hackathon) RegistrationDeadlineAhead(now time.Time) bool {
+	return (h.RegistrationClosesAt == nil || now.Before(*h.RegistrationClosesAt)) && now.Before(h.StartDate)
+}
+
+// SubmissionsClose returns when project submissions lock.
+func (h *Hackathon) SubmissionsClose() time.Time {
+	if h.SubmissionDeadline != nil {
+		return *h.SubmissionDeadline
+	}
+	return h.EndDate
+}
+
+// SubmissionsOpen reports whether teams may submit or edit projects at now:
+// while the hackathon runs, up to the submission deadline.
+func (h *Hackathon) SubmissionsOpen(now time.Time) bool {
+	return h.Status == HackathonRunning && now.Before(h.SubmissionsClose())
+}
+
+// Hackathon application statuses.
+const (
+	ApplicationPending    = "pending"
+	ApplicationApproved   = "approved"
+	ApplicationRejected   = "rejected"
+	ApplicationWaitlisted = "waitlisted"
+)
+
+// ApplicationHoldsPlace reports whether an application in status counts
+// towards a hackathon's participant cap.
+func ApplicationHoldsPlace(status string) bool {
+	return status == ApplicationPending || status == ApplicationApproved
+}
+
+// ApplicationReviewable reports whether an admin may set an application to status.
+func ApplicationReviewable(status string) bool {
+	return status == ApplicationApproved || status == ApplicationRejected || status == ApplicationWaitlisted
 }
 
 type HackathonApplication struct {
-	ID           int64     `json:"id"`
-	HackathonID  int64     `json:"hackathon_id"`
-	UserID       int64     `json:"user_id"`
-	TeamName     string    `json:"team_name"`
-	Status       string    `json:"status"`
-	CreatedAt    time.Time `json:"created_at"`
-	User         *User     `json:"user,omitempty"`
+	ID             int64        `json:"id"`
+	HackathonID    int64        `json:"hackathon_id"`
+	HackathonTitle string       `json:"hackathon_title"`
+	UserID         int64        `json:"user_id"`
+	TeamName       string       `json:"team_name"`
+	TeamID         *int64       `json:"team_id,omitempty"` // set for team applications, made by the captain
+	Places         int          `json:"places"`            // 1, or the team's size
+	Status         string       `json:"status"`
+	ReviewMessage  string       `json:"review_message"`
+	ReviewedBy     *int64       `json:"reviewed_by,omitempty"`
+	ReviewedAt     *time.Time   `json:"reviewed_at,omitempty"`
+	WaitlistPos    *int         `json:"waitlist_position,omitempty"` // 1-based, set while waitlisted
+	CreatedAt      time.Time    `json:"created_at"`
+	User           *User        `json:"user,omitempty"`
+	Answers        []FormAnswer `json:"answers,omitempty"`
+}
+
+// Application form field types.
+const (
+	FormFieldText        = "text"
+	FormFieldLongText    = "long_text"
+	FormFieldNumber      = "number"
+	FormFieldChoice      = "choice"
+	FormFieldMultiChoice = "multi_choice"
+	FormFieldCheckbox    = "checkbox"
+)
+
+var formFieldTypes = []string{
+	FormFieldText, FormFieldLongText, FormFieldNumber, FormFieldChoice, FormFieldMultiChoice, FormFieldCheckbox,
+}
+
+func IsFormFieldType(t string) bool {
+	return slices.Contains(formFieldTypes, t)
+}
+
+// FormField is a question on a hackathon's application form. Choices lists
+// the options of choice and multi_choice fields; a required checkbox must be
+// ticked.
+type FormField struct {
+	ID          int64    `json:"id"`
+	HackathonID int64    `json:"hackathon_id"`
+	Label       string   `json:"label"`
+	Type        string   `json:"type"`
+	Required    bool     `json:"required"`
+	Choices     []string `json:"choices"`
+}
+
+// HasChoices reports whether the field is answered by picking from Choices.
+func (f *FormField) HasChoices() bool {
+	return f.Type == FormFieldChoice || f.Type == FormFieldMultiChoice
+}
+
+// FormAnswer is an applicant's answer to a form field: Choices for
+// multi_choice fields, Value for the rest ("true" or "false" for checkboxes).
+type FormAnswer struct {
+	FieldID int64    `json:"field_id"`
+	Value   string   `json:"value,omitempty"`
+	Choices []string `json:"choices,omitempty"`
 }
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestHackathonCanMoveTo(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{HackathonDraft, HackathonRegistrationOpen, true},
+		{HackathonDraft, HackathonCancelled, true},
+		{HackathonDraft, HackathonRunning, false},
+		{HackathonRegistrationOpen, HackathonRegistrationClosed, true},
+		{HackathonRegistrationOpen, HackathonRunning, true},
+		{HackathonRegistrationOpen, HackathonDraft, false},
+		{HackathonRegistrationClosed, HackathonRegistrationOpen, true},
+		{HackathonRunning, HackathonJudging, true},
+		{HackathonRunning, HackathonFinished, false},
+		{HackathonJudging, HackathonFinished, true},
+		{HackathonJudging, HackathonCancelled, false},
+		{HackathonFinished, HackathonRunning, false},
+		{HackathonCancelled, HackathonDraft, false},
+		{HackathonRunning, HackathonRunning, false},
+		{"unknown", HackathonRunning, false},
+	}
+	for _, tt := range tests {
+		h := Hackathon{Status: tt.from}
+		if got := h.CanMoveTo(tt.to); got != tt.want {
+			t.Errorf("%s -> %s: CanMoveTo = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestHackathonSourcesFor(t *testing.T) {
+	tests := []struct {
+		status string
+		want   []string
+	}{
+		{HackathonDraft, nil},
+		{HackathonRunning, []string{HackathonRegistrationClosed, HackathonRegistrationOpen}},
+		{HackathonCancelled, []string{HackathonDraft, HackathonRegistrationClosed, HackathonRegistrationOpen, HackathonRunning}},
+		{HackathonFinished, []string{HackathonJudging}},
+	}
+	for _, tt := range tests {
+		if got := HackathonSourcesFor(tt.status); !slices.Equal(got, tt.want) {
+			t.Errorf("HackathonSourcesFor(%s) = %v, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestHackathonRegistrationOpen(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	later := now.Add(48 * time.Hour)
+
+	tests := []struct {
+		name   string
+		status string
+		closes *time.Time
+		start  time.Time
+		want   bool
+	}{
+		{"open, no deadline", HackathonRegistrationOpen, nil, later, true},
+		{"open, deadline ahead", HackathonRegistrationOpen, &future, later, true},
+		{"open, deadline passed", HackathonRegistrationOpen, &past, later, false},
+		{"open, deadline now", HackathonRegistrationOpen, &now, later, false},
+		{"open, already started", HackathonRegistrationOpen, nil, past, false},
+		{"draft", HackathonDraft, nil, later, false},
+		{"closed", HackathonRegistrationClosed, &future, later, false},
+		{"running", HackathonRunning, nil, later, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := Hackathon{Status: tt.status, RegistrationClosesAt: tt.closes, StartDate: tt.start}
+			if got := h.RegistrationOpen(now); got != tt.want {
+				t.Errorf("RegistrationOpen = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHackathonStatusesFor(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   []string
+		ok     bool
+	}{
+		{HackathonFilterActive, []string{HackathonRegistrationOpen, HackathonRegistrationClosed, HackathonRunning, HackathonJudging}, true},
+		{HackathonFilterPast, []string{HackathonFinished, HackathonCancelled}, true},
+		{HackathonDraft, []string{HackathonDraft}, true},
+		{"archived", nil, false},
+	}
+	for _, tt := range tests {
+		got, ok := HackathonStatusesFor(tt.filter)
+		if ok != tt.ok || !slices.Equal(got, tt.want) {
+			t.Errorf("HackathonStatusesFor(%q) = %v, %v; want %v, %v", tt.filter, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package model
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+)
+
+// FinalistPlacements is how many top placements count as reaching the final.
+const FinalistPlacements = 3
+
+// LedgerHackathonPrize credits a team member's share of a hackathon prize.
+const LedgerHackathonPrize = "hackathon_prize"
+
+// JudgingCriterion is one line of a hackathon's rubric. Scores run from 0 to
+// MaxScore and count towards a project's total in proportion to Weight.
+type JudgingCriterion struct {
+	ID          int64  `json:"id"`
+	HackathonID int64  `json:"hackathon_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Weight      int    `json:"weight"`
+	MaxScore    int    `json:"max_score"`
+}
+
+// Judge is a user allowed to score a hackathon's projects.
+type Judge struct {
+	HackathonID int64     `json:"hackathon_id"`
+	UserID      int64     `json:"user_id"`
+	Assigned    int       `json:"assigned"` // projects assigned
+	Scored      int       `json:"scored"`   // of which scored
+	User        *User     `json:"user,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// JudgeAssignment is a project a judge has to score, with their scores so far.
+type JudgeAssignment struct {
+	ProjectID   int64         `json:"project_id"`
+	JudgeID     int64         `json:"judge_id"`
+	HackathonID int64         `json:"hackathon_id"`
+	Project     *Project      `json:"project,omitempty"`
+	Scores      map[int64]int `json:"scores"` // criterion id -> score
+	Comment     string        `json:"comment"`
+	ScoredAt    *time.Time    `json:"scored_at,omitempty"`
+}
+
+// HackathonResult is a project's place in the ranking. Score is the average
+// over its judges of the weighted rubric score, normalised to 0-100; projects
+// nobody has scored have no score or placement.
+type HackathonResult struct {
+	ProjectID int64    `json:"project_id"`
+	TeamID    int64    `json:"team_id"`
+	TeamName  string   `json:"team_name"`
+	Title     string   `json:"title"`
+	Score     *float64 `json:"score,omitempty"`
+	Judges    int      `json:"judges"` // judges who scored the project
+	Placement *int     `json:"placement,omitempty"`
+	Prize     int      `json:"prize,omitempty"` // coins for the team's placement
+}
+
+// PrizeTier is the coin prize for a final placement. Teams sharing a
+// placement each win its prize.
+type PrizeTier struct {
+	Placement int `json:"placement"`
+	Coins     int `json:"coins"`
+}
+
+// PrizePayout is a team member's share of their team's prize.
+type PrizePayout struct {
+	HackathonID int64     `json:"hackathon_id"`
+	UserID      int64     `json:"user_id"`
+	TeamID      int64     `json:"team_id"`
+	Placement   int       `json:"placement"`
+	Coins       int       `json:"coins"`
+	User        *User     `json:"user,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// SplitPrize divides coins among n members as evenly as possible; the first
+// coins%n members get one coin more.
+func SplitPrize(coins, n int) []int {
+	shares := make([]int, n)
+	for i := range shares {
+		shares[i] = coins / n
+		if i < coins%n {
+			shares[i]++
+		}
+	}
+	return shares
+}
+
+// JudgeTotal is one judge's weighted rubric score for a project, 0-100.
+type JudgeTotal struct {
+	ProjectID int64
+	JudgeID   int64
+	Total     float64
+}
+
+// ScoreResults scores and ranks results from their judges' totals. Totals
+// are mean-centred per judge: a judge's average over their projects is
+// replaced by the average of all totals, so a harsh or lenient judge does
+// not sink or lift the projects they happen to score. Centring needs at
+// least two projects to compare, so a judge who scored just one keeps the
+// raw total. A project's score is the mean of its judges' totals, rounded
+// so near-equal scores tie. Results come back sorted best first, unscored
+// projects last, and placed by RankResults.
+func ScoreResults(results []HackathonResult, totals []JudgeTotal) {
+	var all float64
+	sums := make(map[int64]float64)
+	counts := make(map[int64]int)
+	for _, t := range totals {
+		all += t.Total
+		sums[t.JudgeID] += t.Total
+		counts[t.JudgeID]++
+	}
+	byProject := make(map[int64][]float64)
+	for _, t := range totals {
+		total := t.Total
+		if counts[t.JudgeID] >= 2 {
+			total += all/float64(len(totals)) - sums[t.JudgeID]/float64(counts[t.JudgeID])
+		}
+		byProject[t.ProjectID] = append(byProject[t.ProjectID], total)
+	}
+	for i := range results {
+		r := &results[i]
+		r.Score, r.Judges = nil, len(byProject[r.ProjectID])
+		if r.Judges == 0 {
+			continue
+		}
+		var sum float64
+		for _, total := range byProject[r.ProjectID] {
+			sum += total
+		}
+		score := math.Round(sum/float64(r.Judges)*100) / 100
+		r.Score = &score
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if (a.Score == nil) != (b.Score == nil) {
+			return a.Score != nil
+		}
+		if a.Score != nil && *a.Score != *b.Score {
+			return *a.Score > *b.Score
+		}
+		if na, nb := strings.ToLower(a.TeamName), strings.ToLower(b.TeamName); na != nb {
+			return na < nb
+		}
+		return a.ProjectID < b.ProjectID
+	})
+	RankResults(results)
+}
+
+// RankResults sets placements on results sorted by score, best first. Equal
+// scores share a placement and the next one is skipped (1, 1, 3).
+func RankResults(results []HackathonResult) {
+	for i := range results {
+		r := &results[i]
+		switch {
+		case r.Score == nil:
+			r.Placement = nil
+		case i > 0 && results[i-1].Score != nil && *results[i-1].Score == *r.Score:
+			r.Placement = results[i-1].Placement
+		default:
+			place := i + 1
+			r.Placement = &place
+		}
+	}
+}
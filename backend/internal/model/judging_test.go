@@ -0,0 +1,161 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+func score(v float64) *float64 { return &v }
+
+func placements(results []HackathonResult) []int {
+	out := make([]int, len(results))
+	for i, r := range results {
+		if r.Placement != nil {
+			out[i] = *r.Placement
+		}
+	}
+	return out
+}
+
+func TestRankResults(t *testing.T) {
+	tests := []struct {
+		name   string
+		scores []*float64
+		want   []int // 0 = no placement
+	}{
+		{"empty", nil, []int{}},
+		{"distinct", []*float64{score(90), score(80), score(70)}, []int{1, 2, 3}},
+		{"tie skips the next place", []*float64{score(90), score(90), score(70)}, []int{1, 1, 3}},
+		{"tie further down", []*float64{score(90), score(80), score(80), score(60)}, []int{1, 2, 2, 4}},
+		{"unscored get no place", []*float64{score(50), nil, nil}, []int{1, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := make([]HackathonResult, len(tt.scores))
+			for i, s := range tt.scores {
+				results[i].Score = s
+			}
+			RankResults(results)
+			got := placements(results)
+			if len(got) != len(tt.want) {
+				t.Fatalf("placements = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Fatalf("placements = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestScoreResults(t *testing.T) {
+	type want struct {
+		project   int64
+		score     float64 // ignored when placement is 0
+		placement int
+	}
+	tests := []struct {
+		name   string
+		totals []JudgeTotal
+		want   []want
+	}{
+		{
+			name: "disjoint judges keep raw totals",
+			totals: []JudgeTotal{
+				{ProjectID: 1, JudgeID: 10, Total: 40},
+				{ProjectID: 2, JudgeID: 11, Total: 80},
+				{ProjectID: 3, JudgeID: 12, Total: 60},
+			},
+			want: []want{{2, 80, 1}, {3, 60, 2}, {1, 40, 3}},
+		},
+		{
+			name: "harsh and lenient judges are centred",
+			totals: []JudgeTotal{
+				{ProjectID: 1, JudgeID: 10, Total: 90},
+				{ProjectID: 2, JudgeID: 10, Total: 70},
+				{ProjectID: 1, JudgeID: 11, Total: 50},
+				{ProjectID: 2, JudgeID: 11, Total: 30},
+			},
+			want: []want{{1, 70, 1}, {2, 50, 2}, {3, 0, 0}},
+		},
+		{
+			name: "single-project judge next to a centred one",
+			totals: []JudgeTotal{
+				{ProjectID: 1, JudgeID: 10, Total: 80},
+				{ProjectID: 2, JudgeID: 10, Total: 60},
+				{ProjectID: 3, JudgeID: 11, Total: 75},
+			},
+			// Mean of all totals is 71.67; judge 10 averages 70.
+			want: []want{{1, 81.67, 1}, {3, 75, 2}, {2, 61.67, 3}},
+		},
+		{
+			name: "equal scores tie",
+			totals: []JudgeTotal{
+				{ProjectID: 1, JudgeID: 10, Total: 55},
+				{ProjectID: 2, JudgeID: 11, Total: 55},
+				{ProjectID: 3, JudgeID: 12, Total: 20},
+			},
+			want: []want{{1, 55, 1}, {2, 55, 1}, {3, 20, 3}},
+		},
+		{
+			name: "nothing scored",
+			want: []want{{1, 0, 0}, {2, 0, 0}, {3, 0, 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := []HackathonResult{
+				{ProjectID: 1, TeamName: "Alpha"},
+				{ProjectID: 2, TeamName: "beta"},
+				{ProjectID: 3, TeamName: "Gamma"},
+			}
+			ScoreResults(results, tt.totals)
+			for i, w := range tt.want {
+				r := results[i]
+				if r.ProjectID != w.project {
+					t.Fatalf("result %d is project %d, want %d", i, r.ProjectID, w.project)
+				}
+				if w.placement == 0 {
+					if r.Score != nil || r.Placement != nil {
+						t.Errorf("project %d: unscored project got a score or placement", r.ProjectID)
+					}
+					continue
+				}
+				if r.Score == nil || *r.Score != w.score {
+					t.Errorf("project %d: score = %v, want %v", r.ProjectID, r.Score, w.score)
+				}
+				if r.Placement == nil || *r.Placement != w.placement {
+					t.Errorf("project %d: placement = %v, want %d", r.ProjectID, r.Placement, w.placement)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitPrize(t *testing.T) {
+	tests := []struct {
+		coins, n int
+		want     []int
+	}{
+		{500, 1, []int{500}},
+		{500, 4, []int{125, 125, 125, 125}},
+		{500, 3, []int{167, 167, 166}},
+		{10, 4, []int{3, 3, 2, 2}},
+		{2, 5, []int{1, 1, 0, 0, 0}},
+		{0, 3, []int{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		got := SplitPrize(tt.coins, tt.n)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("SplitPrize(%d, %d) = %v, want %v", tt.coins, tt.n, got, tt.want)
+		}
+		sum := 0
+		for _, c := range got {
+			sum += c
+		}
+		if sum != tt.coins {
+			t.Errorf("SplitPrize(%d, %d) pays out %d", tt.coins, tt.n, sum)
+		}
+	}
+}
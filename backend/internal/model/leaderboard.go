@@ -0,0 +1,122 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Leaderboard periods.
+const (
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+	PeriodAll   = "all"
+	// PeriodSeason is the current season; see Season.
+	PeriodSeason = "season"
+)
+
+// Leaderboard metrics.
+const (
+	MetricCoins       = "coins"        // coins earned in the period
+	MetricXP          = "xp"           // school XP, ignores the period
+	MetricLevel       = "level"        // school level, ignores the period
+	MetricAttendance  = "attendance"   // check-ins in the period
+	MetricCommunityXP = "community_xp" // community XP gained in the period
+)
+
+// LeaderboardRoles are the roles that compete on the leaderboard; staff
+// (admins) and unverified guests are left out.
+var LeaderboardRoles = []string{string(RoleStudent), string(RoleClubLeader)}
+
+// League is a leaderboard bucket of school levels. MaxLevel nil means no upper bound.
+type League struct {
+	Name     string `json:"name"`
+	MinLevel int    `json:"min_level"`
+	MaxLevel *int   `json:"max_level,omitempty"`
+}
+
+func (l League) Contains(level int) bool {
+	return level >= l.MinLevel && (l.MaxLevel == nil || level <= *l.MaxLevel)
+}
+
+// ParseLeagues reads a spec like "bronze:0-4,silver:5-9,gold:10-" into leagues
+// ordered by level. Ranges must not overlap.
+func ParseLeagues(spec string) ([]League, error) {
+	var leagues []League
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		name, levels, ok := strings.Cut(part, ":")
+		lo, hi, ok2 := strings.Cut(levels, "-")
+		if !ok || !ok2 || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("league %q: want name:min-max", part)
+		}
+		l := League{Name: strings.TrimSpace(name)}
+		var err error
+		if l.MinLevel, err = strconv.Atoi(strings.TrimSpace(lo)); err != nil {
+			return nil, fmt.Errorf("league %q: bad min level", part)
+		}
+		if hi = strings.TrimSpace(hi); hi != "" {
+			maxLevel, err := strconv.Atoi(hi)
+			if err != nil || maxLevel < l.MinLevel {
+				return nil, fmt.Errorf("league %q: bad max level", part)
+			}
+			l.MaxLevel = &maxLevel
+		}
+		if n := len(leagues); n > 0 {
+			prev := leagues[n-1]
+			if prev.MaxLevel == nil || *prev.MaxLevel >= l.MinLevel {
+				return nil, fmt.Errorf("league %q overlaps %q", l.Name, prev.Name)
+			}
+		}
+		leagues = append(leagues, l)
+	}
+	return leagues, nil
+}
+
+// LeagueFor returns the league containing level, or nil.
+func LeagueFor(leagues []League, level int) *League {
+	for i := range leagues {
+		if leagues[i].Contains(level) {
+			return &leagues[i]
+		}
+	}
+	return nil
+}
+
+// EarnedLedgerKinds are the ledger kinds that count as earning coins.
+// Spending and peer transfers do not move a user up or down the board.
+var EarnedLedgerKinds = []string{LedgerAttendance, LedgerAdjustment, LedgerBadgeBonus, LedgerQuestReward, LedgerReferralBonus, LedgerHackathonPrize}
+
+type LeaderboardQuery struct {
+	Period string
+	Metric string
+	Since  *time.Time // start of the period; nil for all time
+	Until  *time.Time // end of the period, exclusive; nil for now
+	League *League    // nil for everyone
+	Limit  int
+	Offset int
+}
+
+type LeaderboardEntry struct {
+	Rank           int    `json:"rank"`
+	UserID         int64  `json:"user_id"`
+	FirstName      string `json:"first_name"`
+	LastName       string `json:"last_name,omitempty"`
+	Username       string `json:"username,omitempty"`
+	PhotoURL       string `json:"photo_url,omitempty"`
+	Coins          int    `json:"coins"`
+	SchoolLevel    int    `json:"school_level"`
+	CommunityLevel int    `json:"community_level"`
+	Score          int64  `json:"score"`
+	League         string `json:"league,omitempty"`
+}
+
+// LeagueStandings is the top of one league's leaderboard.
+type LeagueStandings struct {
+	League  League             `json:"league"`
+	Entries []LeaderboardEntry `json:"entries"`
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Project is a team's hackathon project submission.
+type Project struct {
+	ID          int64        `json:"id"`
+	HackathonID int64        `json:"hackathon_id"`
+	TeamID      int64        `json:"team_id"`
+	TeamName    string       `json:"team_name"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	RepoURL     string       `json:"repository_url"`
+	DemoURL     string       `json:"demo_url"`
+	Attachments []string     `json:"attachments"` // links
+	UpdatedBy   *int64       `json:"updated_by,omitempty"`
+	Members     []TeamMember `json:"members,omitempty"`     // filled in organiser listings
+	FinalScore  *float64     `json:"final_score,omitempty"` // set when results are published
+	Placement   *int         `json:"placement,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
@@ -0,0 +1,46 @@
+package model
+
+import "time"
+
+// Quest submission statuses.
+const (
+	QuestSubmissionPending  = "pending"
+	QuestSubmissionApproved = "approved"
+	QuestSubmissionRejected = "rejected"
+)
+
+type Quest struct {
+	ID             int64      `json:"id"`
+	Title          string     `json:"title"`
+	Description    string     `json:"description"`
+	RewardCoins    int        `json:"reward_coins"`
+	Deadline       *time.Time `json:"deadline,omitempty"`
+	MaxCompletions *int       `json:"max_completions,omitempty"`
+	Active         bool       `json:"active"`
+	Completions    int        `json:"completions"` // approved submissions
+	MyStatus       string     `json:"my_status,omitempty"`
+	CreatedBy      *int64     `json:"created_by,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Open reports whether the quest still accepts submissions at now.
+func (q *Quest) Open(now time.Time) bool {
+	if !q.Active || (q.Deadline != nil && !now.Before(*q.Deadline)) {
+		return false
+	}
+	return q.MaxCompletions == nil || q.Completions < *q.MaxCompletions
+}
+
+type QuestSubmission struct {
+	ID         int64      `json:"id"`
+	QuestID    int64      `json:"quest_id"`
+	QuestTitle string     `json:"quest_title"`
+	UserID     int64      `json:"user_id"`
+	ProofText  string     `json:"proof_text"`
+	ProofURL   string     `json:"proof_url"`
+	Status     string     `json:"status"`
+	ReviewNote string     `json:"review_note"`
+	ReviewedBy *int64     `json:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
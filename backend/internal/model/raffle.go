@@ -0,0 +1,57 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+const (
+	RaffleOpen  = "open"
+	RaffleDrawn = "drawn"
+)
+
+type Raffle struct {
+	ID                int64      `json:"id"`
+	Title             string     `json:"title"`
+	Description       string     `json:"description"`
+	Prize             string     `json:"prize"`
+	ImageURL          string     `json:"image_url"`
+	TicketPriceCoins  int        `json:"ticket_price_coins"`
+	MaxTicketsPerUser *int       `json:"max_tickets_per_user,omitempty"`
+	Status            string     `json:"status"`
+	Seed              string     `json:"-"`
+	SeedHash          string     `json:"seed_hash"`
+	TicketsSold       int        `json:"tickets_sold"`
+	MyTickets         int        `json:"my_tickets"`
+	WinningTicket     *int       `json:"winning_ticket,omitempty"`
+	WinnerID          *int64     `json:"winner_id,omitempty"`
+	Winner            *User      `json:"winner,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	DrawnAt           *time.Time `json:"drawn_at,omitempty"`
+}
+
+type RaffleTicket struct {
+	ID           int64     `json:"id"`
+	RaffleID     int64     `json:"raffle_id"`
+	UserID       int64     `json:"user_id"`
+	TicketNumber int       `json:"ticket_number"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// SeedHash is the commitment published before any ticket is sold:
+// the hex SHA-256 of the hex-encoded seed.
+func SeedHash(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	return hex.EncodeToString(sum[:])
+}
+
+// WinningTicket picks a ticket number in [1, tickets] from the revealed seed.
+// Anyone can recompute it: take SHA-256 of "<seed>:<tickets>", read the first
+// 8 bytes as a big-endian integer, and add 1 to its remainder modulo tickets.
+func WinningTicket(seed string, tickets int) int {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%d", seed, tickets)))
+	return int(binary.BigEndian.Uint64(sum[:8])%uint64(tickets)) + 1
+}
@@ -0,0 +1,51 @@
+package model
+
+import "testing"
+
+func TestSeedHash(t *testing.T) {
+	tests := []struct {
+		seed string
+		want string
+	}{
+		{"", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"},
+		{"abc", "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"},
+		{"deadbeef", "2baf1f40105d9501fe319a8ec463fdf4325a2a5df445adf3f572f626253678c9"},
+	}
+	for _, tt := range tests {
+		if got := SeedHash(tt.seed); got != tt.want {
+			t.Errorf("SeedHash(%q) = %s, want %s", tt.seed, got, tt.want)
+		}
+	}
+}
+
+func TestWinningTicket(t *testing.T) {
+	// Expected values recomputed outside Go from the documented recipe.
+	tests := []struct {
+		seed    string
+		tickets int
+		want    int
+	}{
+		{"abc", 10, 7},
+		{"deadbeef", 7, 7},
+		{"00ff", 1000, 635},
+		{"seed", 3, 3},
+		{"anything", 1, 1},
+	}
+	for _, tt := range tests {
+		if got := WinningTicket(tt.seed, tt.tickets); got != tt.want {
+			t.Errorf("WinningTicket(%q, %d) = %d, want %d", tt.seed, tt.tickets, got, tt.want)
+		}
+	}
+}
+
+func TestWinningTicketInRange(t *testing.T) {
+	for tickets := 1; tickets <= 50; tickets++ {
+		n := WinningTicket("range-check", tickets)
+		if n < 1 || n > tickets {
+			t.Fatalf("WinningTicket(_, %d) = %d, outside [1, %d]", tickets, n, tickets)
+		}
+		if again := WinningTicket("range-check", tickets); again != n {
+			t.Fatalf("WinningTicket(_, %d) is not deterministic: %d then %d", tickets, n, again)
+		}
+	}
+}
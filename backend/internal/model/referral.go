@@ -0,0 +1,49 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	ReferralPending  = "pending"
+	ReferralRewarded = "rewarded"
+	ReferralRejected = "rejected"
+)
+
+// referralStartPrefix marks a referral in the Mini App start parameter.
+const referralStartPrefix = "ref_"
+
+// LedgerReferralBonus is paid to both sides when a referral is rewarded.
+const LedgerReferralBonus = "referral_bonus"
+
+type Referral struct {
+	ID          int64      `json:"id"`
+	ReferrerID  int64      `json:"referrer_id"`
+	Referee     User       `json:"referee"` // name and photo only
+	Status      string     `json:"status"`
+	Note        string     `json:"note,omitempty"`
+	RewardCoins int        `json:"reward_coins"`
+	CreatedAt   time.Time  `json:"created_at"`
+	RewardedAt  *time.Time `json:"rewarded_at,omitempty"`
+}
+
+// ReferralSummary is what a user sees about their own referrals.
+type ReferralSummary struct {
+	Code        string     `json:"code"`
+	StartParam  string     `json:"start_param"`
+	Link        string     `json:"link,omitempty"`
+	RewardCoins int        `json:"reward_coins"`
+	Referrals   []Referral `json:"referrals"`
+}
+
+// ReferralStartParam is the start parameter that refers new users to code.
+func ReferralStartParam(code string) string {
+	return referralStartPrefix + code
+}
+
+// ParseReferralStartParam extracts the referral code from a start parameter.
+func ParseReferralStartParam(startParam string) (string, bool) {
+	code, ok := strings.CutPrefix(startParam, referralStartPrefix)
+	return code, ok && code != ""
+}
@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// Season statuses, derived from the dates and closed_at.
+const (
+	SeasonUpcoming = "upcoming"
+	SeasonActive   = "active"
+	SeasonEnded    = "ended" // past ends_at, waiting for the close job
+	SeasonClosed   = "closed"
+)
+
+// LedgerSeasonReset zeroes a balance when a season without carry-over closes.
+const LedgerSeasonReset = "season_reset"
+
+type Season struct {
+	ID             int64      `json:"id"`
+	Name           string     `json:"name"`
+	StartsAt       time.Time  `json:"starts_at"`
+	EndsAt         time.Time  `json:"ends_at"`
+	CarryOverCoins bool       `json:"carry_over_coins"`
+	ClosedAt       *time.Time `json:"closed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+func (s *Season) Status(now time.Time) string {
+	switch {
+	case s.ClosedAt != nil:
+		return SeasonClosed
+	case now.Before(s.StartsAt):
+		return SeasonUpcoming
+	case now.Before(s.EndsAt):
+		return SeasonActive
+	default:
+		return SeasonEnded
+	}
+}
+
+// SeasonStandings is a season with its leaderboard: the archived final
+// standings once closed, live standings before that.
+type SeasonStandings struct {
+	Season    Season             `json:"season"`
+	Standings []LeaderboardEntry `json:"standings"`
+}
@@ -10,13 +10,109 @@ type ShopItem struct {
 	PriceCoins int       `json:"price_coins"`
 	Stock      int       `json:"stock"`
 	CreatedAt  time.Time `json:"created_at"`
+
+	// Optional sale price, active only between SaleStartsAt and SaleEndsAt.
+	SalePriceCoins *int       `json:"sale_price_coins,omitempty"`
+	SaleStartsAt   *time.Time `json:"sale_starts_at,omitempty"`
+	SaleEndsAt     *time.Time `json:"sale_ends_at,omitempty"`
+}
+
+// PriceAt returns the unit price at time t, taking an active sale into account.
+func (i *ShopItem) PriceAt(t time.Time) int {
+	if i.SalePriceCoins == nil {
+		return i.PriceCoins
+	}
+	if i.SaleStartsAt != nil && t.Before(*i.SaleStartsAt) {
+		return i.PriceCoins
+	}
+	if i.SaleEndsAt != nil && !t.Before(*i.SaleEndsAt) {
+		return i.PriceCoins
+	}
+	return *i.SalePriceCoins
+}
+
+const (
+	DiscountPercent = "percent"
+	DiscountFixed   = "fixed"
+)
+
+type PromoCode struct {
+	ID             int64      `json:"id"`
+	Code           string     `json:"code"`
+	DiscountType   string     `json:"discount_type"`
+	DiscountValue  int        `json:"discount_value"`
+	ItemID         *int64     `json:"item_id,omitempty"`
+	MaxUses        *int       `json:"max_uses,omitempty"`
+	MaxUsesPerUser *int       `json:"max_uses_per_user,omitempty"`
+	UsedCount      int        `json:"used_count"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+// Discount returns how many coins the code takes off price, never more than price.
+func (p *PromoCode) Discount(price int) int {
+	d := p.DiscountValue
+	if p.DiscountType == DiscountPercent {
+		d = price * p.DiscountValue / 100
+	}
+	return min(d, price)
 }
 
 type Purchase struct {
 	ID         int64     `json:"id"`
 	UserID     int64     `json:"user_id"`
 	ItemID     int64     `json:"item_id"`
+	OrderID    *int64    `json:"order_id,omitempty"`
 	ItemName   string    `json:"item_name,omitempty"`
+	Quantity   int       `json:"quantity"`
 	PriceCoins int       `json:"price_coins"`
 	CreatedAt  time.Time `json:"created_at"`
+	User       *User     `json:"user,omitempty"`
+
+	PromoCodeID   *int64 `json:"promo_code_id,omitempty"`
+	DiscountCoins int    `json:"discount_coins"`
+}
+
+// PurchaseFilter narrows purchase listings and reports; nil fields are ignored.
+type PurchaseFilter struct {
+	UserID *int64
+	ItemID *int64
+	From   *time.Time
+	To     *time.Time
+}
+
+// ItemSales aggregates purchases of a single shop item.
+type ItemSales struct {
+	ItemID     int64  `json:"item_id"`
+	ItemName   string `json:"item_name"`
+	UnitsSold  int    `json:"units_sold"`
+	CoinsSpent int    `json:"coins_spent"`
+}
+
+// BuyerStats aggregates purchases made by a single user.
+type BuyerStats struct {
+	User       User `json:"user"`
+	Units      int  `json:"units"`
+	CoinsSpent int  `json:"coins_spent"`
+}
+
+type SalesReport struct {
+	TotalUnits int          `json:"total_units"`
+	TotalCoins int          `json:"total_coins"`
+	Items      []ItemSales  `json:"items"`
+	TopBuyers  []BuyerStats `json:"top_buyers"`
+}
+
+// CartItem is a single line of a checkout request.
+type CartItem struct {
+	ItemID   int64 `json:"item_id"`
+	Quantity int   `json:"quantity"`
+}
+
+type Order struct {
+	ID         int64      `json:"id"`
+	UserID     int64      `json:"user_id"`
+	TotalCoins int        `json:"total_coins"`
+	Items      []Purchase `json:"items"`
+	CreatedAt  time.Time  `json:"created_at"`
 }
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShopItemPriceAt(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	before, after := now.Add(-time.Hour), now.Add(time.Hour)
+	sale := 60
+
+	tests := []struct {
+		name   string
+		sale   *int
+		starts *time.Time
+		ends   *time.Time
+		want   int
+	}{
+		{"no sale", nil, nil, nil, 100},
+		{"open-ended sale", &sale, nil, nil, 60},
+		{"sale running", &sale, &before, &after, 60},
+		{"sale not started", &sale, &after, nil, 100},
+		{"sale starts now", &sale, &now, nil, 60},
+		{"sale ended", &sale, nil, &before, 100},
+		{"sale ends now", &sale, nil, &now, 100},
+		{"dates without a sale price", nil, &before, &after, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			item := ShopItem{PriceCoins: 100, SalePriceCoins: tt.sale, SaleStartsAt: tt.starts, SaleEndsAt: tt.ends}
+			if got := item.PriceAt(now); got != tt.want {
+				t.Errorf("PriceAt = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPromoCodeDiscount(t *testing.T) {
+	tests := []struct {
+		name  string
+		typ   string
+		value int
+		price int
+		want  int
+	}{
+		{"percent", DiscountPercent, 25, 200, 50},
+		{"percent rounds down", DiscountPercent, 10, 95, 9},
+		{"full percent", DiscountPercent, 100, 80, 80},
+		{"percent of nothing", DiscountPercent, 50, 0, 0},
+		{"fixed", DiscountFixed, 30, 200, 30},
+		{"fixed capped at price", DiscountFixed, 300, 200, 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := PromoCode{DiscountType: tt.typ, DiscountValue: tt.value}
+			if got := p.Discount(tt.price); got != tt.want {
+				t.Errorf("Discount(%d) = %d, want %d", tt.price, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Streak counts consecutive campus-time weeks with at least one check-in.
+// A streak stays current through the week after its last check-in.
+type Streak struct {
+	CurrentWeeks   int     `json:"current_weeks"`
+	BestWeeks      int     `json:"best_weeks"`
+	ActiveThisWeek bool    `json:"active_this_week"`
+	NextMultiplier float64 `json:"next_multiplier"` // applied to the next check-in
+}
+
+// NextWeeks is the streak length a check-in made now would count towards.
+func (s Streak) NextWeeks() int {
+	if s.ActiveThisWeek {
+		return s.CurrentWeeks
+	}
+	return s.CurrentWeeks + 1
+}
+
+// StreakMultiplier boosts check-in coins once a streak reaches MinWeeks.
+type StreakMultiplier struct {
+	MinWeeks int
+	Factor   float64
+}
+
+// StreakMultipliers are ordered by MinWeeks.
+type StreakMultipliers []StreakMultiplier
+
+// ParseStreakMultipliers reads a spec like "2:1.1,4:1.25,8:1.5".
+func ParseStreakMultipliers(spec string) (StreakMultipliers, error) {
+	var list StreakMultipliers
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		weeks, factor, ok := strings.Cut(part, ":")
+		if !ok {
+			return nil, fmt.Errorf("multiplier %q: want weeks:factor", part)
+		}
+		var m StreakMultiplier
+		var err error
+		if m.MinWeeks, err = strconv.Atoi(strings.TrimSpace(weeks)); err != nil || m.MinWeeks < 1 {
+			return nil, fmt.Errorf("multiplier %q: bad weeks", part)
+		}
+		m.Factor, err = strconv.ParseFloat(strings.TrimSpace(factor), 64)
+		if err != nil || math.IsNaN(m.Factor) || math.IsInf(m.Factor, 0) || m.Factor < 1 {
+			return nil, fmt.Errorf("multiplier %q: factor must be at least 1", part)
+		}
+		list = append(list, m)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].MinWeeks < list[j].MinWeeks })
+	return list, nil
+}
+
+// For returns the factor for a streak of weeks, 1 if none applies.
+func (ms StreakMultipliers) For(weeks int) float64 {
+	factor := 1.0
+	for _, m := range ms {
+		if weeks >= m.MinWeeks {
+			factor = m.Factor
+		}
+	}
+	return factor
+}
+
+// ApplyMultiplier multiplies coins by factor, rounding to the nearest coin.
+func ApplyMultiplier(coins int, factor float64) int {
+	return int(math.Round(float64(coins) * factor))
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseStreakMultipliers(t *testing.T) {
+	tests := []struct {
+		spec    string
+		want    StreakMultipliers
+		wantErr string
+	}{
+		{spec: "", want: nil},
+		{spec: "2:1.1,4:1.25,8:1.5", want: StreakMultipliers{{2, 1.1}, {4, 1.25}, {8, 1.5}}},
+		{spec: " 8 : 1.5 , 2:1.1,, 4:1.25 ", want: StreakMultipliers{{2, 1.1}, {4, 1.25}, {8, 1.5}}},
+		{spec: "3:1", want: StreakMultipliers{{3, 1}}},
+		{spec: "2", wantErr: "want weeks:factor"},
+		{spec: "two:1.1", wantErr: "bad weeks"},
+		{spec: "0:1.1", wantErr: "bad weeks"},
+		{spec: "2:0.9", wantErr: "factor must be at least 1"},
+		{spec: "2:x", wantErr: "factor must be at least 1"},
+		{spec: "2:NaN", wantErr: "factor must be at least 1"},
+		{spec: "2:Inf", wantErr: "factor must be at least 1"},
+		{spec: "2:1e999", wantErr: "factor must be at least 1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := ParseStreakMultipliers(tt.spec)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStreakMultipliersFor(t *testing.T) {
+	ms := StreakMultipliers{{2, 1.1}, {4, 1.25}, {8, 1.5}}
+	tests := []struct {
+		weeks int
+		want  float64
+	}{
+		{0, 1}, {1, 1}, {2, 1.1}, {3, 1.1}, {4, 1.25}, {7, 1.25}, {8, 1.5}, {52, 1.5},
+	}
+	for _, tt := range tests {
+		if got := ms.For(tt.weeks); got != tt.want {
+			t.Errorf("For(%d) = %v, want %v", tt.weeks, got, tt.want)
+		}
+	}
+	if got := StreakMultipliers(nil).For(10); got != 1 {
+		t.Errorf("no multipliers: For(10) = %v, want 1", got)
+	}
+}
+
+func TestApplyMultiplier(t *testing.T) {
+	tests := []struct {
+		coins  int
+		factor float64
+		want   int
+	}{
+		{10, 1, 10}, {10, 1.25, 13}, {10, 1.1, 11}, {3, 1.5, 5}, {0, 1.5, 0},
+	}
+	for _, tt := range tests {
+		if got := ApplyMultiplier(tt.coins, tt.factor); got != tt.want {
+			t.Errorf("ApplyMultiplier(%d, %v) = %d, want %d", tt.coins, tt.factor, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// teamStartPrefix marks a team invite in the Mini App start parameter.
+const teamStartPrefix = "team_"
+
+// TeamStartParam is the start parameter that invites users to the team with code.
+func TeamStartParam(code string) string {
+	return teamStartPrefix + code
+}
+
+// ParseTeamStartParam extracts the invite code from a start parameter.
+func ParseTeamStartParam(startParam string) (string, bool) {
+	code, ok := strings.CutPrefix(startParam, teamStartPrefix)
+	return code, ok && code != ""
+}
+
+type Team struct {
+	ID             int64        `json:"id"`
+	HackathonID    int64        `json:"hackathon_id"`
+	HackathonTitle string       `json:"hackathon_title"`
+	Name           string       `json:"name"`
+	CaptainID      int64        `json:"captain_id"`
+	InviteCode     string       `json:"invite_code,omitempty"` // members only
+	InviteLink     string       `json:"invite_link,omitempty"` // members only
+	MaxSize        int          `json:"max_size"`
+	Members        []TeamMember `json:"members"`
+	// The team's application, once the captain has applied
+	ApplicationID     *int64    `json:"application_id,omitempty"`
+	ApplicationStatus string    `json:"application_status,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+}
+
+// HasMember reports whether userID is on the team.
+func (t *Team) HasMember(userID int64) bool {
+	for _, m := range t.Members {
+		if m.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+type TeamMember struct {
+	UserID   int64     `json:"user_id"`
+	JoinedAt time.Time `json:"joined_at"`
+	User     *User     `json:"user,omitempty"`
+}
+
+// PoolEntry is a student in a hackathon's "looking for team" pool.
+type PoolEntry struct {
+	HackathonID int64     `json:"hackathon_id"`
+	UserID      int64     `json:"user_id"`
+	Skills      []string  `json:"skills"`
+	Roles       []string  `json:"roles"` // preferred roles, e.g. backend or design
+	Note        string    `json:"note"`
+	Applied     bool      `json:"applied"` // has an individual application, so cannot be matched
+	User        *User     `json:"user,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TeamSuggestion is a proposed team drawn from the pool.
+type TeamSuggestion struct {
+	Members      []PoolEntry `json:"members"`
+	AverageLevel float64     `json:"average_level"`
+	Skills       []string    `json:"skills"` // skills covered by the members
+	Roles        []string    `json:"roles"`  // roles covered by the members
+}
@@ -12,18 +12,21 @@ const (
 )
 
 type User struct {
-	ID          int64     `json:"id"`
-	TelegramID  int64     `json:"telegram_id"`
-	Username    string    `json:"username,omitempty"`
-	FirstName   string    `json:"first_name,omitempty"`
-	LastName    string    `json:"last_name,omitempty"`
-	PhotoURL    string    `json:"photo_url,omitempty"`
-	Role        Role      `json:"role"`
-	SchoolLogin string    `json:"school_login,omitempty"`
-	SchoolLevel int       `json:"school_level"`
-	SchoolXP    int64     `json:"school_xp"`
-	AuditRatio  float64   `json:"audit_ratio"`
-	Coins       int       `json:"coins"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          int64   `json:"id"`
+	TelegramID  int64   `json:"telegram_id"`
+	Username    string  `json:"username,omitempty"`
+	FirstName   string  `json:"first_name,omitempty"`
+	LastName    string  `json:"last_name,omitempty"`
+	PhotoURL    string  `json:"photo_url,omitempty"`
+	Role        Role    `json:"role"`
+	SchoolLogin string  `json:"school_login,omitempty"`
+	SchoolLevel int     `json:"school_level"`
+	SchoolXP    int64   `json:"school_xp"`
+	AuditRatio  float64 `json:"audit_ratio"`
+	Coins       int     `json:"coins"`
+	// Community XP only grows; unlike coins it cannot be spent
+	CommunityXP    int64     `json:"community_xp"`
+	CommunityLevel int       `json:"community_level"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
 }
@@ -0,0 +1,53 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CommunityLevels holds the community XP needed for level 2, 3, and so on;
+// every user starts at level 1.
+type CommunityLevels []int64
+
+// ParseCommunityLevels reads a spec like "100,300,600" into thresholds, which
+// must be positive and strictly increasing.
+func ParseCommunityLevels(spec string) (CommunityLevels, error) {
+	var levels CommunityLevels
+	for _, part := range strings.Split(spec, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		xp, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || xp <= 0 {
+			return nil, fmt.Errorf("level threshold %q: want a positive integer", part)
+		}
+		if n := len(levels); n > 0 && xp <= levels[n-1] {
+			return nil, fmt.Errorf("level threshold %d must be above %d", xp, levels[n-1])
+		}
+		levels = append(levels, xp)
+	}
+	return levels, nil
+}
+
+// Level returns the level reached with xp.
+func (l CommunityLevels) Level(xp int64) int {
+	level := 1
+	for _, threshold := range l {
+		if xp < threshold {
+			break
+		}
+		level++
+	}
+	return level
+}
+
+// NextLevelXP returns the XP needed for the level after xp's, or nil at the top level.
+func (l CommunityLevels) NextLevelXP(xp int64) *int64 {
+	level := l.Level(xp)
+	if level > len(l) {
+		return nil
+	}
+	return &l[level-1]
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
 )
@@ -16,20 +17,72 @@ func NewAttendanceRepository(pool *pgxpool.Pool) *AttendanceRepository {
 	return &AttendanceRepository{pool: pool}
 }
 
-func (r *AttendanceRepository) CheckIn(ctx context.Context, a *model.Attendance) (*model.Attendance, error) {
+const attendanceColumns = `id, user_id, event_name, coins_awarded, COALESCE(base_coins, coins_awarded), multiplier, awarded_by, created_at`
+
+// CheckIn records attendance and credits a.BaseCoins times the user's streak
+// multiplier, with weeks counted in timezone tz. The streak is read in the same
+// transaction, under a lock on the user, so concurrent check-ins cannot both
+// miss each other. When the check-in is made by an admin (AwardedBy), the
+// credited coins count towards that admin's dailyCap.
+func (r *AttendanceRepository) CheckIn(ctx context.Context, a *model.Attendance, tz string, multipliers model.StreakMultipliers, dailyCap int) (*model.Attendance, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := checkIn(ctx, tx, a, tz, multipliers, dailyCap)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func checkIn(ctx context.Context, tx pgx.Tx, a *model.Attendance, tz string, multipliers model.StreakMultipliers, dailyCap int) (*model.Attendance, error) {
+	// The cap lock comes before the user row, as in adjustCoins.
+	if a.AwardedBy != nil && dailyCap > 0 {
+		if err := lockAwardCap(ctx, tx, *a.AwardedBy); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM users WHERE id = $1 FOR UPDATE`, a.UserID); err != nil {
+		return nil, err
+	}
+
+	streak, err := queryStreak(ctx, tx, a.UserID, tz)
+	if err != nil {
+		return nil, err
+	}
+	a.StreakWeeks = streak.NextWeeks()
+	a.Multiplier = 1.0
+	if a.BaseCoins > 0 {
+		a.Multiplier = multipliers.For(a.StreakWeeks)
+	}
+	a.CoinsAwarded = model.ApplyMultiplier(a.BaseCoins, a.Multiplier)
+
+	if a.AwardedBy != nil {
+		if err := enforceAwardCap(ctx, tx, *a.AwardedBy, a.CoinsAwarded, dailyCap); err != nil {
+			return nil, err
+		}
+	}
+
 	var result model.Attendance
-	err := r.pool.QueryRow(ctx,
-		`INSERT INTO attendance (user_id, event_name, coins_awarded)
-		 VALUES ($1, $2, $3)
-		 RETURNING id, user_id, event_name, coins_awarded, created_at`,
-		a.UserID, a.EventName, a.CoinsAwarded,
-	).Scan(&result.ID, &result.UserID, &result.EventName, &result.CoinsAwarded, &result.CreatedAt)
+	err = tx.QueryRow(ctx,
+		`INSERT INTO attendance (user_id, event_name, coins_awarded, base_coins, multiplier, awarded_by)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING `+attendanceColumns,
+		a.UserID, a.EventName, a.CoinsAwarded, a.BaseCoins, a.Multiplier, a.AwardedBy,
+	).Scan(&result.ID, &result.UserID, &result.EventName, &result.CoinsAwarded, &result.BaseCoins, &result.Multiplier,
+		&result.AwardedBy, &result.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
 
 	// Add coins to user
-	_, err = r.pool.Exec(ctx,
+	_, err = tx.Exec(ctx,
 		`UPDATE users SET coins = coins + $1, updated_at = NOW() WHERE id = $2`,
 		a.CoinsAwarded, a.UserID,
 	)
@@ -37,12 +90,19 @@ func (r *AttendanceRepository) CheckIn(ctx context.Context, a *model.Attendance)
 		return nil, fmt.Errorf("failed to update coins: %w", err)
 	}
 
+	if a.CoinsAwarded != 0 {
+		entry := &model.LedgerEntry{UserID: a.UserID, Amount: a.CoinsAwarded, Kind: model.LedgerAttendance, RefID: &result.ID, ActorID: a.AwardedBy, Note: a.EventName}
+		if err := insertLedgerEntry(ctx, tx, entry); err != nil {
+			return nil, err
+		}
+	}
+	result.StreakWeeks = a.StreakWeeks
 	return &result, nil
 }
 
 func (r *AttendanceRepository) ListByUserID(ctx context.Context, userID int64) ([]model.Attendance, error) {
 	rows, err := r.pool.Query(ctx,
-		`SELECT id, user_id, event_name, coins_awarded, created_at FROM attendance WHERE user_id = $1 ORDER BY created_at DESC`, userID,
+		`SELECT `+attendanceColumns+` FROM attendance WHERE user_id = $1 ORDER BY created_at DESC`, userID,
 	)
 	if err != nil {
 		return nil, err
@@ -52,10 +112,42 @@ func (r *AttendanceRepository) ListByUserID(ctx context.Context, userID int64) (
 	var list []model.Attendance
 	for rows.Next() {
 		var a model.Attendance
-		if err := rows.Scan(&a.ID, &a.UserID, &a.EventName, &a.CoinsAwarded, &a.CreatedAt); err != nil {
+		if err := rows.Scan(&a.ID, &a.UserID, &a.EventName, &a.CoinsAwarded, &a.BaseCoins, &a.Multiplier, &a.AwardedBy, &a.CreatedAt); err != nil {
 			return nil, err
 		}
 		list = append(list, a)
 	}
 	return list, rows.Err()
 }
+
+// Streak computes userID's weekly check-in streaks, with weeks starting on
+// Monday in the timezone tz.
+func (r *AttendanceRepository) Streak(ctx context.Context, userID int64, tz string) (*model.Streak, error) {
+	return queryStreak(ctx, r.pool, userID, tz)
+}
+
+func queryStreak(ctx context.Context, q querier, userID int64, tz string) (*model.Streak, error) {
+	var s model.Streak
+	err := q.QueryRow(ctx,
+		`WITH weeks AS (
+		     SELECT DISTINCT date_trunc('week', created_at AT TIME ZONE $2)::date AS week
+		     FROM attendance WHERE user_id = $1
+		 ), runs AS (
+		     -- consecutive weeks share week - 7 * row_number
+		     SELECT MAX(week) AS last_week, COUNT(*) AS weeks
+		     FROM (SELECT week, week - (7 * ROW_NUMBER() OVER (ORDER BY week))::int AS run FROM weeks) w
+		     GROUP BY run
+		 ), cur AS (
+		     SELECT date_trunc('week', NOW() AT TIME ZONE $2)::date AS week
+		 )
+		 SELECT COALESCE(MAX(r.weeks) FILTER (WHERE r.last_week >= n.week - 7), 0),
+		        COALESCE(MAX(r.weeks), 0),
+		        COALESCE(BOOL_OR(r.last_week = n.week), FALSE)
+		 FROM runs r CROSS JOIN cur n`,
+		userID, tz,
+	).Scan(&s.CurrentWeeks, &s.BestWeeks, &s.ActiveThisWeek)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type AwardRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAwardRepository(pool *pgxpool.Pool) *AwardRepository {
+	return &AwardRepository{pool: pool}
+}
+
+const awardRequestColumns = `id, kind, requested_by, user_id, amount, reason, status, reviewed_by, reviewed_at, created_at`
+
+func scanAwardRequest(row pgx.Row) (*model.CoinAwardRequest, error) {
+	var req model.CoinAwardRequest
+	err := row.Scan(&req.ID, &req.Kind, &req.RequestedBy, &req.UserID, &req.Amount, &req.Reason,
+		&req.Status, &req.ReviewedBy, &req.ReviewedAt, &req.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &req, nil
+}
+
+func (r *AwardRepository) CreateRequest(ctx context.Context, req *model.CoinAwardRequest) (*model.CoinAwardRequest, error) {
+	return scanAwardRequest(r.pool.QueryRow(ctx,
+		`INSERT INTO coin_award_requests (kind, requested_by, user_id, amount, reason)
+		 VALUES ($1, $2, $3, $4, $5)
+		 RETURNING `+awardRequestColumns,
+		req.Kind, req.RequestedBy, req.UserID, req.Amount, req.Reason))
+}
+
+func (r *AwardRepository) GetRequest(ctx context.Context, id int64) (*model.CoinAwardRequest, error) {
+	return scanAwardRequest(r.pool.QueryRow(ctx,
+		`SELECT `+awardRequestColumns+` FROM coin_award_requests WHERE id = $1`, id))
+}
+
+func (r *AwardRepository) ListRequests(ctx context.Context, status string) ([]model.CoinAwardRequest, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+awardRequestColumns+` FROM coin_award_requests
+		 WHERE status = $1
+		 ORDER BY created_at DESC
+		 LIMIT 200`, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.CoinAwardRequest
+	for rows.Next() {
+		req, err := scanAwardRequest(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *req)
+	}
+	return list, rows.Err()
+}
+
+// ReviewRequest moves a pending request to status on behalf of reviewerID.
+// It returns nil if the request is not pending or was made by the reviewer,
+// so two admins racing to approve cannot both win.
+func (r *AwardRepository) ReviewRequest(ctx context.Context, id, reviewerID int64, status string) (*model.CoinAwardRequest, error) {
+	return scanAwardRequest(r.pool.QueryRow(ctx,
+		`UPDATE coin_award_requests
+		 SET status = $3, reviewed_by = $2, reviewed_at = NOW()
+		 WHERE id = $1 AND status = 'pending' AND requested_by <> $2
+		 RETURNING `+awardRequestColumns,
+		id, reviewerID, status))
+}
+
+// ApproveRequest approves a pending request on behalf of reviewerID and
+// applies its award in the same transaction, so a request is never approved
+// without its coins or the other way round. The award is made as the admin who
+// requested it and counts towards their dailyCap. Attendance requests are
+// recorded as a, with the streak multiplier applied as in CheckIn, and
+// returned; adjustments are built from the request. Like ReviewRequest it
+// returns nil if the request cannot be approved by reviewerID.
+func (r *AwardRepository) ApproveRequest(ctx context.Context, id, reviewerID int64, a *model.Attendance, tz string, multipliers model.StreakMultipliers, dailyCap int) (*model.CoinAwardRequest, *model.Attendance, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	req, err := scanAwardRequest(tx.QueryRow(ctx,
+		`UPDATE coin_award_requests
+		 SET status = 'approved', reviewed_by = $2, reviewed_at = NOW()
+		 WHERE id = $1 AND status = 'pending' AND requested_by <> $2
+		 RETURNING `+awardRequestColumns,
+		id, reviewerID))
+	if err != nil || req == nil {
+		return nil, nil, err
+	}
+
+	var attendance *model.Attendance
+	switch req.Kind {
+	case model.LedgerAttendance:
+		attendance, err = checkIn(ctx, tx, a, tz, multipliers, dailyCap)
+	default:
+		adj := model.CoinAdjustment{UserID: req.UserID, Amount: req.Amount, Reason: req.Reason}
+		_, err = adjustCoins(ctx, tx, req.RequestedBy, []model.CoinAdjustment{adj}, dailyCap)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, err
+	}
+	return req, attendance, nil
+}
+
+// ─── Alerts ──────────────────────────────────────────────────────────────────
+
+const alertColumns = `id, kind, message, admin_id, user_id, fingerprint, resolved_by, resolved_at, created_at`
+
+func scanAlert(row pgx.Row) (*model.AdminAlert, error) {
+	var a model.AdminAlert
+	err := row.Scan(&a.ID, &a.Kind, &a.Message, &a.AdminID, &a.UserID, &a.Fingerprint, &a.ResolvedBy, &a.ResolvedAt, &a.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// RaiseAlert stores an alert unless one with the same fingerprint already
+// exists. It reports whether a new alert was created.
+func (r *AwardRepository) RaiseAlert(ctx context.Context, a *model.AdminAlert) (bool, error) {
+	tag, err := r.pool.Exec(ctx,
+		`INSERT INTO admin_alerts (kind, message, admin_id, user_id, fingerprint)
+		 VALUES ($1, $2, $3, $4, $5)
+		 ON CONFLICT (fingerprint) DO NOTHING`,
+		a.Kind, a.Message, a.AdminID, a.UserID, a.Fingerprint)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *AwardRepository) ListAlerts(ctx context.Context, includeResolved bool, limit int) ([]model.AdminAlert, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+alertColumns+` FROM admin_alerts
+		 WHERE $1 OR resolved_at IS NULL
+		 ORDER BY created_at DESC
+		 LIMIT $2`, includeResolved, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.AdminAlert
+	for rows.Next() {
+		a, err := scanAlert(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *a)
+	}
+	return list, rows.Err()
+}
+
+// ResolveAlert marks an alert as handled. It returns nil if the alert does not exist.
+func (r *AwardRepository) ResolveAlert(ctx context.Context, id, resolverID int64) (*model.AdminAlert, error) {
+	return scanAlert(r.pool.QueryRow(ctx,
+		`UPDATE admin_alerts
+		 SET resolved_by = COALESCE(resolved_by, $2), resolved_at = COALESCE(resolved_at, NOW())
+		 WHERE id = $1
+		 RETURNING `+alertColumns,
+		id, resolverID))
+}
+
+// ─── Anomaly detection ───────────────────────────────────────────────────────
+
+// RepeatCheckIns finds admin/student pairs with at least minCount check-ins in the last 7 days.
+func (r *AwardRepository) RepeatCheckIns(ctx context.Context, minCount int) ([]model.RepeatCheckIn, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT awarded_by, user_id, COUNT(*), COALESCE(SUM(coins_awarded), 0)
+		 FROM attendance
+		 WHERE awarded_by IS NOT NULL AND created_at > NOW() - INTERVAL '7 days'
+		 GROUP BY awarded_by, user_id
+		 HAVING COUNT(*) >= $1`, minCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.RepeatCheckIn
+	for rows.Next() {
+		var rc model.RepeatCheckIn
+		if err := rows.Scan(&rc.AdminID, &rc.UserID, &rc.Count, &rc.Coins); err != nil {
+			return nil, err
+		}
+		list = append(list, rc)
+	}
+	return list, rows.Err()
+}
+
+// AwardSpikes finds admins who awarded at least minCoins in the last 24 hours
+// and more than factor times their daily average over the 30 days before.
+func (r *AwardRepository) AwardSpikes(ctx context.Context, minCoins int, factor float64) ([]model.AwardSpike, error) {
+	rows, err := r.pool.Query(ctx,
+		`WITH recent AS (
+		     SELECT actor_id, SUM(amount) AS awarded FROM coin_ledger
+		     WHERE actor_id IS NOT NULL AND amount > 0 AND created_at > NOW() - INTERVAL '24 hours'
+		     GROUP BY actor_id
+		 ), baseline AS (
+		     SELECT actor_id, SUM(amount) / 30.0 AS daily_average FROM coin_ledger
+		     WHERE actor_id IS NOT NULL AND amount > 0
+		       AND created_at <= NOW() - INTERVAL '24 hours' AND created_at > NOW() - INTERVAL '31 days'
+		     GROUP BY actor_id
+		 )
+		 SELECT r.actor_id, r.awarded, COALESCE(b.daily_average, 0)::float8
+		 FROM recent r LEFT JOIN baseline b ON b.actor_id = r.actor_id
+		 WHERE r.awarded >= $1 AND r.awarded > $2 * COALESCE(b.daily_average, 0)`,
+		minCoins, factor)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.AwardSpike
+	for rows.Next() {
+		var s model.AwardSpike
+		if err := rows.Scan(&s.AdminID, &s.Awarded, &s.DailyAverage); err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+	}
+	return list, rows.Err()
+}
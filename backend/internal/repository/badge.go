@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type BadgeRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewBadgeRepository(pool *pgxpool.Pool) *BadgeRepository {
+	return &BadgeRepository{pool: pool}
+}
+
+// badgeCounters count, for user $1, what a badge counter measures.
+var badgeCounters = map[string]string{
+	model.CounterCheckIns:              `SELECT COUNT(*) FROM attendance WHERE user_id = $1`,
+	model.CounterClubsJoined:           `SELECT COUNT(*) FROM club_members WHERE user_id = $1`,
+	model.CounterHackathonApplications: `SELECT COUNT(*) FROM hackathon_applications WHERE user_id = $1`,
+	model.CounterHackathonFinals: fmt.Sprintf(`SELECT COUNT(*) FROM team_members m
+		JOIN hackathon_submissions s ON s.team_id = m.team_id
+		WHERE m.user_id = $1 AND s.placement <= %d`, model.FinalistPlacements),
+	model.CounterPurchases: `SELECT COUNT(*) FROM purchases WHERE user_id = $1`,
+	model.CounterQuestsCompleted: `SELECT COUNT(*) FROM quest_submissions
+		WHERE user_id = $1 AND status = 'approved'`,
+}
+
+// Sync creates or updates the badges rows for the given definitions.
+func (r *BadgeRepository) Sync(ctx context.Context, defs []model.BadgeDefinition) error {
+	for _, d := range defs {
+		_, err := r.pool.Exec(ctx,
+			`INSERT INTO badges (code, name, description, icon) VALUES ($1, $2, $3, $4)
+			 ON CONFLICT (code) DO UPDATE
+			 SET name = EXCLUDED.name, description = EXCLUDED.description, icon = EXCLUDED.icon`,
+			d.Code, d.Name, d.Description, d.Icon)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *BadgeRepository) Count(ctx context.Context, counter string, userID int64) (int, error) {
+	sql, ok := badgeCounters[counter]
+	if !ok {
+		return 0, fmt.Errorf("unknown badge counter %q", counter)
+	}
+	var n int
+	err := r.pool.QueryRow(ctx, sql, userID).Scan(&n)
+	return n, err
+}
+
+// Award gives userID the badge with code, crediting bonusCoins the first time.
+// It returns nil if the user already had the badge.
+func (r *BadgeRepository) Award(ctx context.Context, userID int64, code string, bonusCoins int) (*model.Badge, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var b model.Badge
+	err = tx.QueryRow(ctx,
+		`WITH awarded AS (
+		     INSERT INTO user_badges (user_id, badge_id)
+		     SELECT $1, id FROM badges WHERE code = $2
+		     ON CONFLICT DO NOTHING
+		     RETURNING badge_id, awarded_at
+		 )
+		 SELECT b.id, b.code, b.name, b.description, b.icon, a.awarded_at
+		 FROM awarded a JOIN badges b ON b.id = a.badge_id`,
+		userID, code,
+	).Scan(&b.ID, &b.Code, &b.Name, &b.Description, &b.Icon, &b.AwardedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if bonusCoins > 0 {
+		_, err = tx.Exec(ctx, `UPDATE users SET coins = coins + $1, updated_at = NOW() WHERE id = $2`, bonusCoins, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to credit badge bonus: %w", err)
+		}
+		entry := &model.LedgerEntry{UserID: userID, Amount: bonusCoins, Kind: model.LedgerBadgeBonus, RefID: &b.ID, Note: b.Name}
+		if err := insertLedgerEntry(ctx, tx, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (r *BadgeRepository) ListForUser(ctx context.Context, userID int64) ([]model.Badge, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT b.id, b.code, b.name, b.description, b.icon, ub.awarded_at
+		 FROM user_badges ub JOIN badges b ON b.id = ub.badge_id
+		 WHERE ub.user_id = $1
+		 ORDER BY ub.awarded_at DESC`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.Badge
+	for rows.Next() {
+		var b model.Badge
+		if err := rows.Scan(&b.ID, &b.Code, &b.Name, &b.Description, &b.Icon, &b.AwardedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, b)
+	}
+	return list, rows.Err()
+}
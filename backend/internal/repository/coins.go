@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type CoinRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewCoinRepository(pool *pgxpool.Pool) *CoinRepository {
+	return &CoinRepository{pool: pool}
+}
+
+// ErrAwardCapReached is returned when an award would take an admin past their
+// daily cap.
+var ErrAwardCapReached = errors.New("daily award cap reached")
+
+// debitCoins locks the user row and deducts amount from the balance.
+// Callers must lock any other rows they need (e.g. shop items) before calling
+// it so that every transaction acquires locks in the same order. The caller
+// records the matching ledger entry once it knows the ref id.
+func debitCoins(ctx context.Context, tx pgx.Tx, userID int64, amount int) error {
+	var userCoins int
+	err := tx.QueryRow(ctx, `SELECT coins FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&userCoins)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("user not found")
+	}
+	if err != nil {
+		return err
+	}
+	if userCoins < amount {
+		return fmt.Errorf("not enough coins")
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE users SET coins = coins - $1, updated_at = NOW() WHERE id = $2`, amount, userID)
+	return err
+}
+
+// insertLedgerEntry records a balance change; it does not touch users.coins.
+func insertLedgerEntry(ctx context.Context, tx pgx.Tx, e *model.LedgerEntry) error {
+	return tx.QueryRow(ctx,
+		`INSERT INTO coin_ledger (user_id, amount, kind, ref_id, counterparty_id, actor_id, note)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 RETURNING id, created_at`,
+		e.UserID, e.Amount, e.Kind, e.RefID, e.CounterpartyID, e.ActorID, e.Note,
+	).Scan(&e.ID, &e.CreatedAt)
+}
+
+// awardCapLockKey namespaces the per-admin advisory locks taken by enforceAwardCap.
+const awardCapLockKey = 32
+
+// enforceAwardCap fails if crediting amount would take actorID past dailyCap
+// coins awarded in the last 24 hours. It holds a per-admin advisory lock until
+// the transaction ends, so concurrent awards by one admin cannot both slip
+// under the cap. A zero dailyCap disables the check.
+func enforceAwardCap(ctx context.Context, tx pgx.Tx, actorID int64, amount, dailyCap int) error {
+	if dailyCap <= 0 || amount <= 0 {
+		return nil
+	}
+	if err := lockAwardCap(ctx, tx, actorID); err != nil {
+		return err
+	}
+
+	var awarded int
+	err := tx.QueryRow(ctx,
+		`SELECT COALESCE(SUM(amount), 0) FROM coin_ledger
+		 WHERE actor_id = $1 AND amount > 0 AND created_at > NOW() - INTERVAL '24 hours'`,
+		actorID,
+	).Scan(&awarded)
+	if err != nil {
+		return err
+	}
+	if awarded+amount > dailyCap {
+		return fmt.Errorf("%w: %d of %d coins left", ErrAwardCapReached, max(dailyCap-awarded, 0), dailyCap)
+	}
+	return nil
+}
+
+// lockAwardCap takes actorID's award cap lock without checking the cap, for
+// callers that must hold it before locking other rows. The lock is reentrant,
+// so enforceAwardCap can still be called afterwards.
+func lockAwardCap(ctx context.Context, tx pgx.Tx, actorID int64) error {
+	_, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1, $2)`, int32(awardCapLockKey), int32(actorID))
+	return err
+}
+
+// ledgerSum totals amount for a user's entries of the given kind since the interval ago, e.g. '24 hours'.
+func ledgerSum(ctx context.Context, tx pgx.Tx, userID int64, kind, interval string) (int, error) {
+	var total int
+	err := tx.QueryRow(ctx,
+		`SELECT COALESCE(SUM(amount), 0) FROM coin_ledger
+		 WHERE user_id = $1 AND kind = $2 AND created_at > NOW() - $3::interval`,
+		userID, kind, interval,
+	).Scan(&total)
+	return total, err
+}
+
+func (r *CoinRepository) ListLedger(ctx context.Context, userID int64, limit int) ([]model.LedgerEntry, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT id, user_id, amount, kind, ref_id, counterparty_id, actor_id, note, created_at
+		 FROM coin_ledger WHERE user_id = $1
+		 ORDER BY created_at DESC, id DESC LIMIT $2`, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.LedgerEntry
+	for rows.Next() {
+		var e model.LedgerEntry
+		if err := rows.Scan(&e.ID, &e.UserID, &e.Amount, &e.Kind, &e.RefID, &e.CounterpartyID, &e.ActorID, &e.Note, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, e)
+	}
+	return list, rows.Err()
+}
+
+// Transfer moves coins between two users. Both rows are locked in ascending id
+// order so that opposite transfers between the same pair cannot deadlock. The
+// limits cap what the sender may send and the recipient may receive over the
+// last 24 hours; zero disables a limit.
+func (r *CoinRepository) Transfer(ctx context.Context, senderID, recipientID int64, amount int, note string, sendLimit, receiveLimit int) (*model.Transfer, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx,
+		`SELECT `+userColumns+` FROM users WHERE id IN ($1, $2) ORDER BY id FOR UPDATE`, senderID, recipientID)
+	if err != nil {
+		return nil, err
+	}
+	var sender, recipient *model.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if u.ID == senderID {
+			sender = u
+		} else {
+			recipient = u
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if sender == nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	if recipient == nil {
+		return nil, fmt.Errorf("recipient not found")
+	}
+
+	if sender.Role == model.RoleGuest {
+		return nil, fmt.Errorf("verify your student account before sending coins")
+	}
+	if sender.Coins < amount {
+		return nil, fmt.Errorf("not enough coins")
+	}
+	if sendLimit > 0 {
+		sent, err := ledgerSum(ctx, tx, senderID, model.LedgerTransferOut, "24 hours")
+		if err != nil {
+			return nil, err
+		}
+		if -sent+amount > sendLimit {
+			return nil, fmt.Errorf("daily send limit of %d coins exceeded (%d left)", sendLimit, max(sendLimit+sent, 0))
+		}
+	}
+	if receiveLimit > 0 {
+		received, err := ledgerSum(ctx, tx, recipientID, model.LedgerTransferIn, "24 hours")
+		if err != nil {
+			return nil, err
+		}
+		if received+amount > receiveLimit {
+			return nil, fmt.Errorf("recipient can only receive %d more coins today", max(receiveLimit-received, 0))
+		}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE users SET coins = coins - $1, updated_at = NOW() WHERE id = $2`, amount, senderID)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(ctx, `UPDATE users SET coins = coins + $1, updated_at = NOW() WHERE id = $2`, amount, recipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &model.LedgerEntry{UserID: senderID, Amount: -amount, Kind: model.LedgerTransferOut, CounterpartyID: &recipientID, Note: note}
+	if err := insertLedgerEntry(ctx, tx, out); err != nil {
+		return nil, err
+	}
+	in := &model.LedgerEntry{UserID: recipientID, Amount: amount, Kind: model.LedgerTransferIn, CounterpartyID: &senderID, Note: note}
+	if err := insertLedgerEntry(ctx, tx, in); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	sender.Coins -= amount
+	recipient.Coins += amount
+	return &model.Transfer{Sender: *sender, Recipient: *recipient, Amount: amount, Note: note, CreatedAt: out.CreatedAt}, nil
+}
+
+// Adjust applies manual adjustments by actorID in one transaction: either all
+// of them succeed or none do. Users are locked in ascending id order, and a
+// debit may not take a balance below zero. The credits count towards the
+// actor's dailyCap.
+func (r *CoinRepository) Adjust(ctx context.Context, actorID int64, adjustments []model.CoinAdjustment, dailyCap int) ([]model.LedgerEntry, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	entries, err := adjustCoins(ctx, tx, actorID, adjustments, dailyCap)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func adjustCoins(ctx context.Context, tx pgx.Tx, actorID int64, adjustments []model.CoinAdjustment, dailyCap int) ([]model.LedgerEntry, error) {
+	credited := 0
+	for _, adj := range adjustments {
+		if adj.Amount > 0 {
+			credited += adj.Amount
+		}
+	}
+	if err := enforceAwardCap(ctx, tx, actorID, credited, dailyCap); err != nil {
+		return nil, err
+	}
+
+	ordered := make([]int, len(adjustments))
+	for i := range ordered {
+		ordered[i] = i
+	}
+	sort.SliceStable(ordered, func(a, b int) bool {
+		return adjustments[ordered[a]].UserID < adjustments[ordered[b]].UserID
+	})
+
+	entries := make([]model.LedgerEntry, len(adjustments))
+	for _, i := range ordered {
+		adj := adjustments[i]
+		var balance int
+		err := tx.QueryRow(ctx,
+			`UPDATE users SET coins = coins + $1, updated_at = NOW() WHERE id = $2 RETURNING coins`,
+			adj.Amount, adj.UserID).Scan(&balance)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("user %d not found", adj.UserID)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if balance < 0 {
+			return nil, fmt.Errorf("user %d has only %d coins", adj.UserID, balance-adj.Amount)
+		}
+
+		entries[i] = model.LedgerEntry{UserID: adj.UserID, Amount: adj.Amount, Kind: model.LedgerAdjustment, ActorID: &actorID, Note: adj.Reason}
+		if err := insertLedgerEntry(ctx, tx, &entries[i]); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
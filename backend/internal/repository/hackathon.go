@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -17,86 +19,417 @@ func NewHackathonRepository(pool *pgxpool.Pool) *HackathonRepository {
 	return &HackathonRepository{pool: pool}
 }
 
-func (r *HackathonRepository) List(ctx context.Context, status string) ([]model.Hackathon, error) {
-	query := `SELECT id, title, description, status, start_date, end_date, created_at FROM hackathons`
-	args := []any{}
+// ErrNoPlaces is returned when approving applications would take a hackathon
+// past its participant cap.
+var ErrNoPlaces = errors.New("not enough free places")
 
-	if status != "" {
-		query += ` WHERE status = $1`
-		args = append(args, status)
-	}
-	query += ` ORDER BY start_date DESC`
+const hackathonColumns = `id, title, description, status, start_date, end_date,
+	registration_opens_at, registration_closes_at, submission_deadline, location, prizes, rules, max_team_size,
+	max_participants, (SELECT COALESCE(SUM(` + applicationPlaces + `), 0)::int FROM hackathon_applications ha
+	                   WHERE ha.hackathon_id = hackathons.id AND ha.status IN ('pending', 'approved')),
+	cover_image_url, organizer_contacts, status_changed_at, results_published_at, created_at, updated_at`
 
-	rows, err := r.pool.Query(ctx, query, args...)
+func scanHackathon(row pgx.Row) (*model.Hackathon, error) {
+	var h model.Hackathon
+	err := row.Scan(&h.ID, &h.Title, &h.Description, &h.Status, &h.StartDate, &h.EndDate,
+		&h.RegistrationOpensAt, &h.RegistrationClosesAt, &h.SubmissionDeadline, &h.Location, &h.Prizes, &h.Rules, &h.MaxTeamSize,
+		&h.MaxParticipants, &h.Participants, &h.CoverImageURL, &h.OrganizerContacts, &h.StatusChangedAt, &h.ResultsPublishedAt,
+		&h.CreatedAt, &h.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	return &h, nil
+}
 
+func scanHackathons(rows pgx.Rows) ([]model.Hackathon, error) {
+	defer rows.Close()
 	var list []model.Hackathon
 	for rows.Next() {
-		var h model.Hackathon
-		if err := rows.Scan(&h.ID, &h.Title, &h.Description, &h.Status, &h.StartDate, &h.EndDate, &h.CreatedAt); err != nil {
+		h, err := scanHackathon(rows)
+		if err != nil {
 			return nil, err
 		}
-		list = append(list, h)
+		list = append(list, *h)
 	}
 	return list, rows.Err()
 }
 
+// List returns hackathons in any of statuses, or all of them if statuses is nil.
+func (r *HackathonRepository) List(ctx context.Context, statuses []string) ([]model.Hackathon, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+hackathonColumns+` FROM hackathons
+		 WHERE $1::text[] IS NULL OR status = ANY($1)
+		 ORDER BY start_date DESC`, statuses)
+	if err != nil {
+		return nil, err
+	}
+	return scanHackathons(rows)
+}
+
 func (r *HackathonRepository) GetByID(ctx context.Context, id int64) (*model.Hackathon, error) {
-	var h model.Hackathon
-	err := r.pool.QueryRow(ctx,
-		`SELECT id, title, description, status, start_date, end_date, created_at FROM hackathons WHERE id = $1`, id,
-	).Scan(&h.ID, &h.Title, &h.Description, &h.Status, &h.StartDate, &h.EndDate, &h.CreatedAt)
+	return scanHackathon(r.pool.QueryRow(ctx,
+		`SELECT `+hackathonColumns+` FROM hackathons WHERE id = $1`, id))
+}
+
+func (r *HackathonRepository) Create(ctx context.Context, h *model.Hackathon) (*model.Hackathon, error) {
+	return scanHackathon(r.pool.QueryRow(ctx,
+		`INSERT INTO hackathons (title, description, status, start_date, end_date, registration_opens_at, registration_closes_at,
+		                         submission_deadline, location, prizes, rules, max_team_size, max_participants, cover_image_url,
+		                         organizer_contacts)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		 RETURNING `+hackathonColumns,
+		h.Title, h.Description, h.Status, h.StartDate, h.EndDate, h.RegistrationOpensAt, h.RegistrationClosesAt,
+		h.SubmissionDeadline, h.Location, h.Prizes, h.Rules, h.MaxTeamSize, h.MaxParticipants, h.CoverImageURL, h.OrganizerContacts))
+}
+
+// Update saves the editable fields of h if the hackathon is still in h.Status,
+// so dates checked against one status are not written after the scheduler
+// has moved it on. It returns nil if nothing matched.
+func (r *HackathonRepository) Update(ctx context.Context, h *model.Hackathon) (*model.Hackathon, error) {
+	return scanHackathon(r.pool.QueryRow(ctx,
+		`UPDATE hackathons
+		 SET title = $3, description = $4, start_date = $5, end_date = $6,
+		     registration_opens_at = $7, registration_closes_at = $8, location = $9, prizes = $10,
+		     rules = $11, max_team_size = $12, max_participants = $13, cover_image_url = $14, organizer_contacts = $15,
+		     submission_deadline = $16, updated_at = NOW()
+		 WHERE id = $1 AND status = $2
+		 RETURNING `+hackathonColumns,
+		h.ID, h.Status, h.Title, h.Description, h.StartDate, h.EndDate, h.RegistrationOpensAt, h.RegistrationClosesAt,
+		h.Location, h.Prizes, h.Rules, h.MaxTeamSize, h.MaxParticipants, h.CoverImageURL, h.OrganizerContacts,
+		h.SubmissionDeadline))
+}
+
+// Transition moves a hackathon to status if it is currently in one of from.
+// It returns nil if the hackathon does not exist or is in another status, so
+// a concurrent change (e.g. by the scheduler) cannot be overwritten.
+func (r *HackathonRepository) Transition(ctx context.Context, id int64, from []string, status string) (*model.Hackathon, error) {
+	return scanHackathon(r.pool.QueryRow(ctx,
+		`UPDATE hackathons SET status = $2, status_changed_at = NOW()
+		 WHERE id = $1 AND status = ANY($3)
+		 RETURNING `+hackathonColumns,
+		id, status, from))
+}
+
+// hackathonSchedule moves hackathons along the lifecycle as their dates pass.
+// Steps run in order, so one pass can move a hackathon several steps.
+var hackathonSchedule = []struct {
+	from []string
+	to   string
+	due  string
+}{
+	{[]string{model.HackathonDraft}, model.HackathonRegistrationOpen, `registration_opens_at <= NOW()`},
+	{[]string{model.HackathonRegistrationOpen}, model.HackathonRegistrationClosed, `registration_closes_at <= NOW()`},
+	{[]string{model.HackathonRegistrationOpen, model.HackathonRegistrationClosed}, model.HackathonRunning, `start_date <= NOW()`},
+	{[]string{model.HackathonRunning}, model.HackathonJudging, `end_date <= NOW()`},
+}
+
+// Advance applies every due scheduled transition and returns the hackathons
+// that moved, in their new status.
+func (r *HackathonRepository) Advance(ctx context.Context) ([]model.Hackathon, error) {
+	var moved []model.Hackathon
+	for _, step := range hackathonSchedule {
+		rows, err := r.pool.Query(ctx,
+			`UPDATE hackathons SET status = $1, status_changed_at = NOW()
+			 WHERE status = ANY($2) AND `+step.due+`
+			 RETURNING `+hackathonColumns,
+			step.to, step.from)
+		if err != nil {
+			return moved, err
+		}
+		list, err := scanHackathons(rows)
+		if err != nil {
+			return moved, err
+		}
+		moved = append(moved, list...)
+	}
+	return moved, nil
+}
+
+func (r *HackathonRepository) Delete(ctx context.Context, id int64) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM hackathons WHERE id = $1`, id)
+	return err
+}
+
+// ─── Applications ────────────────────────────────────────────────────────────
+
+// applicationPlaces is how many places an application aliased as ha takes
+// under the participant cap: one per person, so a team takes its size.
+const applicationPlaces = `CASE WHEN ha.team_id IS NULL THEN 1
+	ELSE (SELECT COUNT(*) FROM team_members tm WHERE tm.team_id = ha.team_id) END`
 
+// applicationColumns expects hackathon_applications aliased as ha joined to hackathons as h.
+const applicationColumns = `ha.id, ha.hackathon_id, h.title, ha.user_id, ha.team_name, ha.team_id, ` + applicationPlaces + `, ha.status,
+	ha.review_message, ha.reviewed_by, ha.reviewed_at,
+	CASE WHEN ha.status = 'waitlisted' THEN (
+	    SELECT COUNT(*) FROM hackathon_applications w
+	    WHERE w.hackathon_id = ha.hackathon_id AND w.status = 'waitlisted'
+	      AND (w.waitlisted_at, w.id) <= (ha.waitlisted_at, ha.id)
+	) END,
+	ha.created_at`
+
+const applicationFrom = ` FROM hackathon_applications ha JOIN hackathons h ON h.id = ha.hackathon_id`
+
+func scanApplication(row pgx.Row) (*model.HackathonApplication, error) {
+	var a model.HackathonApplication
+	err := row.Scan(&a.ID, &a.HackathonID, &a.HackathonTitle, &a.UserID, &a.TeamName, &a.TeamID, &a.Places, &a.Status,
+		&a.ReviewMessage, &a.ReviewedBy, &a.ReviewedAt, &a.WaitlistPos, &a.CreatedAt)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
-	return &h, nil
+	return &a, nil
 }
 
-func (r *HackathonRepository) Create(ctx context.Context, h *model.Hackathon) (*model.Hackathon, error) {
-	var result model.Hackathon
-	err := r.pool.QueryRow(ctx,
-		`INSERT INTO hackathons (title, description, status, start_date, end_date)
-		 VALUES ($1, $2, 'active', $3, $4)
-		 RETURNING id, title, description, status, start_date, end_date, created_at`,
-		h.Title, h.Description, h.StartDate, h.EndDate,
-	).Scan(&result.ID, &result.Title, &result.Description, &result.Status, &result.StartDate, &result.EndDate, &result.CreatedAt)
+func scanApplications(rows pgx.Rows) ([]model.HackathonApplication, error) {
+	defer rows.Close()
+	var list []model.HackathonApplication
+	for rows.Next() {
+		a, err := scanApplication(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *a)
+	}
+	return list, rows.Err()
+}
+
+func scanIDs(rows pgx.Rows) ([]int64, error) {
+	defer rows.Close()
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// listApplicationsByID reads applications inside tx, so statuses and waitlist
+// positions reflect the transaction's own changes.
+func listApplicationsByID(ctx context.Context, tx pgx.Tx, ids []int64) ([]model.HackathonApplication, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := tx.Query(ctx,
+		`SELECT `+applicationColumns+applicationFrom+` WHERE ha.id = ANY($1) ORDER BY ha.id`, ids)
 	if err != nil {
 		return nil, err
 	}
-	return &result, nil
+	return scanApplications(rows)
 }
 
-func (r *HackathonRepository) Delete(ctx context.Context, id int64) error {
-	_, err := r.pool.Exec(ctx, `DELETE FROM hackathons WHERE id = $1`, id)
-	return err
+// countPlaces returns how many places are taken under the participant cap.
+func countPlaces(ctx context.Context, tx pgx.Tx, hackathonID int64) (int, error) {
+	var n int
+	err := tx.QueryRow(ctx,
+		`SELECT COALESCE(SUM(`+applicationPlaces+`), 0)::int FROM hackathon_applications ha
+		 WHERE ha.hackathon_id = $1 AND ha.status IN ('pending', 'approved')`, hackathonID).Scan(&n)
+	return n, err
+}
+
+// Apply returns nil if the hackathon is not taking registrations. Applicants
+// who do not fit under the participant cap are waitlisted. For a team
+// application app.UserID must be the captain. checkForm is called with the
+// application form under the hackathon lock, so the answers it leaves in
+// app.Answers are checked against the form they are saved with; its error is
+// returned as is.
+func (r *HackathonRepository) Apply(ctx context.Context, app *model.HackathonApplication, checkForm func(fields []model.FormField) error) (*model.HackathonApplication, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// The row lock serialises applicants competing for the last places.
+	var maxParticipants *int
+	err = tx.QueryRow(ctx,
+		`SELECT max_participants FROM hackathons
+		 WHERE id = $1 AND status = 'registration_open'
+		   AND (registration_closes_at IS NULL OR registration_closes_at > NOW())
+		   AND start_date > NOW()
+		 FOR UPDATE`, app.HackathonID).Scan(&maxParticipants)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	fields, err := listFormFields(ctx, tx, app.HackathonID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkForm(fields); err != nil {
+		return nil, err
+	}
+
+	places := 1
+	if app.TeamID != nil {
+		// Lock the team so nobody joins while its places are counted.
+		err = tx.QueryRow(ctx,
+			`SELECT (SELECT COUNT(*) FROM team_members WHERE team_id = t.id) FROM teams t
+			 WHERE t.id = $1 AND t.hackathon_id = $2 AND t.captain_id = $3
+			 FOR UPDATE`, *app.TeamID, app.HackathonID, app.UserID).Scan(&places)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("only the team captain can apply for the team")
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	status := model.ApplicationPending
+	if maxParticipants != nil {
+		taken, err := countPlaces(ctx, tx, app.HackathonID)
+		if err != nil {
+			return nil, err
+		}
+		if taken+places > *maxParticipants {
+			status = model.ApplicationWaitlisted
+		}
+	}
+	var id int64
+	err = tx.QueryRow(ctx,
+		`INSERT INTO hackathon_applications (hackathon_id, user_id, team_name, team_id, status, waitlisted_at)
+		 VALUES ($1, $2, $3, $4, $5, CASE WHEN $6 THEN NOW() END)
+		 RETURNING id`,
+		app.HackathonID, app.UserID, app.TeamName, app.TeamID, status, status == model.ApplicationWaitlisted,
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	if err := saveAnswers(ctx, tx, app.HackathonID, app.UserID, app.Answers); err != nil {
+		return nil, err
+	}
+	result, err := scanApplication(tx.QueryRow(ctx,
+		`SELECT `+applicationColumns+applicationFrom+` WHERE ha.id = $1`, id))
+	if err != nil {
+		return nil, err
+	}
+	result.Answers = app.Answers
+	return result, tx.Commit(ctx)
+}
+
+// Withdraw deletes a user's application. If it held a place under the
+// participant cap, the longest-waiting applicants move into the freed place
+// with the withdrawn application's status; they are returned as promoted.
+// withdrawn is nil if the user had not applied.
+func (r *HackathonRepository) Withdraw(ctx context.Context, hackathonID, userID int64) (withdrawn *model.HackathonApplication, promoted []model.HackathonApplication, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var maxParticipants *int
+	err = tx.QueryRow(ctx,
+		`SELECT max_participants FROM hackathons WHERE id = $1 FOR UPDATE`, hackathonID).Scan(&maxParticipants)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	withdrawn, err = scanApplication(tx.QueryRow(ctx,
+		`SELECT `+applicationColumns+applicationFrom+` WHERE ha.hackathon_id = $1 AND ha.user_id = $2`,
+		hackathonID, userID))
+	if err != nil || withdrawn == nil {
+		return nil, nil, err
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM hackathon_applications WHERE id = $1`, withdrawn.ID); err != nil {
+		return nil, nil, err
+	}
+	if maxParticipants != nil && model.ApplicationHoldsPlace(withdrawn.Status) {
+		promoted, err = promoteWaitlisted(ctx, tx, hackathonID, *maxParticipants, withdrawn.Status)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return withdrawn, promoted, tx.Commit(ctx)
 }
 
-func (r *HackathonRepository) Apply(ctx context.Context, app *model.HackathonApplication) (*model.HackathonApplication, error) {
-	var result model.HackathonApplication
-	err := r.pool.QueryRow(ctx,
-		`INSERT INTO hackathon_applications (hackathon_id, user_id, team_name)
-		 VALUES ($1, $2, $3)
-		 RETURNING id, hackathon_id, user_id, team_name, status, created_at`,
-		app.HackathonID, app.UserID, app.TeamName,
-	).Scan(&result.ID, &result.HackathonID, &result.UserID, &result.TeamName, &result.Status, &result.CreatedAt)
+// FillFromWaitlist promotes waitlisted applicants to pending while the
+// participant cap has room, e.g. after it was raised.
+func (r *HackathonRepository) FillFromWaitlist(ctx context.Context, hackathonID int64) ([]model.HackathonApplication, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var maxParticipants *int
+	err = tx.QueryRow(ctx,
+		`SELECT max_participants FROM hackathons WHERE id = $1 FOR UPDATE`, hackathonID).Scan(&maxParticipants)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && maxParticipants == nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	promoted, err := promoteWaitlisted(ctx, tx, hackathonID, *maxParticipants, model.ApplicationPending)
 	if err != nil {
 		return nil, err
 	}
-	return &result, nil
+	return promoted, tx.Commit(ctx)
+}
+
+// promoteWaitlisted moves waitlisted applicants, longest waiting first, into
+// the places free under maxParticipants. It stops at the first team too big
+// for the free places, so nobody jumps the queue. The hackathon row must be
+// locked.
+func promoteWaitlisted(ctx context.Context, tx pgx.Tx, hackathonID int64, maxParticipants int, status string) ([]model.HackathonApplication, error) {
+	taken, err := countPlaces(ctx, tx, hackathonID)
+	if err != nil || taken >= maxParticipants {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx,
+		`SELECT ha.id, `+applicationPlaces+` FROM hackathon_applications ha
+		 WHERE ha.hackathon_id = $1 AND ha.status = 'waitlisted'
+		 ORDER BY ha.waitlisted_at, ha.id`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		var places int
+		if err := rows.Scan(&id, &places); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		if taken+places > maxParticipants {
+			break
+		}
+		taken += places
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil || len(ids) == 0 {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE hackathon_applications SET status = $2 WHERE id = ANY($1)`, ids, status); err != nil {
+		return nil, err
+	}
+	return listApplicationsByID(ctx, tx, ids)
+}
+
+func (r *HackathonRepository) GetApplication(ctx context.Context, id int64) (*model.HackathonApplication, error) {
+	return scanApplication(r.pool.QueryRow(ctx,
+		`SELECT `+applicationColumns+applicationFrom+` WHERE ha.id = $1`, id))
 }
 
 func (r *HackathonRepository) ListApplications(ctx context.Context, hackathonID int64) ([]model.HackathonApplication, error) {
 	rows, err := r.pool.Query(ctx,
-		`SELECT ha.id, ha.hackathon_id, ha.user_id, ha.team_name, ha.status, ha.created_at,
+		`SELECT `+applicationColumns+`,
 		        u.id, u.telegram_id, u.username, u.first_name, u.last_name, u.photo_url, u.role, u.created_at, u.updated_at
-		 FROM hackathon_applications ha
+		 `+applicationFrom+`
 		 JOIN users u ON u.id = ha.user_id
 		 WHERE ha.hackathon_id = $1
 		 ORDER BY ha.created_at DESC`, hackathonID,
@@ -111,7 +444,8 @@ func (r *HackathonRepository) ListApplications(ctx context.Context, hackathonID
 		var a model.HackathonApplication
 		var u model.User
 		if err := rows.Scan(
-			&a.ID, &a.HackathonID, &a.UserID, &a.TeamName, &a.Status, &a.CreatedAt,
+			&a.ID, &a.HackathonID, &a.HackathonTitle, &a.UserID, &a.TeamName, &a.TeamID, &a.Places, &a.Status,
+			&a.ReviewMessage, &a.ReviewedBy, &a.ReviewedAt, &a.WaitlistPos, &a.CreatedAt,
 			&u.ID, &u.TelegramID, &u.Username, &u.FirstName, &u.LastName, &u.PhotoURL, &u.Role, &u.CreatedAt, &u.UpdatedAt,
 		); err != nil {
 			return nil, err
@@ -119,5 +453,287 @@ func (r *HackathonRepository) ListApplications(ctx context.Context, hackathonID
 		a.User = &u
 		list = append(list, a)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	answers, err := listAnswers(ctx, r.pool, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range list {
+		list[i].Answers = answers[list[i].UserID]
+	}
+	return list, nil
+}
+
+// ListApplicationsByUser returns a student's applications, including those of
+// their teams, newest first.
+func (r *HackathonRepository) ListApplicationsByUser(ctx context.Context, userID int64) ([]model.HackathonApplication, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+applicationColumns+applicationFrom+`
+		 WHERE ha.user_id = $1
+		    OR ha.team_id IN (SELECT team_id FROM team_members WHERE user_id = $1)
+		 ORDER BY ha.created_at DESC`, userID)
+	if err != nil {
+		return nil, err
+	}
+	return scanApplications(rows)
+}
+
+// ReviewApplications sets status and message on the listed applications of a
+// hackathon. Applications already in status are left alone, so only the
+// changed ones are returned as reviewed. Approvals that would take the
+// hackathon past its participant cap fail with ErrNoPlaces. Rejecting an
+// application that held a place moves the longest-waiting applicants into it
+// as pending; they are returned as promoted.
+func (r *HackathonRepository) ReviewApplications(ctx context.Context, hackathonID int64, ids []int64, status, message string, reviewerID int64) (reviewed, promoted []model.HackathonApplication, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var maxParticipants *int
+	err = tx.QueryRow(ctx,
+		`SELECT max_participants FROM hackathons WHERE id = $1 FOR UPDATE`, hackathonID).Scan(&maxParticipants)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gained, freed int
+	rows, err := tx.Query(ctx,
+		`SELECT ha.status, `+applicationPlaces+` FROM hackathon_applications ha
+		 WHERE ha.hackathon_id = $1 AND ha.id = ANY($2) AND ha.status <> $3`,
+		hackathonID, ids, status)
+	if err != nil {
+		return nil, nil, err
+	}
+	for rows.Next() {
+		var current string
+		var places int
+		if err := rows.Scan(&current, &places); err != nil {
+			rows.Close()
+			return nil, nil, err
+		}
+		switch {
+		case model.ApplicationHoldsPlace(current) && !model.ApplicationHoldsPlace(status):
+			freed += places
+		case !model.ApplicationHoldsPlace(current) && model.ApplicationHoldsPlace(status):
+			gained += places
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+	if maxParticipants != nil && gained > 0 {
+		taken, err := countPlaces(ctx, tx, hackathonID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if taken+gained > *maxParticipants {
+			return nil, nil, fmt.Errorf("%w: %d of %d places are free, the applications need %d",
+				ErrNoPlaces, max(*maxParticipants-taken, 0), *maxParticipants, gained)
+		}
+	}
+
+	rows, err = tx.Query(ctx,
+		`UPDATE hackathon_applications
+		 SET status = $3, review_message = $4, reviewed_by = $5, reviewed_at = NOW(),
+		     waitlisted_at = CASE WHEN $6 THEN NOW() ELSE waitlisted_at END
+		 WHERE hackathon_id = $1 AND id = ANY($2) AND status <> $3
+		 RETURNING id`,
+		hackathonID, ids, status, message, reviewerID, status == model.ApplicationWaitlisted)
+	if err != nil {
+		return nil, nil, err
+	}
+	changed, err := scanIDs(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	reviewed, err = listApplicationsByID(ctx, tx, changed)
+	if err != nil {
+		return nil, nil, err
+	}
+	if maxParticipants != nil && freed > 0 && status == model.ApplicationRejected {
+		promoted, err = promoteWaitlisted(ctx, tx, hackathonID, *maxParticipants, model.ApplicationPending)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return reviewed, promoted, tx.Commit(ctx)
+}
+
+// ─── Application Forms ───────────────────────────────────────────────────────
+
+func listFormFields(ctx context.Context, q querier, hackathonID int64) ([]model.FormField, error) {
+	rows, err := q.Query(ctx,
+		`SELECT id, hackathon_id, label, type, required, choices FROM hackathon_form_fields
+		 WHERE hackathon_id = $1 ORDER BY position, id`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.FormField
+	for rows.Next() {
+		var f model.FormField
+		if err := rows.Scan(&f.ID, &f.HackathonID, &f.Label, &f.Type, &f.Required, &f.Choices); err != nil {
+			return nil, err
+		}
+		list = append(list, f)
+	}
 	return list, rows.Err()
 }
+
+func (r *HackathonRepository) ListFormFields(ctx context.Context, hackathonID int64) ([]model.FormField, error) {
+	return listFormFields(ctx, r.pool, hackathonID)
+}
+
+// ReplaceFormFields sets a hackathon's form to fields, in order. Fields with
+// an id update the existing field, keeping its answers; fields left out are
+// deleted along with their answers. Edits that would leave answers stale are
+// refused: an answered field cannot change type or drop a choice, and a
+// field cannot become required while an application lacks an answer to it.
+func (r *HackathonRepository) ReplaceFormFields(ctx context.Context, hackathonID int64, fields []model.FormField) ([]model.FormField, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Apply checks answers under the same lock, so they always match the
+	// form they are saved with.
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM hackathons WHERE id = $1 FOR UPDATE`, hackathonID); err != nil {
+		return nil, err
+	}
+	rows, err := tx.Query(ctx,
+		`SELECT f.id, f.type, f.required, f.choices,
+		        EXISTS (SELECT 1 FROM hackathon_form_answers a WHERE a.field_id = f.id),
+		        EXISTS (SELECT 1 FROM hackathon_applications a
+		                WHERE a.hackathon_id = f.hackathon_id
+		                  AND NOT EXISTS (SELECT 1 FROM hackathon_form_answers fa
+		                                  WHERE fa.field_id = f.id AND fa.user_id = a.user_id))
+		 FROM hackathon_form_fields f WHERE f.hackathon_id = $1`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	current := map[int64]model.FormField{}
+	answered := map[int64]bool{}
+	unanswered := map[int64]bool{}
+	for rows.Next() {
+		var f model.FormField
+		var ok, missing bool
+		if err := rows.Scan(&f.ID, &f.Type, &f.Required, &f.Choices, &ok, &missing); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		current[f.ID], answered[f.ID], unanswered[f.ID] = f, ok, missing
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	keep := make([]int64, 0, len(fields))
+	for _, f := range fields {
+		if f.ID == 0 {
+			continue
+		}
+		old, ok := current[f.ID]
+		if !ok {
+			return nil, fmt.Errorf("form field %d not found", f.ID)
+		}
+		if answered[f.ID] {
+			if old.Type != f.Type {
+				return nil, fmt.Errorf("%q has answers, its type cannot change", f.Label)
+			}
+			for _, c := range old.Choices {
+				if !slices.Contains(f.Choices, c) {
+					return nil, fmt.Errorf("%q has answers, choice %q cannot be removed", f.Label, c)
+				}
+			}
+		}
+		if f.Required && !old.Required && unanswered[f.ID] {
+			return nil, fmt.Errorf("%q cannot become required, some applications have not answered it", f.Label)
+		}
+		keep = append(keep, f.ID)
+	}
+	if _, err := tx.Exec(ctx,
+		`DELETE FROM hackathon_form_fields WHERE hackathon_id = $1 AND NOT id = ANY($2)`, hackathonID, keep); err != nil {
+		return nil, err
+	}
+	result := make([]model.FormField, len(fields))
+	for i, f := range fields {
+		f.HackathonID = hackathonID
+		if f.Choices == nil {
+			f.Choices = []string{}
+		}
+		if f.ID == 0 {
+			err = tx.QueryRow(ctx,
+				`INSERT INTO hackathon_form_fields (hackathon_id, label, type, required, choices, position)
+				 VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+				hackathonID, f.Label, f.Type, f.Required, f.Choices, i).Scan(&f.ID)
+		} else {
+			_, err = tx.Exec(ctx,
+				`UPDATE hackathon_form_fields SET label = $2, type = $3, required = $4, choices = $5, position = $6
+				 WHERE id = $1`,
+				f.ID, f.Label, f.Type, f.Required, f.Choices, i)
+		}
+		if err != nil {
+			return nil, err
+		}
+		result[i] = f
+	}
+	return result, tx.Commit(ctx)
+}
+
+// saveAnswers replaces a user's answers to a hackathon's form.
+func saveAnswers(ctx context.Context, tx pgx.Tx, hackathonID, userID int64, answers []model.FormAnswer) error {
+	if _, err := tx.Exec(ctx,
+		`DELETE FROM hackathon_form_answers WHERE hackathon_id = $1 AND user_id = $2`, hackathonID, userID); err != nil {
+		return err
+	}
+	for _, a := range answers {
+		choices := a.Choices
+		if choices == nil {
+			choices = []string{}
+		}
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO hackathon_form_answers (hackathon_id, user_id, field_id, value, choices)
+			 VALUES ($1, $2, $3, $4, $5)`,
+			hackathonID, userID, a.FieldID, a.Value, choices); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listAnswers returns every applicant's answers to a hackathon's form by
+// user id, in form order.
+func listAnswers(ctx context.Context, q querier, hackathonID int64) (map[int64][]model.FormAnswer, error) {
+	rows, err := q.Query(ctx,
+		`SELECT a.user_id, a.field_id, a.value, a.choices
+		 FROM hackathon_form_answers a JOIN hackathon_form_fields f ON f.id = a.field_id
+		 WHERE a.hackathon_id = $1
+		 ORDER BY a.user_id, f.position, f.id`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	answers := map[int64][]model.FormAnswer{}
+	for rows.Next() {
+		var userID int64
+		var a model.FormAnswer
+		if err := rows.Scan(&userID, &a.FieldID, &a.Value, &a.Choices); err != nil {
+			return nil, err
+		}
+		if len(a.Choices) == 0 {
+			a.Choices = nil
+		}
+		answers[userID] = append(answers[userID], a)
+	}
+	return answers, rows.Err()
+}
@@ -0,0 +1,579 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type JudgingRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewJudgingRepository(pool *pgxpool.Pool) *JudgingRepository {
+	return &JudgingRepository{pool: pool}
+}
+
+func (r *JudgingRepository) ListCriteria(ctx context.Context, hackathonID int64) ([]model.JudgingCriterion, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT id, hackathon_id, name, description, weight, max_score FROM judging_criteria
+		 WHERE hackathon_id = $1 ORDER BY position, id`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.JudgingCriterion
+	for rows.Next() {
+		var c model.JudgingCriterion
+		if err := rows.Scan(&c.ID, &c.HackathonID, &c.Name, &c.Description, &c.Weight, &c.MaxScore); err != nil {
+			return nil, err
+		}
+		list = append(list, c)
+	}
+	return list, rows.Err()
+}
+
+// ReplaceCriteria swaps a hackathon's rubric for criteria, in order. The
+// rubric is frozen once any project has been scored against it.
+func (r *JudgingRepository) ReplaceCriteria(ctx context.Context, hackathonID int64, criteria []model.JudgingCriterion) ([]model.JudgingCriterion, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Scoring locks the hackathon row too, so no score lands in between.
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM hackathons WHERE id = $1 FOR UPDATE`, hackathonID); err != nil {
+		return nil, err
+	}
+	var scored bool
+	if err := tx.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM judge_scores sc JOIN judging_criteria c ON c.id = sc.criterion_id
+		                WHERE c.hackathon_id = $1)`, hackathonID).Scan(&scored); err != nil {
+		return nil, err
+	}
+	if scored {
+		return nil, fmt.Errorf("the rubric cannot change once scoring has started")
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM judging_criteria WHERE hackathon_id = $1`, hackathonID); err != nil {
+		return nil, err
+	}
+	result := make([]model.JudgingCriterion, len(criteria))
+	for i, c := range criteria {
+		c.HackathonID = hackathonID
+		if err := tx.QueryRow(ctx,
+			`INSERT INTO judging_criteria (hackathon_id, name, description, weight, max_score, position)
+			 VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+			hackathonID, c.Name, c.Description, c.Weight, c.MaxScore, i).Scan(&c.ID); err != nil {
+			return nil, err
+		}
+		result[i] = c
+	}
+	return result, tx.Commit(ctx)
+}
+
+// ListJudges returns a hackathon's judges with their progress.
+func (r *JudgingRepository) ListJudges(ctx context.Context, hackathonID int64) ([]model.Judge, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT j.hackathon_id, j.user_id, j.created_at,
+		        (SELECT COUNT(*) FROM judge_assignments a WHERE a.hackathon_id = j.hackathon_id AND a.judge_id = j.user_id),
+		        (SELECT COUNT(*) FROM judge_assignments a WHERE a.hackathon_id = j.hackathon_id AND a.judge_id = j.user_id
+		                                                   AND a.scored_at IS NOT NULL),
+		        u.id, u.telegram_id, u.username, u.first_name, u.last_name, u.photo_url, u.role, u.school_login,
+		        u.school_level, u.school_xp, u.audit_ratio, u.coins, u.community_xp, u.community_level, u.created_at, u.updated_at
+		 FROM hackathon_judges j JOIN users u ON u.id = j.user_id
+		 WHERE j.hackathon_id = $1
+		 ORDER BY j.created_at, j.user_id`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.Judge
+	for rows.Next() {
+		var j model.Judge
+		var u model.User
+		if err := rows.Scan(&j.HackathonID, &j.UserID, &j.CreatedAt, &j.Assigned, &j.Scored,
+			&u.ID, &u.TelegramID, &u.Username, &u.FirstName, &u.LastName, &u.PhotoURL, &u.Role,
+			&u.SchoolLogin, &u.SchoolLevel, &u.SchoolXP, &u.AuditRatio, &u.Coins, &u.CommunityXP, &u.CommunityLevel,
+			&u.CreatedAt, &u.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		j.User = &u
+		list = append(list, j)
+	}
+	return list, rows.Err()
+}
+
+func (r *JudgingRepository) IsJudge(ctx context.Context, hackathonID, userID int64) (bool, error) {
+	var ok bool
+	err := r.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM hackathon_judges WHERE hackathon_id = $1 AND user_id = $2)`,
+		hackathonID, userID).Scan(&ok)
+	return ok, err
+}
+
+// AddJudge returns false if the user already judges the hackathon.
+func (r *JudgingRepository) AddJudge(ctx context.Context, hackathonID, userID, addedBy int64) (bool, error) {
+	tag, err := r.pool.Exec(ctx,
+		`INSERT INTO hackathon_judges (hackathon_id, user_id, added_by) VALUES ($1, $2, $3)
+		 ON CONFLICT DO NOTHING`, hackathonID, userID, addedBy)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// RemoveJudge also drops the judge's assignments and scores. It returns
+// false if the user was not a judge.
+func (r *JudgingRepository) RemoveJudge(ctx context.Context, hackathonID, userID int64) (bool, error) {
+	tag, err := r.pool.Exec(ctx,
+		`DELETE FROM hackathon_judges WHERE hackathon_id = $1 AND user_id = $2`, hackathonID, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Assign gives judges projects to score. Pairs that already exist, whose
+// project is not in the hackathon, or where the judge is on the project's
+// team are skipped; it returns how many were added.
+func (r *JudgingRepository) Assign(ctx context.Context, hackathonID int64, pairs []model.JudgeAssignment) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	added := 0
+	for _, p := range pairs {
+		tag, err := tx.Exec(ctx,
+			`INSERT INTO judge_assignments (project_id, judge_id, hackathon_id)
+			 SELECT s.id, $2, s.hackathon_id FROM hackathon_submissions s
+			 WHERE s.id = $1 AND s.hackathon_id = $3
+			   AND NOT EXISTS (SELECT 1 FROM team_members m WHERE m.team_id = s.team_id AND m.user_id = $2)
+			 ON CONFLICT DO NOTHING`, p.ProjectID, p.JudgeID, hackathonID)
+		if err != nil {
+			return 0, err
+		}
+		added += int(tag.RowsAffected())
+	}
+	return added, tx.Commit(ctx)
+}
+
+// ListAssignments returns a hackathon's assignments with their projects and
+// scores, for one judge if judgeID is set.
+func (r *JudgingRepository) ListAssignments(ctx context.Context, hackathonID int64, judgeID *int64) ([]model.JudgeAssignment, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT a.project_id, a.judge_id, a.hackathon_id, a.comment, a.scored_at, `+projectColumns+projectFrom+`
+		 JOIN judge_assignments a ON a.project_id = s.id
+		 WHERE a.hackathon_id = $1 AND ($2::bigint IS NULL OR a.judge_id = $2)
+		 ORDER BY a.judge_id, lower(t.name), s.id`, hackathonID, judgeID)
+	if err != nil {
+		return nil, err
+	}
+	var list []model.JudgeAssignment
+	for rows.Next() {
+		var a model.JudgeAssignment
+		var p model.Project
+		if err := rows.Scan(&a.ProjectID, &a.JudgeID, &a.HackathonID, &a.Comment, &a.ScoredAt,
+			&p.ID, &p.HackathonID, &p.TeamID, &p.TeamName, &p.Title, &p.Description, &p.RepoURL, &p.DemoURL,
+			&p.Attachments, &p.UpdatedBy, &p.FinalScore, &p.Placement, &p.CreatedAt, &p.UpdatedAt,
+		); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		a.Project = &p
+		a.Scores = map[int64]int{}
+		list = append(list, a)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil || len(list) == 0 {
+		return list, err
+	}
+
+	type key struct{ project, judge int64 }
+	byKey := make(map[key]*model.JudgeAssignment, len(list))
+	for i := range list {
+		byKey[key{list[i].ProjectID, list[i].JudgeID}] = &list[i]
+	}
+	rows, err = r.pool.Query(ctx,
+		`SELECT sc.project_id, sc.judge_id, sc.criterion_id, sc.score
+		 FROM judge_scores sc JOIN judge_assignments a USING (project_id, judge_id)
+		 WHERE a.hackathon_id = $1 AND ($2::bigint IS NULL OR a.judge_id = $2)`, hackathonID, judgeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var projectID, judgeID, criterionID int64
+		var score int
+		if err := rows.Scan(&projectID, &judgeID, &criterionID, &score); err != nil {
+			return nil, err
+		}
+		if a := byKey[key{projectID, judgeID}]; a != nil {
+			a.Scores[criterionID] = score
+		}
+	}
+	return list, rows.Err()
+}
+
+// Score records a judge's scores and comment for an assigned project,
+// replacing earlier ones. It returns nil if the judge is not assigned the
+// project, and an error once judging has closed.
+func (r *JudgingRepository) Score(ctx context.Context, hackathonID int64, a *model.JudgeAssignment) (*model.JudgeAssignment, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Publishing locks the row for update, so scores cannot change under it.
+	var status string
+	if err := tx.QueryRow(ctx,
+		`SELECT status FROM hackathons WHERE id = $1 FOR SHARE`, hackathonID).Scan(&status); err != nil {
+		return nil, err
+	}
+	if status != model.HackathonJudging {
+		return nil, fmt.Errorf("judging is not open")
+	}
+	tag, err := tx.Exec(ctx,
+		`UPDATE judge_assignments SET comment = $3, scored_at = NOW()
+		 WHERE project_id = $1 AND judge_id = $2 AND hackathon_id = $4`,
+		a.ProjectID, a.JudgeID, a.Comment, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, nil
+	}
+	if _, err := tx.Exec(ctx,
+		`DELETE FROM judge_scores WHERE project_id = $1 AND judge_id = $2`, a.ProjectID, a.JudgeID); err != nil {
+		return nil, err
+	}
+	for criterionID, score := range a.Scores {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO judge_scores (project_id, judge_id, criterion_id, score) VALUES ($1, $2, $3, $4)`,
+			a.ProjectID, a.JudgeID, criterionID, score); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.QueryRow(ctx,
+		`SELECT scored_at FROM judge_assignments WHERE project_id = $1 AND judge_id = $2`,
+		a.ProjectID, a.JudgeID).Scan(&a.ScoredAt); err != nil {
+		return nil, err
+	}
+	a.HackathonID = hackathonID
+	return a, tx.Commit(ctx)
+}
+
+// judgeTotalsQuery gives each judge's total for the projects they scored:
+// the weighted average of their criterion scores as fractions of the
+// maximum, scaled to 0-100.
+const judgeTotalsQuery = `SELECT a.project_id, a.judge_id,
+	       SUM(c.weight * sc.score::float8 / c.max_score) / SUM(c.weight) * 100
+	FROM judge_assignments a
+	JOIN judge_scores sc ON sc.project_id = a.project_id AND sc.judge_id = a.judge_id
+	JOIN judging_criteria c ON c.id = sc.criterion_id
+	WHERE a.hackathon_id = $1 AND a.scored_at IS NOT NULL
+	GROUP BY a.project_id, a.judge_id`
+
+// queryResults ranks a hackathon's projects from the judges' totals; see
+// model.ScoreResults for how they are combined.
+func queryResults(ctx context.Context, q querier, hackathonID int64) ([]model.HackathonResult, error) {
+	rows, err := q.Query(ctx, judgeTotalsQuery, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	var totals []model.JudgeTotal
+	for rows.Next() {
+		var t model.JudgeTotal
+		if err := rows.Scan(&t.ProjectID, &t.JudgeID, &t.Total); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		totals = append(totals, t)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = q.Query(ctx,
+		`SELECT s.id, s.team_id, t.name, s.title
+		 FROM hackathon_submissions s JOIN teams t ON t.id = s.team_id
+		 WHERE s.hackathon_id = $1`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.HackathonResult
+	for rows.Next() {
+		var res model.HackathonResult
+		if err := rows.Scan(&res.ProjectID, &res.TeamID, &res.TeamName, &res.Title); err != nil {
+			return nil, err
+		}
+		list = append(list, res)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	model.ScoreResults(list, totals)
+	return list, nil
+}
+
+// Results computes the current ranking from the scores so far.
+func (r *JudgingRepository) Results(ctx context.Context, hackathonID int64) ([]model.HackathonResult, error) {
+	list, err := queryResults(ctx, r.pool, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	return list, attachPrizes(ctx, r.pool, hackathonID, list)
+}
+
+// PublishedResults returns the ranking as it was published.
+func (r *JudgingRepository) PublishedResults(ctx context.Context, hackathonID int64) ([]model.HackathonResult, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT s.id, s.team_id, t.name, s.title, s.final_score, s.placement,
+		        (SELECT COUNT(*) FROM judge_assignments a WHERE a.project_id = s.id AND a.scored_at IS NOT NULL)::int
+		 FROM hackathon_submissions s JOIN teams t ON t.id = s.team_id
+		 WHERE s.hackathon_id = $1
+		 ORDER BY s.placement NULLS LAST, lower(t.name), s.id`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.HackathonResult
+	for rows.Next() {
+		var res model.HackathonResult
+		if err := rows.Scan(&res.ProjectID, &res.TeamID, &res.TeamName, &res.Title, &res.Score, &res.Placement, &res.Judges); err != nil {
+			return nil, err
+		}
+		list = append(list, res)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, attachPrizes(ctx, r.pool, hackathonID, list)
+}
+
+// PublishResults ranks a hackathon in judging, stores each project's score
+// and placement, pays out the prizes and moves the hackathon to finished, in
+// one transaction. It returns nil results if the hackathon is not in judging.
+func (r *JudgingRepository) PublishResults(ctx context.Context, hackathonID int64) ([]model.HackathonResult, []model.PrizePayout, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var title string
+	err = tx.QueryRow(ctx,
+		`SELECT title FROM hackathons WHERE id = $1 AND status = 'judging' FOR UPDATE`, hackathonID).Scan(&title)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := queryResults(ctx, tx, hackathonID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := attachPrizes(ctx, tx, hackathonID, results); err != nil {
+		return nil, nil, err
+	}
+	for _, res := range results {
+		if _, err := tx.Exec(ctx,
+			`UPDATE hackathon_submissions SET final_score = $2, placement = $3 WHERE id = $1`,
+			res.ProjectID, res.Score, res.Placement); err != nil {
+			return nil, nil, err
+		}
+	}
+	payouts, err := payPrizes(ctx, tx, hackathonID, title, results)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE hackathons SET status = 'finished', status_changed_at = NOW(), results_published_at = NOW()
+		 WHERE id = $1`, hackathonID); err != nil {
+		return nil, nil, err
+	}
+	if results == nil {
+		results = []model.HackathonResult{}
+	}
+	return results, payouts, tx.Commit(ctx)
+}
+
+func listPrizes(ctx context.Context, q querier, hackathonID int64) ([]model.PrizeTier, error) {
+	rows, err := q.Query(ctx,
+		`SELECT placement, coins FROM hackathon_prizes WHERE hackathon_id = $1 ORDER BY placement`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.PrizeTier
+	for rows.Next() {
+		var t model.PrizeTier
+		if err := rows.Scan(&t.Placement, &t.Coins); err != nil {
+			return nil, err
+		}
+		list = append(list, t)
+	}
+	return list, rows.Err()
+}
+
+// attachPrizes sets the prize each result's placement wins.
+func attachPrizes(ctx context.Context, q querier, hackathonID int64, results []model.HackathonResult) error {
+	tiers, err := listPrizes(ctx, q, hackathonID)
+	if err != nil {
+		return err
+	}
+	coins := make(map[int]int, len(tiers))
+	for _, t := range tiers {
+		coins[t.Placement] = t.Coins
+	}
+	for i := range results {
+		if p := results[i].Placement; p != nil {
+			results[i].Prize = coins[*p]
+		}
+	}
+	return nil
+}
+
+// payPrizes splits each placed team's prize among its members and credits
+// them. A member who has already been paid for the hackathon is skipped, so
+// paying twice has no effect. Users are credited in ascending id order.
+func payPrizes(ctx context.Context, tx pgx.Tx, hackathonID int64, title string, results []model.HackathonResult) ([]model.PrizePayout, error) {
+	var payouts []model.PrizePayout
+	for _, res := range results {
+		if res.Placement == nil || res.Prize == 0 {
+			continue
+		}
+		rows, err := tx.Query(ctx,
+			`SELECT user_id FROM team_members WHERE team_id = $1 ORDER BY joined_at, user_id`, res.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		var members []int64
+		for rows.Next() {
+			var id int64
+			if err := rows.Scan(&id); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			members = append(members, id)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		for i, share := range model.SplitPrize(res.Prize, len(members)) {
+			if share > 0 {
+				payouts = append(payouts, model.PrizePayout{
+					HackathonID: hackathonID, UserID: members[i], TeamID: res.TeamID, Placement: *res.Placement, Coins: share,
+				})
+			}
+		}
+	}
+	sort.Slice(payouts, func(i, j int) bool { return payouts[i].UserID < payouts[j].UserID })
+
+	paid := make([]model.PrizePayout, 0, len(payouts))
+	for _, p := range payouts {
+		err := tx.QueryRow(ctx,
+			`INSERT INTO hackathon_prize_payouts (hackathon_id, user_id, team_id, placement, coins)
+			 VALUES ($1, $2, $3, $4, $5)
+			 ON CONFLICT DO NOTHING
+			 RETURNING created_at`,
+			p.HackathonID, p.UserID, p.TeamID, p.Placement, p.Coins).Scan(&p.CreatedAt)
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx,
+			`UPDATE users SET coins = coins + $1, updated_at = NOW() WHERE id = $2`, p.Coins, p.UserID); err != nil {
+			return nil, err
+		}
+		if err := insertLedgerEntry(ctx, tx, &model.LedgerEntry{
+			UserID: p.UserID, Amount: p.Coins, Kind: model.LedgerHackathonPrize, RefID: &hackathonID,
+			Note: fmt.Sprintf("#%d in %s", p.Placement, title),
+		}); err != nil {
+			return nil, err
+		}
+		paid = append(paid, p)
+	}
+	return paid, nil
+}
+
+func (r *JudgingRepository) ListPrizes(ctx context.Context, hackathonID int64) ([]model.PrizeTier, error) {
+	return listPrizes(ctx, r.pool, hackathonID)
+}
+
+// ReplacePrizes swaps a hackathon's prize tiers. Prizes are frozen once the
+// results have been published.
+func (r *JudgingRepository) ReplacePrizes(ctx context.Context, hackathonID int64, tiers []model.PrizeTier) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// Publishing locks the hackathon row too, so tiers cannot change mid-payout.
+	var published bool
+	if err := tx.QueryRow(ctx,
+		`SELECT results_published_at IS NOT NULL FROM hackathons WHERE id = $1 FOR UPDATE`,
+		hackathonID).Scan(&published); err != nil {
+		return err
+	}
+	if published {
+		return fmt.Errorf("prizes cannot change once results are published")
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM hackathon_prizes WHERE hackathon_id = $1`, hackathonID); err != nil {
+		return err
+	}
+	for _, t := range tiers {
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO hackathon_prizes (hackathon_id, placement, coins) VALUES ($1, $2, $3)`,
+			hackathonID, t.Placement, t.Coins); err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// ListPayouts returns the prizes paid for a hackathon, best placement first.
+func (r *JudgingRepository) ListPayouts(ctx context.Context, hackathonID int64) ([]model.PrizePayout, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT p.hackathon_id, p.user_id, p.team_id, p.placement, p.coins, p.created_at,
+		        u.id, u.telegram_id, u.username, u.first_name, u.last_name, u.photo_url, u.role, u.school_login,
+		        u.school_level, u.school_xp, u.audit_ratio, u.coins, u.community_xp, u.community_level, u.created_at, u.updated_at
+		 FROM hackathon_prize_payouts p JOIN users u ON u.id = p.user_id
+		 WHERE p.hackathon_id = $1
+		 ORDER BY p.placement, p.team_id, p.user_id`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.PrizePayout
+	for rows.Next() {
+		var p model.PrizePayout
+		var u model.User
+		if err := rows.Scan(&p.HackathonID, &p.UserID, &p.TeamID, &p.Placement, &p.Coins, &p.CreatedAt,
+			&u.ID, &u.TelegramID, &u.Username, &u.FirstName, &u.LastName, &u.PhotoURL, &u.Role,
+			&u.SchoolLogin, &u.SchoolLevel, &u.SchoolXP, &u.AuditRatio, &u.Coins, &u.CommunityXP, &u.CommunityLevel,
+			&u.CreatedAt, &u.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		p.User = &u
+		list = append(list, p)
+	}
+	return list, rows.Err()
+}
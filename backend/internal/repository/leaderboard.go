@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type LeaderboardRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewLeaderboardRepository(pool *pgxpool.Pool) *LeaderboardRepository {
+	return &LeaderboardRepository{pool: pool}
+}
+
+// leaderboardScores maps a metric to its score over users u and params p
+// (p.kinds: earned ledger kinds, p.since and p.until: period bounds or NULL).
+var leaderboardScores = map[string]string{
+	model.MetricCoins: `(SELECT COALESCE(SUM(l.amount), 0) FROM coin_ledger l
+		WHERE l.user_id = u.id AND l.kind = ANY(p.kinds)
+		  AND (p.since IS NULL OR l.created_at >= p.since) AND (p.until IS NULL OR l.created_at < p.until))`,
+	model.MetricXP:    `u.school_xp`,
+	model.MetricLevel: `u.school_level::bigint`,
+	model.MetricAttendance: `(SELECT COUNT(*) FROM attendance a
+		WHERE a.user_id = u.id
+		  AND (p.since IS NULL OR a.created_at >= p.since) AND (p.until IS NULL OR a.created_at < p.until))`,
+	model.MetricCommunityXP: `(SELECT COALESCE(SUM(x.amount), 0) FROM community_xp_events x
+		WHERE x.user_id = u.id
+		  AND (p.since IS NULL OR x.created_at >= p.since) AND (p.until IS NULL OR x.created_at < p.until))`,
+}
+
+// rankedLeaderboardSQL selects leaderboard entries for a metric from a "ranked"
+// CTE; callers append their own filter. $1 to $6 are bound by leaderboardArgs.
+// Only competing roles within the league's levels are ranked; ties share a rank.
+func rankedLeaderboardSQL(metric string) string {
+	score, ok := leaderboardScores[metric]
+	if !ok {
+		score = leaderboardScores[model.MetricCoins]
+	}
+	return `WITH p AS (
+		    SELECT $1::text[] AS kinds, $2::timestamptz AS since, $3::text[] AS roles,
+		           $4::int AS min_level, $5::int AS max_level, $6::timestamptz AS until
+		), scores AS (
+		    SELECT u.id, ` + score + ` AS score FROM users u CROSS JOIN p
+		    WHERE u.role = ANY(p.roles)
+		      AND (p.min_level IS NULL OR u.school_level >= p.min_level)
+		      AND (p.max_level IS NULL OR u.school_level <= p.max_level)
+		), ranked AS (
+		    SELECT id, score, RANK() OVER (ORDER BY score DESC) AS rank FROM scores
+		)
+		SELECT rk.rank, rk.score, u.id, u.first_name, u.last_name, u.username, u.photo_url, u.coins, u.school_level, u.community_level
+		FROM ranked rk JOIN users u ON u.id = rk.id `
+}
+
+func leaderboardArgs(q model.LeaderboardQuery, extra ...any) []any {
+	var minLevel, maxLevel *int
+	if q.League != nil {
+		minLevel, maxLevel = &q.League.MinLevel, q.League.MaxLevel
+	}
+	return append([]any{model.EarnedLedgerKinds, q.Since, model.LeaderboardRoles, minLevel, maxLevel, q.Until}, extra...)
+}
+
+func scanLeaderboardEntry(row pgx.Row) (*model.LeaderboardEntry, error) {
+	var e model.LeaderboardEntry
+	err := row.Scan(&e.Rank, &e.Score, &e.UserID, &e.FirstName, &e.LastName, &e.Username, &e.PhotoURL, &e.Coins, &e.SchoolLevel, &e.CommunityLevel)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// List returns one page of the leaderboard, ordered by rank then user id.
+func (r *LeaderboardRepository) List(ctx context.Context, q model.LeaderboardQuery) ([]model.LeaderboardEntry, error) {
+	rows, err := r.pool.Query(ctx,
+		rankedLeaderboardSQL(q.Metric)+`ORDER BY rk.rank, u.id LIMIT $7 OFFSET $8`,
+		leaderboardArgs(q, q.Limit, q.Offset)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.LeaderboardEntry
+	for rows.Next() {
+		e, err := scanLeaderboardEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *e)
+	}
+	return list, rows.Err()
+}
+
+// Rank returns userID's entry on the leaderboard, or nil if the user is not on it.
+func (r *LeaderboardRepository) Rank(ctx context.Context, q model.LeaderboardQuery, userID int64) (*model.LeaderboardEntry, error) {
+	return scanLeaderboardEntry(r.pool.QueryRow(ctx,
+		rankedLeaderboardSQL(q.Metric)+`WHERE rk.id = $7`,
+		leaderboardArgs(q, userID)...))
+}
@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type ProjectRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewProjectRepository(pool *pgxpool.Pool) *ProjectRepository {
+	return &ProjectRepository{pool: pool}
+}
+
+const projectColumns = `s.id, s.hackathon_id, s.team_id, t.name, s.title, s.description, s.repo_url, s.demo_url,
+	s.attachments, s.updated_by, s.final_score, s.placement, s.created_at, s.updated_at`
+
+const projectFrom = ` FROM hackathon_submissions s JOIN teams t ON t.id = s.team_id`
+
+func scanProject(row pgx.Row) (*model.Project, error) {
+	var p model.Project
+	err := row.Scan(&p.ID, &p.HackathonID, &p.TeamID, &p.TeamName, &p.Title, &p.Description, &p.RepoURL, &p.DemoURL,
+		&p.Attachments, &p.UpdatedBy, &p.FinalScore, &p.Placement, &p.CreatedAt, &p.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *ProjectRepository) GetByTeam(ctx context.Context, teamID int64) (*model.Project, error) {
+	return scanProject(r.pool.QueryRow(ctx,
+		`SELECT `+projectColumns+projectFrom+` WHERE s.team_id = $1`, teamID))
+}
+
+// ListByHackathon returns a hackathon's project submissions in team name order.
+func (r *ProjectRepository) ListByHackathon(ctx context.Context, hackathonID int64) ([]model.Project, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+projectColumns+projectFrom+` WHERE s.hackathon_id = $1 ORDER BY lower(t.name), s.id`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.Project
+	for rows.Next() {
+		p, err := scanProject(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *p)
+	}
+	return list, rows.Err()
+}
+
+// Save creates or replaces a team's submission. The deadline is checked in
+// the same statement, so an edit racing the deadline cannot slip through; it
+// returns nil if submissions are closed.
+func (r *ProjectRepository) Save(ctx context.Context, p *model.Project) (*model.Project, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var id int64
+	err = tx.QueryRow(ctx,
+		`INSERT INTO hackathon_submissions (hackathon_id, team_id, title, description, repo_url, demo_url, attachments, updated_by)
+		 SELECT h.id, $2, $3, $4, $5, $6, $7, $8 FROM hackathons h
+		 WHERE h.id = $1 AND h.status = 'running' AND NOW() < COALESCE(h.submission_deadline, h.end_date)
+		 ON CONFLICT (team_id) DO UPDATE
+		 SET title = EXCLUDED.title, description = EXCLUDED.description, repo_url = EXCLUDED.repo_url,
+		     demo_url = EXCLUDED.demo_url, attachments = EXCLUDED.attachments, updated_by = EXCLUDED.updated_by,
+		     updated_at = NOW()
+		 RETURNING id`,
+		p.HackathonID, p.TeamID, p.Title, p.Description, p.RepoURL, p.DemoURL, p.Attachments, p.UpdatedBy).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	result, err := scanProject(tx.QueryRow(ctx,
+		`SELECT `+projectColumns+projectFrom+` WHERE s.id = $1`, id))
+	if err != nil {
+		return nil, err
+	}
+	return result, tx.Commit(ctx)
+}
@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type QuestRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewQuestRepository(pool *pgxpool.Pool) *QuestRepository {
+	return &QuestRepository{pool: pool}
+}
+
+// questColumns expects quests aliased as q and the viewer's user id as $1.
+const questColumns = `q.id, q.title, q.description, q.reward_coins, q.deadline, q.max_completions, q.active,
+	q.created_by, q.created_at,
+	(SELECT COUNT(*) FROM quest_submissions WHERE quest_id = q.id AND status = 'approved') AS completions,
+	COALESCE((SELECT status FROM quest_submissions WHERE quest_id = q.id AND user_id = $1
+	          ORDER BY created_at DESC LIMIT 1), '') AS my_status`
+
+func scanQuest(row pgx.Row) (*model.Quest, error) {
+	var q model.Quest
+	err := row.Scan(&q.ID, &q.Title, &q.Description, &q.RewardCoins, &q.Deadline, &q.MaxCompletions, &q.Active,
+		&q.CreatedBy, &q.CreatedAt, &q.Completions, &q.MyStatus)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+// List returns quests still taking submissions, soonest deadline first, or
+// every quest if includeClosed is set.
+func (r *QuestRepository) List(ctx context.Context, userID *int64, includeClosed bool) ([]model.Quest, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+questColumns+` FROM quests q
+		 WHERE $2 OR (q.active AND (q.deadline IS NULL OR q.deadline > NOW()))
+		 ORDER BY q.active DESC, q.deadline ASC NULLS LAST, q.created_at DESC`, userID, includeClosed)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.Quest
+	for rows.Next() {
+		q, err := scanQuest(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *q)
+	}
+	return list, rows.Err()
+}
+
+func (r *QuestRepository) GetByID(ctx context.Context, id int64, userID *int64) (*model.Quest, error) {
+	return scanQuest(r.pool.QueryRow(ctx,
+		`SELECT `+questColumns+` FROM quests q WHERE q.id = $2`, userID, id))
+}
+
+func (r *QuestRepository) Create(ctx context.Context, q *model.Quest) (*model.Quest, error) {
+	var id int64
+	err := r.pool.QueryRow(ctx,
+		`INSERT INTO quests (title, description, reward_coins, deadline, max_completions, active, created_by)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 RETURNING id`,
+		q.Title, q.Description, q.RewardCoins, q.Deadline, q.MaxCompletions, q.Active, q.CreatedBy,
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, id, nil)
+}
+
+// Update returns nil if the quest does not exist.
+func (r *QuestRepository) Update(ctx context.Context, q *model.Quest) (*model.Quest, error) {
+	tag, err := r.pool.Exec(ctx,
+		`UPDATE quests
+		 SET title = $2, description = $3, reward_coins = $4, deadline = $5, max_completions = $6, active = $7, updated_at = NOW()
+		 WHERE id = $1`,
+		q.ID, q.Title, q.Description, q.RewardCoins, q.Deadline, q.MaxCompletions, q.Active)
+	if err != nil || tag.RowsAffected() == 0 {
+		return nil, err
+	}
+	return r.GetByID(ctx, q.ID, nil)
+}
+
+// ─── Submissions ─────────────────────────────────────────────────────────────
+
+// submissionColumns expects quest_submissions aliased as s joined to quests as q.
+const submissionColumns = `s.id, s.quest_id, q.title, s.user_id, s.proof_text, s.proof_url, s.status, s.review_note,
+	s.reviewed_by, s.reviewed_at, s.created_at`
+
+func scanSubmission(row pgx.Row) (*model.QuestSubmission, error) {
+	var s model.QuestSubmission
+	err := row.Scan(&s.ID, &s.QuestID, &s.QuestTitle, &s.UserID, &s.ProofText, &s.ProofURL, &s.Status, &s.ReviewNote,
+		&s.ReviewedBy, &s.ReviewedAt, &s.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// CreateSubmission returns nil if the user already has a pending or approved
+// submission for the quest.
+func (r *QuestRepository) CreateSubmission(ctx context.Context, sub *model.QuestSubmission) (*model.QuestSubmission, error) {
+	return scanSubmission(r.pool.QueryRow(ctx,
+		`WITH s AS (
+		     INSERT INTO quest_submissions (quest_id, user_id, proof_text, proof_url)
+		     VALUES ($1, $2, $3, $4)
+		     ON CONFLICT (quest_id, user_id) WHERE status IN ('pending', 'approved') DO NOTHING
+		     RETURNING *
+		 )
+		 SELECT `+submissionColumns+` FROM s JOIN quests q ON q.id = s.quest_id`,
+		sub.QuestID, sub.UserID, sub.ProofText, sub.ProofURL))
+}
+
+func (r *QuestRepository) GetSubmission(ctx context.Context, id int64) (*model.QuestSubmission, error) {
+	return scanSubmission(r.pool.QueryRow(ctx,
+		`SELECT `+submissionColumns+` FROM quest_submissions s JOIN quests q ON q.id = s.quest_id
+		 WHERE s.id = $1`, id))
+}
+
+// ListSubmissions returns submissions with status, oldest first so the review
+// queue is worked in order. A nil questID lists all quests.
+func (r *QuestRepository) ListSubmissions(ctx context.Context, status string, questID *int64) ([]model.QuestSubmission, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+submissionColumns+` FROM quest_submissions s JOIN quests q ON q.id = s.quest_id
+		 WHERE s.status = $1 AND ($2::bigint IS NULL OR s.quest_id = $2)
+		 ORDER BY s.created_at ASC
+		 LIMIT 200`, status, questID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.QuestSubmission
+	for rows.Next() {
+		s, err := scanSubmission(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *s)
+	}
+	return list, rows.Err()
+}
+
+// ApproveSubmission marks a pending submission approved and pays the quest
+// reward. The quest row is locked before the user row, matching the
+// item-then-user order used by the shop, so concurrent approvals cannot
+// exceed max_completions. The reward counts towards the reviewer's dailyCap.
+// It returns nil if the submission is not pending or belongs to the reviewer.
+func (r *QuestRepository) ApproveSubmission(ctx context.Context, id, reviewerID int64, note string, dailyCap int) (*model.QuestSubmission, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var (
+		reward         int
+		maxCompletions *int
+	)
+	err = tx.QueryRow(ctx,
+		`SELECT q.reward_coins, q.max_completions FROM quests q
+		 JOIN quest_submissions s ON s.quest_id = q.id
+		 WHERE s.id = $1
+		 FOR UPDATE OF q`, id,
+	).Scan(&reward, &maxCompletions)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := scanSubmission(tx.QueryRow(ctx,
+		`WITH s AS (
+		     UPDATE quest_submissions
+		     SET status = 'approved', review_note = $3, reviewed_by = $2, reviewed_at = NOW()
+		     WHERE id = $1 AND status = 'pending' AND user_id <> $2
+		     RETURNING *
+		 )
+		 SELECT `+submissionColumns+` FROM s JOIN quests q ON q.id = s.quest_id`,
+		id, reviewerID, note))
+	if err != nil || sub == nil {
+		return nil, err
+	}
+
+	if maxCompletions != nil {
+		var completions int
+		err = tx.QueryRow(ctx,
+			`SELECT COUNT(*) FROM quest_submissions WHERE quest_id = $1 AND status = 'approved'`, sub.QuestID,
+		).Scan(&completions)
+		if err != nil {
+			return nil, err
+		}
+		if completions > *maxCompletions {
+			return nil, fmt.Errorf("quest has reached its limit of %d completions", *maxCompletions)
+		}
+	}
+
+	if err := enforceAwardCap(ctx, tx, reviewerID, reward, dailyCap); err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE users SET coins = coins + $1, updated_at = NOW() WHERE id = $2`, reward, sub.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to credit quest reward: %w", err)
+	}
+	entry := &model.LedgerEntry{
+		UserID: sub.UserID, Amount: reward, Kind: model.LedgerQuestReward,
+		RefID: &sub.ID, ActorID: &reviewerID, Note: sub.QuestTitle,
+	}
+	if err := insertLedgerEntry(ctx, tx, entry); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// RejectSubmission returns nil if the submission is not pending or belongs to
+// the reviewer.
+func (r *QuestRepository) RejectSubmission(ctx context.Context, id, reviewerID int64, note string) (*model.QuestSubmission, error) {
+	return scanSubmission(r.pool.QueryRow(ctx,
+		`WITH s AS (
+		     UPDATE quest_submissions
+		     SET status = 'rejected', review_note = $3, reviewed_by = $2, reviewed_at = NOW()
+		     WHERE id = $1 AND status = 'pending' AND user_id <> $2
+		     RETURNING *
+		 )
+		 SELECT `+submissionColumns+` FROM s JOIN quests q ON q.id = s.quest_id`,
+		id, reviewerID, note))
+}
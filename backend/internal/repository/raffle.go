@@ -0,0 +1,207 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type RaffleRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewRaffleRepository(pool *pgxpool.Pool) *RaffleRepository {
+	return &RaffleRepository{pool: pool}
+}
+
+// raffleColumns expects raffles aliased as rf and the viewer's user id as $1.
+const raffleColumns = `rf.id, rf.title, rf.description, rf.prize, rf.image_url, rf.ticket_price_coins, rf.max_tickets_per_user,
+	rf.status, rf.seed, rf.seed_hash, rf.winning_ticket, rf.winner_id, rf.created_at, rf.drawn_at,
+	(SELECT COUNT(*) FROM raffle_tickets WHERE raffle_id = rf.id) AS tickets_sold,
+	(SELECT COUNT(*) FROM raffle_tickets WHERE raffle_id = rf.id AND user_id = $1) AS my_tickets`
+
+func scanRaffle(row pgx.Row) (*model.Raffle, error) {
+	var rf model.Raffle
+	err := row.Scan(&rf.ID, &rf.Title, &rf.Description, &rf.Prize, &rf.ImageURL, &rf.TicketPriceCoins, &rf.MaxTicketsPerUser,
+		&rf.Status, &rf.Seed, &rf.SeedHash, &rf.WinningTicket, &rf.WinnerID, &rf.CreatedAt, &rf.DrawnAt,
+		&rf.TicketsSold, &rf.MyTickets)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &rf, nil
+}
+
+func (r *RaffleRepository) List(ctx context.Context, userID *int64) ([]model.Raffle, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+raffleColumns+` FROM raffles rf ORDER BY rf.status = 'open' DESC, rf.created_at DESC`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.Raffle
+	for rows.Next() {
+		rf, err := scanRaffle(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *rf)
+	}
+	return list, rows.Err()
+}
+
+func (r *RaffleRepository) GetByID(ctx context.Context, id int64, userID *int64) (*model.Raffle, error) {
+	rf, err := scanRaffle(r.pool.QueryRow(ctx,
+		`SELECT `+raffleColumns+` FROM raffles rf WHERE rf.id = $2`, userID, id))
+	if err != nil || rf == nil || rf.WinnerID == nil {
+		return rf, err
+	}
+	rf.Winner, err = scanUser(r.pool.QueryRow(ctx, `SELECT `+userColumns+` FROM users WHERE id = $1`, *rf.WinnerID))
+	return rf, err
+}
+
+func (r *RaffleRepository) Create(ctx context.Context, rf *model.Raffle) (*model.Raffle, error) {
+	var id int64
+	err := r.pool.QueryRow(ctx,
+		`INSERT INTO raffles (title, description, prize, image_url, ticket_price_coins, max_tickets_per_user, seed, seed_hash)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		 RETURNING id`,
+		rf.Title, rf.Description, rf.Prize, rf.ImageURL, rf.TicketPriceCoins, rf.MaxTicketsPerUser, rf.Seed, rf.SeedHash,
+	).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, id, nil)
+}
+
+// BuyTickets deducts coins for quantity tickets and numbers them after the tickets already sold.
+// The raffle row is locked before the user row, matching the item-then-user order used by the shop.
+func (r *RaffleRepository) BuyTickets(ctx context.Context, raffleID, userID int64, quantity int) ([]model.RaffleTicket, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Lock and read the raffle
+	var title, status string
+	var price int
+	var maxPerUser *int
+	err = tx.QueryRow(ctx,
+		`SELECT title, status, ticket_price_coins, max_tickets_per_user FROM raffles WHERE id = $1 FOR UPDATE`, raffleID).
+		Scan(&title, &status, &price, &maxPerUser)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("raffle not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if status != model.RaffleOpen {
+		return nil, fmt.Errorf("raffle is closed")
+	}
+
+	var sold, mine int
+	err = tx.QueryRow(ctx,
+		`SELECT COUNT(*), COUNT(*) FILTER (WHERE user_id = $2) FROM raffle_tickets WHERE raffle_id = $1`,
+		raffleID, userID).Scan(&sold, &mine)
+	if err != nil {
+		return nil, err
+	}
+	if maxPerUser != nil && mine+quantity > *maxPerUser {
+		return nil, fmt.Errorf("ticket limit is %d per user", *maxPerUser)
+	}
+
+	// Coin columns are 32-bit; refuse totals that would not fit.
+	if price > 0 && quantity > math.MaxInt32/price {
+		return nil, fmt.Errorf("ticket total is too large")
+	}
+
+	// Lock the user and deduct coins
+	if err := debitCoins(ctx, tx, userID, price*quantity); err != nil {
+		return nil, err
+	}
+
+	entry := &model.LedgerEntry{
+		UserID: userID, Amount: -price * quantity, Kind: model.LedgerRaffleTicket, RefID: &raffleID,
+		Note: fmt.Sprintf("%d × %s", quantity, title),
+	}
+	if err := insertLedgerEntry(ctx, tx, entry); err != nil {
+		return nil, err
+	}
+
+	tickets := make([]model.RaffleTicket, quantity)
+	for i := range tickets {
+		t := &tickets[i]
+		err = tx.QueryRow(ctx,
+			`INSERT INTO raffle_tickets (raffle_id, user_id, ticket_number) VALUES ($1, $2, $3)
+			 RETURNING id, raffle_id, user_id, ticket_number, created_at`,
+			raffleID, userID, sold+i+1).
+			Scan(&t.ID, &t.RaffleID, &t.UserID, &t.TicketNumber, &t.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return tickets, nil
+}
+
+// Draw closes ticket sales and records the winning ticket derived from the committed seed.
+// A raffle without tickets is closed without a winner.
+func (r *RaffleRepository) Draw(ctx context.Context, raffleID int64) (*model.Raffle, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var status, seed string
+	err = tx.QueryRow(ctx, `SELECT status, seed FROM raffles WHERE id = $1 FOR UPDATE`, raffleID).Scan(&status, &seed)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("raffle not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if status != model.RaffleOpen {
+		return nil, fmt.Errorf("raffle already drawn")
+	}
+
+	var sold int
+	if err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM raffle_tickets WHERE raffle_id = $1`, raffleID).Scan(&sold); err != nil {
+		return nil, err
+	}
+
+	var winningTicket *int
+	var winnerID *int64
+	if sold > 0 {
+		n := model.WinningTicket(seed, sold)
+		winningTicket = &n
+		err = tx.QueryRow(ctx,
+			`SELECT user_id FROM raffle_tickets WHERE raffle_id = $1 AND ticket_number = $2`, raffleID, n).Scan(&winnerID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx,
+		`UPDATE raffles SET status = 'drawn', winning_ticket = $2, winner_id = $3, drawn_at = NOW() WHERE id = $1`,
+		raffleID, winningTicket, winnerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return r.GetByID(ctx, raffleID, nil)
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type ReferralRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewReferralRepository(pool *pgxpool.Pool) *ReferralRepository {
+	return &ReferralRepository{pool: pool}
+}
+
+// referralLockKey namespaces the per-login advisory locks taken by Complete.
+const referralLockKey = 39
+
+// Code returns userID's referral code, or "" if the user does not exist.
+func (r *ReferralRepository) Code(ctx context.Context, userID int64) (string, error) {
+	var code string
+	err := r.pool.QueryRow(ctx, `SELECT referral_code FROM users WHERE id = $1`, userID).Scan(&code)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	}
+	return code, err
+}
+
+// Create records that referrerCode brought refereeID in. It does nothing if
+// the code is unknown, belongs to the referee, or the referee was already
+// referred, and reports whether a referral was created.
+func (r *ReferralRepository) Create(ctx context.Context, referrerCode string, refereeID int64) (bool, error) {
+	tag, err := r.pool.Exec(ctx,
+		`INSERT INTO referrals (referrer_id, referee_id)
+		 SELECT id, $2 FROM users WHERE referral_code = $1 AND id <> $2
+		 ON CONFLICT (referee_id) DO NOTHING`,
+		referrerCode, refereeID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Complete settles refereeID's pending referral now that they verified as
+// schoolLogin, crediting reward coins to both sides. The referral is rejected
+// instead if the login was already referred or belongs to the referrer. It
+// returns nil if there is no pending referral.
+func (r *ReferralRepository) Complete(ctx context.Context, refereeID int64, schoolLogin string, reward int) (*model.Referral, error) {
+	schoolLogin = strings.ToLower(schoolLogin)
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Serialise completions for one login so the duplicate check below holds.
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1, hashtext($2))`, int32(referralLockKey), schoolLogin); err != nil {
+		return nil, err
+	}
+
+	ref := model.Referral{Referee: model.User{ID: refereeID}}
+	var referrerLogin string
+	err = tx.QueryRow(ctx,
+		`SELECT rf.id, rf.referrer_id, rf.created_at, LOWER(u.school_login)
+		 FROM referrals rf JOIN users u ON u.id = rf.referrer_id
+		 WHERE rf.referee_id = $1 AND rf.status = 'pending'
+		 FOR UPDATE OF rf`, refereeID,
+	).Scan(&ref.ID, &ref.ReferrerID, &ref.CreatedAt, &referrerLogin)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var alreadyReferred bool
+	err = tx.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM referrals WHERE school_login = $1 AND status = 'rewarded')`, schoolLogin,
+	).Scan(&alreadyReferred)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case alreadyReferred:
+		ref.Note = "school login was already referred"
+	case referrerLogin == schoolLogin:
+		ref.Note = "school login belongs to the referrer"
+	}
+	if ref.Note != "" {
+		ref.Status = model.ReferralRejected
+		_, err = tx.Exec(ctx, `UPDATE referrals SET status = 'rejected', note = $2 WHERE id = $1`, ref.ID, ref.Note)
+		if err != nil {
+			return nil, err
+		}
+		return &ref, tx.Commit(ctx)
+	}
+
+	ref.Status = model.ReferralRewarded
+	ref.RewardCoins = reward
+	err = tx.QueryRow(ctx,
+		`UPDATE referrals SET status = 'rewarded', school_login = $2, reward_coins = $3, rewarded_at = NOW()
+		 WHERE id = $1
+		 RETURNING rewarded_at`,
+		ref.ID, schoolLogin, reward,
+	).Scan(&ref.RewardedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if reward > 0 {
+		// Lock both users in ascending id order, like transfers, to avoid deadlocks.
+		if _, err := tx.Exec(ctx,
+			`SELECT id FROM users WHERE id IN ($1, $2) ORDER BY id FOR UPDATE`, ref.ReferrerID, refereeID); err != nil {
+			return nil, err
+		}
+		for userID, counterparty := range map[int64]int64{ref.ReferrerID: refereeID, refereeID: ref.ReferrerID} {
+			_, err = tx.Exec(ctx, `UPDATE users SET coins = coins + $1, updated_at = NOW() WHERE id = $2`, reward, userID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to credit referral bonus: %w", err)
+			}
+			entry := &model.LedgerEntry{
+				UserID: userID, Amount: reward, Kind: model.LedgerReferralBonus,
+				RefID: &ref.ID, CounterpartyID: &counterparty, Note: "Referral bonus",
+			}
+			if err := insertLedgerEntry(ctx, tx, entry); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &ref, nil
+}
+
+// ListByReferrer returns the users referrerID brought in, newest first.
+func (r *ReferralRepository) ListByReferrer(ctx context.Context, referrerID int64) ([]model.Referral, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT rf.id, rf.referrer_id, rf.status, rf.note, rf.reward_coins, rf.created_at, rf.rewarded_at,
+		        u.id, u.username, u.first_name, u.last_name, u.photo_url, u.role
+		 FROM referrals rf JOIN users u ON u.id = rf.referee_id
+		 WHERE rf.referrer_id = $1
+		 ORDER BY rf.created_at DESC
+		 LIMIT 200`, referrerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.Referral
+	for rows.Next() {
+		var ref model.Referral
+		u := &ref.Referee
+		err := rows.Scan(&ref.ID, &ref.ReferrerID, &ref.Status, &ref.Note, &ref.RewardCoins, &ref.CreatedAt, &ref.RewardedAt,
+			&u.ID, &u.Username, &u.FirstName, &u.LastName, &u.PhotoURL, &u.Role)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, ref)
+	}
+	return list, rows.Err()
+}
@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type SeasonRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewSeasonRepository(pool *pgxpool.Pool) *SeasonRepository {
+	return &SeasonRepository{pool: pool}
+}
+
+const seasonColumns = `id, name, starts_at, ends_at, carry_over_coins, closed_at, created_at`
+
+func scanSeason(row pgx.Row) (*model.Season, error) {
+	var s model.Season
+	err := row.Scan(&s.ID, &s.Name, &s.StartsAt, &s.EndsAt, &s.CarryOverCoins, &s.ClosedAt, &s.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *SeasonRepository) querySeasons(ctx context.Context, sql string, args ...any) ([]model.Season, error) {
+	rows, err := r.pool.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.Season
+	for rows.Next() {
+		s, err := scanSeason(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *s)
+	}
+	return list, rows.Err()
+}
+
+func (r *SeasonRepository) List(ctx context.Context) ([]model.Season, error) {
+	return r.querySeasons(ctx, `SELECT `+seasonColumns+` FROM seasons ORDER BY starts_at DESC`)
+}
+
+// ListDue returns seasons past their end that have not been closed yet.
+func (r *SeasonRepository) ListDue(ctx context.Context) ([]model.Season, error) {
+	return r.querySeasons(ctx,
+		`SELECT `+seasonColumns+` FROM seasons WHERE closed_at IS NULL AND ends_at <= NOW() ORDER BY ends_at`)
+}
+
+func (r *SeasonRepository) GetByID(ctx context.Context, id int64) (*model.Season, error) {
+	return scanSeason(r.pool.QueryRow(ctx, `SELECT `+seasonColumns+` FROM seasons WHERE id = $1`, id))
+}
+
+// Current returns the season running now, if any.
+func (r *SeasonRepository) Current(ctx context.Context) (*model.Season, error) {
+	return scanSeason(r.pool.QueryRow(ctx,
+		`SELECT `+seasonColumns+` FROM seasons
+		 WHERE closed_at IS NULL AND starts_at <= NOW() AND ends_at > NOW()
+		 ORDER BY starts_at DESC LIMIT 1`))
+}
+
+// Overlaps reports whether another season than excludeID intersects s's dates.
+func (r *SeasonRepository) Overlaps(ctx context.Context, s *model.Season, excludeID int64) (bool, error) {
+	var overlaps bool
+	err := r.pool.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM seasons WHERE id <> $1 AND starts_at < $3 AND ends_at > $2)`,
+		excludeID, s.StartsAt, s.EndsAt,
+	).Scan(&overlaps)
+	return overlaps, err
+}
+
+func (r *SeasonRepository) Create(ctx context.Context, s *model.Season) (*model.Season, error) {
+	return scanSeason(r.pool.QueryRow(ctx,
+		`INSERT INTO seasons (name, starts_at, ends_at, carry_over_coins)
+		 VALUES ($1, $2, $3, $4)
+		 RETURNING `+seasonColumns,
+		s.Name, s.StartsAt, s.EndsAt, s.CarryOverCoins))
+}
+
+// Update changes a season that has not been closed. It returns nil if there is no such season.
+func (r *SeasonRepository) Update(ctx context.Context, s *model.Season) (*model.Season, error) {
+	return scanSeason(r.pool.QueryRow(ctx,
+		`UPDATE seasons SET name = $2, starts_at = $3, ends_at = $4, carry_over_coins = $5
+		 WHERE id = $1 AND closed_at IS NULL
+		 RETURNING `+seasonColumns,
+		s.ID, s.Name, s.StartsAt, s.EndsAt, s.CarryOverCoins))
+}
+
+// Delete removes a season that has not been closed and reports whether it did.
+func (r *SeasonRepository) Delete(ctx context.Context, id int64) (bool, error) {
+	tag, err := r.pool.Exec(ctx, `DELETE FROM seasons WHERE id = $1 AND closed_at IS NULL`, id)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Close ends a season: a season still running is cut short at NOW(), its
+// coin standings are archived, finishers ranked 1..topBadges with a positive
+// score get a badge, and balances are reset to zero unless the season
+// carries coins over.
+func (r *SeasonRepository) Close(ctx context.Context, id int64, topBadges int) (*model.Season, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	s, err := scanSeason(tx.QueryRow(ctx, `SELECT `+seasonColumns+` FROM seasons WHERE id = $1 FOR UPDATE`, id))
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, fmt.Errorf("season not found")
+	}
+	if s.ClosedAt != nil {
+		return nil, fmt.Errorf("season is already closed")
+	}
+
+	s, err = scanSeason(tx.QueryRow(ctx,
+		`UPDATE seasons SET ends_at = LEAST(ends_at, NOW()), closed_at = NOW()
+		 WHERE id = $1 AND starts_at < NOW()
+		 RETURNING `+seasonColumns, id))
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, fmt.Errorf("season has not started yet")
+	}
+
+	q := model.LeaderboardQuery{Metric: model.MetricCoins, Since: &s.StartsAt, Until: &s.EndsAt}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO season_standings (season_id, user_id, rank, score, school_level)
+		 SELECT $7, lb.id, lb.rank, lb.score, lb.school_level
+		 FROM (`+rankedLeaderboardSQL(q.Metric)+`) lb`,
+		leaderboardArgs(q, s.ID)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive standings: %w", err)
+	}
+
+	for rank := 1; rank <= topBadges; rank++ {
+		if err := awardSeasonBadge(ctx, tx, s, rank); err != nil {
+			return nil, err
+		}
+	}
+
+	if !s.CarryOverCoins {
+		if err := resetBalances(ctx, tx, s); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// awardSeasonBadge gives everyone who finished the season at rank a badge.
+// Nothing is created if nobody holds that rank with a positive score.
+func awardSeasonBadge(ctx context.Context, tx pgx.Tx, s *model.Season, rank int) error {
+	icon := "🏆"
+	if rank <= 3 {
+		icon = []string{"🥇", "🥈", "🥉"}[rank-1]
+	}
+	_, err := tx.Exec(ctx,
+		`WITH finishers AS (
+		     SELECT user_id FROM season_standings WHERE season_id = $1 AND rank = $2 AND score > 0
+		 ), badge AS (
+		     INSERT INTO badges (code, name, description, icon)
+		     SELECT $3, $4, $5, $6 WHERE EXISTS (SELECT 1 FROM finishers)
+		     ON CONFLICT (code) DO UPDATE SET name = EXCLUDED.name
+		     RETURNING id
+		 )
+		 INSERT INTO user_badges (user_id, badge_id)
+		 SELECT f.user_id, b.id FROM finishers f CROSS JOIN badge b
+		 ON CONFLICT DO NOTHING`,
+		s.ID, rank,
+		fmt.Sprintf("season-%d-rank-%d", s.ID, rank),
+		fmt.Sprintf("%s: #%d", s.Name, rank),
+		fmt.Sprintf("Finished season %s in place #%d", s.Name, rank),
+		icon)
+	if err != nil {
+		return fmt.Errorf("failed to award season badge: %w", err)
+	}
+	return nil
+}
+
+// resetBalances zeroes every positive balance and records it in the ledger.
+// Users are locked in id order first, like every other multi-user update.
+func resetBalances(ctx context.Context, tx pgx.Tx, s *model.Season) error {
+	_, err := tx.Exec(ctx, `SELECT id FROM users WHERE coins > 0 ORDER BY id FOR UPDATE`)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(ctx,
+		`INSERT INTO coin_ledger (user_id, amount, kind, ref_id, note)
+		 SELECT id, -coins, $1, $2, $3 FROM users WHERE coins > 0`,
+		model.LedgerSeasonReset, s.ID, fmt.Sprintf("Season %s ended", s.Name))
+	if err != nil {
+		return fmt.Errorf("failed to record balance reset: %w", err)
+	}
+	_, err = tx.Exec(ctx, `UPDATE users SET coins = 0, updated_at = NOW() WHERE coins > 0`)
+	return err
+}
+
+// Standings returns a page of a closed season's archived standings.
+func (r *SeasonRepository) Standings(ctx context.Context, seasonID int64, limit, offset int) ([]model.LeaderboardEntry, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT ss.rank, ss.score, u.id, u.first_name, u.last_name, u.username, u.photo_url, u.coins, ss.school_level, u.community_level
+		 FROM season_standings ss JOIN users u ON u.id = ss.user_id
+		 WHERE ss.season_id = $1
+		 ORDER BY ss.rank, u.id
+		 LIMIT $2 OFFSET $3`,
+		seasonID, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.LeaderboardEntry
+	for rows.Next() {
+		e, err := scanLeaderboardEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *e)
+	}
+	return list, rows.Err()
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -18,51 +21,59 @@ func NewShopRepository(pool *pgxpool.Pool) *ShopRepository {
 	return &ShopRepository{pool: pool}
 }
 
+const shopItemColumns = `id, name, description, image_url, price_coins, stock, created_at, sale_price_coins, sale_starts_at, sale_ends_at`
+
+func scanShopItem(row pgx.Row) (*model.ShopItem, error) {
+	var item model.ShopItem
+	err := row.Scan(&item.ID, &item.Name, &item.Description, &item.ImageURL, &item.PriceCoins, &item.Stock, &item.CreatedAt,
+		&item.SalePriceCoins, &item.SaleStartsAt, &item.SaleEndsAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
 func (r *ShopRepository) ListItems(ctx context.Context) ([]model.ShopItem, error) {
 	rows, err := r.pool.Query(ctx,
-		`SELECT id, name, description, image_url, price_coins, stock, created_at
-		 FROM shop_items ORDER BY created_at DESC`)
+		`SELECT `+shopItemColumns+` FROM shop_items ORDER BY created_at DESC`)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 	var items []model.ShopItem
 	for rows.Next() {
-		var item model.ShopItem
-		if err := rows.Scan(&item.ID, &item.Name, &item.Description, &item.ImageURL, &item.PriceCoins, &item.Stock, &item.CreatedAt); err != nil {
+		item, err := scanShopItem(rows)
+		if err != nil {
 			return nil, err
 		}
-		items = append(items, item)
+		items = append(items, *item)
 	}
-	return items, nil
+	return items, rows.Err()
 }
 
 func (r *ShopRepository) GetItem(ctx context.Context, id int64) (*model.ShopItem, error) {
-	var item model.ShopItem
-	err := r.pool.QueryRow(ctx,
-		`SELECT id, name, description, image_url, price_coins, stock, created_at
-		 FROM shop_items WHERE id = $1`, id).
-		Scan(&item.ID, &item.Name, &item.Description, &item.ImageURL, &item.PriceCoins, &item.Stock, &item.CreatedAt)
-	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, nil
-	}
-	if err != nil {
-		return nil, err
-	}
-	return &item, nil
+	return scanShopItem(r.pool.QueryRow(ctx,
+		`SELECT `+shopItemColumns+` FROM shop_items WHERE id = $1`, id))
 }
 
 func (r *ShopRepository) CreateItem(ctx context.Context, item *model.ShopItem) (*model.ShopItem, error) {
-	err := r.pool.QueryRow(ctx,
+	return scanShopItem(r.pool.QueryRow(ctx,
 		`INSERT INTO shop_items (name, description, image_url, price_coins, stock)
 		 VALUES ($1, $2, $3, $4, $5)
-		 RETURNING id, name, description, image_url, price_coins, stock, created_at`,
-		item.Name, item.Description, item.ImageURL, item.PriceCoins, item.Stock).
-		Scan(&item.ID, &item.Name, &item.Description, &item.ImageURL, &item.PriceCoins, &item.Stock, &item.CreatedAt)
-	if err != nil {
-		return nil, err
-	}
-	return item, nil
+		 RETURNING `+shopItemColumns,
+		item.Name, item.Description, item.ImageURL, item.PriceCoins, item.Stock))
+}
+
+// SetSale sets (or, with a nil price, clears) the timed sale price of an item.
+func (r *ShopRepository) SetSale(ctx context.Context, id int64, price *int, startsAt, endsAt *time.Time) (*model.ShopItem, error) {
+	return scanShopItem(r.pool.QueryRow(ctx,
+		`UPDATE shop_items SET sale_price_coins = $2, sale_starts_at = $3, sale_ends_at = $4
+		 WHERE id = $1
+		 RETURNING `+shopItemColumns,
+		id, price, startsAt, endsAt))
 }
 
 func (r *ShopRepository) DeleteItem(ctx context.Context, id int64) error {
@@ -71,7 +82,8 @@ func (r *ShopRepository) DeleteItem(ctx context.Context, id int64) error {
 }
 
 // Buy atomically deducts coins from user, decrements stock, and creates a purchase record.
-func (r *ShopRepository) Buy(ctx context.Context, userID, itemID int64) (*model.Purchase, error) {
+// The price is the item's current (sale) price minus the discount of promoCode, if given.
+func (r *ShopRepository) Buy(ctx context.Context, userID, itemID int64, promoCode string) (*model.Purchase, error) {
 	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return nil, err
@@ -79,35 +91,35 @@ func (r *ShopRepository) Buy(ctx context.Context, userID, itemID int64) (*model.
 	defer tx.Rollback(ctx)
 
 	// Lock and read the item
-	var item model.ShopItem
-	err = tx.QueryRow(ctx,
-		`SELECT id, name, price_coins, stock FROM shop_items WHERE id = $1 FOR UPDATE`, itemID).
-		Scan(&item.ID, &item.Name, &item.PriceCoins, &item.Stock)
-	if errors.Is(err, pgx.ErrNoRows) {
-		return nil, fmt.Errorf("item not found")
-	}
+	item, err := scanShopItem(tx.QueryRow(ctx,
+		`SELECT `+shopItemColumns+` FROM shop_items WHERE id = $1 FOR UPDATE`, itemID))
 	if err != nil {
 		return nil, err
 	}
+	if item == nil {
+		return nil, fmt.Errorf("item not found")
+	}
 
 	// Check stock
 	if item.Stock == 0 {
 		return nil, fmt.Errorf("item out of stock")
 	}
 
-	// Check user coins
-	var userCoins int
-	err = tx.QueryRow(ctx, `SELECT coins FROM users WHERE id = $1 FOR UPDATE`, userID).Scan(&userCoins)
-	if err != nil {
-		return nil, err
-	}
-	if userCoins < item.PriceCoins {
-		return nil, fmt.Errorf("not enough coins")
+	// Compute the final price
+	now := time.Now()
+	price := item.PriceAt(now)
+	discount := 0
+	var promo *model.PromoCode
+	if promoCode != "" {
+		promo, err = redeemPromoCode(ctx, tx, promoCode, userID, item.ID, now)
+		if err != nil {
+			return nil, err
+		}
+		discount = promo.Discount(price)
 	}
 
-	// Deduct coins
-	_, err = tx.Exec(ctx, `UPDATE users SET coins = coins - $1, updated_at = NOW() WHERE id = $2`, item.PriceCoins, userID)
-	if err != nil {
+	// Lock the user and deduct coins
+	if err := debitCoins(ctx, tx, userID, price-discount); err != nil {
 		return nil, err
 	}
 
@@ -120,19 +132,328 @@ func (r *ShopRepository) Buy(ctx context.Context, userID, itemID int64) (*model.
 	}
 
 	// Create purchase record
+	var promoID *int64
+	if promo != nil {
+		promoID = &promo.ID
+	}
 	var purchase model.Purchase
 	err = tx.QueryRow(ctx,
-		`INSERT INTO purchases (user_id, item_id) VALUES ($1, $2) RETURNING id, user_id, item_id, created_at`,
-		userID, itemID).
-		Scan(&purchase.ID, &purchase.UserID, &purchase.ItemID, &purchase.CreatedAt)
+		`INSERT INTO purchases (user_id, item_id, quantity, price_coins, promo_code_id, discount_coins)
+		 VALUES ($1, $2, 1, $3, $4, $5)
+		 RETURNING id, user_id, item_id, quantity, price_coins, promo_code_id, discount_coins, created_at`,
+		userID, itemID, price-discount, promoID, discount).
+		Scan(&purchase.ID, &purchase.UserID, &purchase.ItemID, &purchase.Quantity, &purchase.PriceCoins,
+			&purchase.PromoCodeID, &purchase.DiscountCoins, &purchase.CreatedAt)
 	if err != nil {
 		return nil, err
 	}
 	purchase.ItemName = item.Name
-	purchase.PriceCoins = item.PriceCoins
+
+	entry := &model.LedgerEntry{UserID: userID, Amount: -purchase.PriceCoins, Kind: model.LedgerPurchase, RefID: &purchase.ID, Note: item.Name}
+	if err := insertLedgerEntry(ctx, tx, entry); err != nil {
+		return nil, err
+	}
 
 	if err := tx.Commit(ctx); err != nil {
 		return nil, err
 	}
 	return &purchase, nil
 }
+
+// redeemPromoCode locks the code, checks that userID may use it on itemID and
+// counts the use. The caller's transaction must already hold the item lock.
+func redeemPromoCode(ctx context.Context, tx pgx.Tx, code string, userID, itemID int64, now time.Time) (*model.PromoCode, error) {
+	promo, err := scanPromoCode(tx.QueryRow(ctx,
+		`SELECT `+promoCodeColumns+` FROM promo_codes WHERE code = $1 FOR UPDATE`, strings.ToUpper(code)))
+	if err != nil {
+		return nil, err
+	}
+	if promo == nil {
+		return nil, fmt.Errorf("invalid promo code")
+	}
+	if promo.ExpiresAt != nil && !now.Before(*promo.ExpiresAt) {
+		return nil, fmt.Errorf("promo code has expired")
+	}
+	if promo.ItemID != nil && *promo.ItemID != itemID {
+		return nil, fmt.Errorf("promo code does not apply to this item")
+	}
+	if promo.MaxUses != nil && promo.UsedCount >= *promo.MaxUses {
+		return nil, fmt.Errorf("promo code usage limit reached")
+	}
+	if promo.MaxUsesPerUser != nil {
+		var used int
+		err := tx.QueryRow(ctx,
+			`SELECT COUNT(*) FROM purchases WHERE promo_code_id = $1 AND user_id = $2`, promo.ID, userID).Scan(&used)
+		if err != nil {
+			return nil, err
+		}
+		if used >= *promo.MaxUsesPerUser {
+			return nil, fmt.Errorf("promo code already used")
+		}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE promo_codes SET used_count = used_count + 1 WHERE id = $1`, promo.ID)
+	if err != nil {
+		return nil, err
+	}
+	promo.UsedCount++
+	return promo, nil
+}
+
+// Checkout buys every cart line in one transaction and records them as a single order.
+// Items are locked in ascending id order (and before the user row, as in Buy) so
+// concurrent checkouts cannot deadlock. The cart must not contain duplicate items.
+func (r *ShopRepository) Checkout(ctx context.Context, userID int64, cart []model.CartItem) (*model.Order, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	ids := make([]int64, len(cart))
+	for i, line := range cart {
+		ids[i] = line.ItemID
+	}
+
+	// Lock and read all items
+	rows, err := tx.Query(ctx,
+		`SELECT `+shopItemColumns+` FROM shop_items WHERE id = ANY($1) ORDER BY id FOR UPDATE`, ids)
+	if err != nil {
+		return nil, err
+	}
+	items := make(map[int64]*model.ShopItem, len(cart))
+	for rows.Next() {
+		item, err := scanShopItem(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		items[item.ID] = item
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Validate stock and compute the total at current (sale) prices
+	now := time.Now()
+	prices := make(map[int64]int, len(cart))
+	total := 0
+	for _, line := range cart {
+		item, ok := items[line.ItemID]
+		if !ok {
+			return nil, fmt.Errorf("item %d not found", line.ItemID)
+		}
+		if item.Stock >= 0 && item.Stock < line.Quantity {
+			return nil, fmt.Errorf("%s is out of stock", item.Name)
+		}
+		// Coin columns are 32-bit; refuse totals that would not fit.
+		price := item.PriceAt(now)
+		if price > 0 && line.Quantity > (math.MaxInt32-total)/price {
+			return nil, fmt.Errorf("order total is too large")
+		}
+		prices[item.ID] = price * line.Quantity
+		total += prices[item.ID]
+	}
+
+	// Lock the user and deduct coins
+	if err := debitCoins(ctx, tx, userID, total); err != nil {
+		return nil, err
+	}
+
+	order := model.Order{UserID: userID, TotalCoins: total}
+	err = tx.QueryRow(ctx,
+		`INSERT INTO orders (user_id, total_coins) VALUES ($1, $2) RETURNING id, created_at`,
+		userID, total).
+		Scan(&order.ID, &order.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range cart {
+		item := items[line.ItemID]
+
+		// Decrement stock (only if not unlimited)
+		if item.Stock > 0 {
+			_, err = tx.Exec(ctx, `UPDATE shop_items SET stock = stock - $1 WHERE id = $2`, line.Quantity, item.ID)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		purchase := model.Purchase{ItemName: item.Name}
+		err = tx.QueryRow(ctx,
+			`INSERT INTO purchases (user_id, item_id, order_id, quantity, price_coins) VALUES ($1, $2, $3, $4, $5)
+			 RETURNING id, user_id, item_id, order_id, quantity, price_coins, created_at`,
+			userID, item.ID, order.ID, line.Quantity, prices[item.ID]).
+			Scan(&purchase.ID, &purchase.UserID, &purchase.ItemID, &purchase.OrderID, &purchase.Quantity, &purchase.PriceCoins, &purchase.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		entry := &model.LedgerEntry{UserID: userID, Amount: -purchase.PriceCoins, Kind: model.LedgerPurchase, RefID: &purchase.ID, Note: item.Name}
+		if err := insertLedgerEntry(ctx, tx, entry); err != nil {
+			return nil, err
+		}
+		order.Items = append(order.Items, purchase)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// purchaseConditions builds a WHERE clause over purchases aliased as p.
+func purchaseConditions(f model.PurchaseFilter) (string, []any) {
+	var conds []string
+	var args []any
+	if f.UserID != nil {
+		args = append(args, *f.UserID)
+		conds = append(conds, fmt.Sprintf("p.user_id = $%d", len(args)))
+	}
+	if f.ItemID != nil {
+		args = append(args, *f.ItemID)
+		conds = append(conds, fmt.Sprintf("p.item_id = $%d", len(args)))
+	}
+	if f.From != nil {
+		args = append(args, *f.From)
+		conds = append(conds, fmt.Sprintf("p.created_at >= $%d", len(args)))
+	}
+	if f.To != nil {
+		args = append(args, *f.To)
+		conds = append(conds, fmt.Sprintf("p.created_at < $%d", len(args)))
+	}
+	if len(conds) == 0 {
+		return "", args
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+func (r *ShopRepository) ListPurchases(ctx context.Context, f model.PurchaseFilter) ([]model.Purchase, error) {
+	where, args := purchaseConditions(f)
+	rows, err := r.pool.Query(ctx,
+		`SELECT p.id, p.user_id, p.item_id, p.order_id, si.name, p.quantity, p.price_coins, p.promo_code_id, p.discount_coins, p.created_at,
+		        u.id, u.telegram_id, u.username, u.first_name, u.last_name, u.photo_url, u.role, u.created_at, u.updated_at
+		 FROM purchases p
+		 JOIN shop_items si ON si.id = p.item_id
+		 JOIN users u ON u.id = p.user_id`+where+`
+		 ORDER BY p.created_at DESC, p.id DESC`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []model.Purchase
+	for rows.Next() {
+		var p model.Purchase
+		var u model.User
+		if err := rows.Scan(
+			&p.ID, &p.UserID, &p.ItemID, &p.OrderID, &p.ItemName, &p.Quantity, &p.PriceCoins, &p.PromoCodeID, &p.DiscountCoins, &p.CreatedAt,
+			&u.ID, &u.TelegramID, &u.Username, &u.FirstName, &u.LastName, &u.PhotoURL, &u.Role, &u.CreatedAt, &u.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		p.User = &u
+		list = append(list, p)
+	}
+	return list, rows.Err()
+}
+
+// SalesReport aggregates units sold and coins spent per item, plus the topBuyers biggest spenders.
+func (r *ShopRepository) SalesReport(ctx context.Context, f model.PurchaseFilter, topBuyers int) (*model.SalesReport, error) {
+	where, args := purchaseConditions(f)
+	report := &model.SalesReport{}
+
+	rows, err := r.pool.Query(ctx,
+		`SELECT p.item_id, si.name, SUM(p.quantity), SUM(p.price_coins)
+		 FROM purchases p
+		 JOIN shop_items si ON si.id = p.item_id`+where+`
+		 GROUP BY p.item_id, si.name
+		 ORDER BY SUM(p.quantity) DESC, p.item_id`, args...)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var s model.ItemSales
+		if err := rows.Scan(&s.ItemID, &s.ItemName, &s.UnitsSold, &s.CoinsSpent); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		report.TotalUnits += s.UnitsSold
+		report.TotalCoins += s.CoinsSpent
+		report.Items = append(report.Items, s)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	args = append(args, topBuyers)
+	rows, err = r.pool.Query(ctx,
+		`SELECT u.id, u.telegram_id, u.username, u.first_name, u.last_name, u.photo_url, u.role, u.created_at, u.updated_at,
+		        SUM(p.quantity), SUM(p.price_coins)
+		 FROM purchases p
+		 JOIN users u ON u.id = p.user_id`+where+`
+		 GROUP BY u.id
+		 ORDER BY SUM(p.price_coins) DESC, u.id
+		 LIMIT $`+fmt.Sprint(len(args)), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var b model.BuyerStats
+		u := &b.User
+		if err := rows.Scan(&u.ID, &u.TelegramID, &u.Username, &u.FirstName, &u.LastName, &u.PhotoURL, &u.Role, &u.CreatedAt, &u.UpdatedAt,
+			&b.Units, &b.CoinsSpent); err != nil {
+			return nil, err
+		}
+		report.TopBuyers = append(report.TopBuyers, b)
+	}
+	return report, rows.Err()
+}
+
+const promoCodeColumns = `id, code, discount_type, discount_value, item_id, max_uses, max_uses_per_user, used_count, expires_at, created_at`
+
+func scanPromoCode(row pgx.Row) (*model.PromoCode, error) {
+	var p model.PromoCode
+	err := row.Scan(&p.ID, &p.Code, &p.DiscountType, &p.DiscountValue, &p.ItemID, &p.MaxUses, &p.MaxUsesPerUser,
+		&p.UsedCount, &p.ExpiresAt, &p.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *ShopRepository) ListPromoCodes(ctx context.Context) ([]model.PromoCode, error) {
+	rows, err := r.pool.Query(ctx, `SELECT `+promoCodeColumns+` FROM promo_codes ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.PromoCode
+	for rows.Next() {
+		p, err := scanPromoCode(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *p)
+	}
+	return list, rows.Err()
+}
+
+func (r *ShopRepository) CreatePromoCode(ctx context.Context, p *model.PromoCode) (*model.PromoCode, error) {
+	return scanPromoCode(r.pool.QueryRow(ctx,
+		`INSERT INTO promo_codes (code, discount_type, discount_value, item_id, max_uses, max_uses_per_user, expires_at)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7)
+		 RETURNING `+promoCodeColumns,
+		strings.ToUpper(p.Code), p.DiscountType, p.DiscountValue, p.ItemID, p.MaxUses, p.MaxUsesPerUser, p.ExpiresAt))
+}
+
+func (r *ShopRepository) DeletePromoCode(ctx context.Context, id int64) error {
+	_, err := r.pool.Exec(ctx, `DELETE FROM promo_codes WHERE id = $1`, id)
+	return err
+}
@@ -0,0 +1,578 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type TeamRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewTeamRepository(pool *pgxpool.Pool) *TeamRepository {
+	return &TeamRepository{pool: pool}
+}
+
+// teamColumns expects teams aliased as t joined to hackathons as h.
+const teamColumns = `t.id, t.hackathon_id, h.title, t.name, t.captain_id, t.invite_code, t.max_size,
+	a.id, a.status, t.created_at`
+
+const teamFrom = ` FROM teams t
+	JOIN hackathons h ON h.id = t.hackathon_id
+	LEFT JOIN hackathon_applications a ON a.team_id = t.id`
+
+func scanTeam(row pgx.Row) (*model.Team, error) {
+	var t model.Team
+	var appStatus *string
+	err := row.Scan(&t.ID, &t.HackathonID, &t.HackathonTitle, &t.Name, &t.CaptainID, &t.InviteCode, &t.MaxSize,
+		&t.ApplicationID, &appStatus, &t.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if appStatus != nil {
+		t.ApplicationStatus = *appStatus
+	}
+	return &t, nil
+}
+
+// queryTeams runs a team query and loads the members of every team found.
+func queryTeams(ctx context.Context, q querier, sql string, args ...any) ([]model.Team, error) {
+	rows, err := q.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	var list []model.Team
+	for rows.Next() {
+		t, err := scanTeam(rows)
+		if err != nil {
+			rows.Close()
+			return nil, err
+		}
+		list = append(list, *t)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil || len(list) == 0 {
+		return list, err
+	}
+
+	ids := make([]int64, len(list))
+	byID := make(map[int64]*model.Team, len(list))
+	for i := range list {
+		ids[i] = list[i].ID
+		list[i].Members = []model.TeamMember{}
+		byID[list[i].ID] = &list[i]
+	}
+	rows, err = q.Query(ctx,
+		`SELECT m.team_id, m.joined_at,
+		        u.id, u.telegram_id, u.username, u.first_name, u.last_name, u.photo_url, u.role, u.school_level, u.created_at, u.updated_at
+		 FROM team_members m
+		 JOIN users u ON u.id = m.user_id
+		 WHERE m.team_id = ANY($1)
+		 ORDER BY m.joined_at, m.user_id`, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var teamID int64
+		var m model.TeamMember
+		var u model.User
+		if err := rows.Scan(&teamID, &m.JoinedAt,
+			&u.ID, &u.TelegramID, &u.Username, &u.FirstName, &u.LastName, &u.PhotoURL, &u.Role, &u.SchoolLevel, &u.CreatedAt, &u.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		m.UserID = u.ID
+		m.User = &u
+		byID[teamID].Members = append(byID[teamID].Members, m)
+	}
+	return list, rows.Err()
+}
+
+func queryTeam(ctx context.Context, q querier, sql string, args ...any) (*model.Team, error) {
+	list, err := queryTeams(ctx, q, sql, args...)
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+	return &list[0], nil
+}
+
+// querier is implemented by both the pool and transactions.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func (r *TeamRepository) GetByID(ctx context.Context, id int64) (*model.Team, error) {
+	return queryTeam(ctx, r.pool, `SELECT `+teamColumns+teamFrom+` WHERE t.id = $1`, id)
+}
+
+func (r *TeamRepository) GetByCode(ctx context.Context, code string) (*model.Team, error) {
+	return queryTeam(ctx, r.pool, `SELECT `+teamColumns+teamFrom+` WHERE t.invite_code = $1`, code)
+}
+
+// GetByMember returns the team userID belongs to in a hackathon, if any.
+func (r *TeamRepository) GetByMember(ctx context.Context, hackathonID, userID int64) (*model.Team, error) {
+	return queryTeam(ctx, r.pool,
+		`SELECT `+teamColumns+teamFrom+`
+		 JOIN team_members m ON m.team_id = t.id
+		 WHERE m.hackathon_id = $1 AND m.user_id = $2`, hackathonID, userID)
+}
+
+// ListByHackathon returns a hackathon's teams, largest first.
+func (r *TeamRepository) ListByHackathon(ctx context.Context, hackathonID int64) ([]model.Team, error) {
+	return queryTeams(ctx, r.pool,
+		`SELECT `+teamColumns+teamFrom+`
+		 WHERE t.hackathon_id = $1
+		 ORDER BY (SELECT COUNT(*) FROM team_members m WHERE m.team_id = t.id) DESC, t.created_at`, hackathonID)
+}
+
+// ListByUser returns the teams userID belongs to, newest hackathon first.
+func (r *TeamRepository) ListByUser(ctx context.Context, userID int64) ([]model.Team, error) {
+	return queryTeams(ctx, r.pool,
+		`SELECT `+teamColumns+teamFrom+`
+		 JOIN team_members m ON m.team_id = t.id
+		 WHERE m.user_id = $1
+		 ORDER BY h.start_date DESC, t.id`, userID)
+}
+
+// joinBlocker says why userID cannot be added to a team in a hackathon, or
+// returns "" if they can.
+func joinBlocker(ctx context.Context, tx pgx.Tx, hackathonID, userID int64) (string, error) {
+	var inTeam, applied bool
+	err := tx.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM team_members WHERE hackathon_id = $1 AND user_id = $2),
+		        EXISTS (SELECT 1 FROM hackathon_applications WHERE hackathon_id = $1 AND user_id = $2 AND team_id IS NULL)`,
+		hackathonID, userID).Scan(&inTeam, &applied)
+	switch {
+	case err != nil:
+		return "", err
+	case inTeam:
+		return "already in a team for this hackathon", nil
+	case applied:
+		return "withdraw your individual application to join a team", nil
+	}
+	return "", nil
+}
+
+// Create stores a team with its captain as the first member, who leaves the
+// "looking for team" pool.
+func (r *TeamRepository) Create(ctx context.Context, t *model.Team) (*model.Team, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	blocker, err := joinBlocker(ctx, tx, t.HackathonID, t.CaptainID)
+	if err != nil {
+		return nil, err
+	}
+	if blocker != "" {
+		return nil, errors.New(blocker)
+	}
+	var id int64
+	err = tx.QueryRow(ctx,
+		`INSERT INTO teams (hackathon_id, name, captain_id, max_size) VALUES ($1, $2, $3, $4) RETURNING id`,
+		t.HackathonID, t.Name, t.CaptainID, t.MaxSize).Scan(&id)
+	if err != nil {
+		if strings.Contains(err.Error(), "idx_teams_name") {
+			return nil, fmt.Errorf("a team called %q already exists", t.Name)
+		}
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO team_members (team_id, user_id, hackathon_id) VALUES ($1, $2, $3)`,
+		id, t.CaptainID, t.HackathonID); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx,
+		`DELETE FROM team_pool WHERE hackathon_id = $1 AND user_id = $2`, t.HackathonID, t.CaptainID); err != nil {
+		return nil, err
+	}
+	result, err := queryTeam(ctx, tx, `SELECT `+teamColumns+teamFrom+` WHERE t.id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	return result, tx.Commit(ctx)
+}
+
+// Join adds userID to the team with code and takes them out of the pool. It
+// returns nil if no team has that code. A team that has applied only grows
+// while the hackathon's participant cap has room, since every member holds a
+// place.
+func (r *TeamRepository) Join(ctx context.Context, code string, userID int64) (*model.Team, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Locking the hackathon row as well serialises joins with applications
+	// competing for the same places. It is locked before the team, as in Apply.
+	var teamID, hackathonID int64
+	var maxSize int
+	var maxParticipants *int
+	err = tx.QueryRow(ctx,
+		`SELECT h.id, h.max_participants FROM hackathons h
+		 WHERE h.id = (SELECT hackathon_id FROM teams WHERE invite_code = $1)
+		 FOR UPDATE`, code).Scan(&hackathonID, &maxParticipants)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = tx.QueryRow(ctx,
+		`SELECT id, max_size FROM teams WHERE invite_code = $1 FOR UPDATE`, code).Scan(&teamID, &maxSize)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	blocker, err := joinBlocker(ctx, tx, hackathonID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if blocker != "" {
+		return nil, errors.New(blocker)
+	}
+	var size int
+	var appStatus *string
+	err = tx.QueryRow(ctx,
+		`SELECT (SELECT COUNT(*) FROM team_members WHERE team_id = $1),
+		        (SELECT status FROM hackathon_applications WHERE team_id = $1)`, teamID).Scan(&size, &appStatus)
+	if err != nil {
+		return nil, err
+	}
+	if size >= maxSize {
+		return nil, fmt.Errorf("team is full")
+	}
+	if maxParticipants != nil && appStatus != nil && model.ApplicationHoldsPlace(*appStatus) {
+		taken, err := countPlaces(ctx, tx, hackathonID)
+		if err != nil {
+			return nil, err
+		}
+		if taken >= *maxParticipants {
+			return nil, fmt.Errorf("the hackathon is full")
+		}
+	}
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO team_members (team_id, user_id, hackathon_id) VALUES ($1, $2, $3)`,
+		teamID, userID, hackathonID); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx,
+		`DELETE FROM team_pool WHERE hackathon_id = $1 AND user_id = $2`, hackathonID, userID); err != nil {
+		return nil, err
+	}
+	result, err := queryTeam(ctx, tx, `SELECT `+teamColumns+teamFrom+` WHERE t.id = $1`, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return result, tx.Commit(ctx)
+}
+
+// Leave removes userID from a team. A captain cannot leave while others are
+// on the team; the last member leaving deletes the team and its application.
+// If the team's application held places under the participant cap, the
+// longest-waiting applicants move into them with its status, as in Withdraw;
+// they are returned as promoted. left is false if userID was not a member.
+func (r *TeamRepository) Leave(ctx context.Context, teamID, userID int64) (left bool, promoted []model.HackathonApplication, err error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var hackathonID int64
+	var maxParticipants *int
+	err = tx.QueryRow(ctx,
+		`SELECT h.id, h.max_participants FROM hackathons h
+		 WHERE h.id = (SELECT hackathon_id FROM teams WHERE id = $1)
+		 FOR UPDATE`, teamID).Scan(&hackathonID, &maxParticipants)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil, nil
+	}
+	if err != nil {
+		return false, nil, err
+	}
+
+	var captainID int64
+	var size int
+	var member bool
+	var appStatus *string
+	err = tx.QueryRow(ctx,
+		`SELECT captain_id,
+		        (SELECT COUNT(*) FROM team_members WHERE team_id = t.id),
+		        EXISTS (SELECT 1 FROM team_members WHERE team_id = t.id AND user_id = $2),
+		        (SELECT status FROM hackathon_applications WHERE team_id = t.id)
+		 FROM teams t WHERE t.id = $1
+		 FOR UPDATE`, teamID, userID).Scan(&captainID, &size, &member, &appStatus)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !member) {
+		return false, nil, nil
+	}
+	if err != nil {
+		return false, nil, err
+	}
+	switch {
+	case size == 1:
+		_, err = tx.Exec(ctx, `DELETE FROM teams WHERE id = $1`, teamID)
+	case captainID == userID:
+		return false, nil, fmt.Errorf("hand the captaincy to another member before leaving")
+	default:
+		_, err = tx.Exec(ctx, `DELETE FROM team_members WHERE team_id = $1 AND user_id = $2`, teamID, userID)
+	}
+	if err != nil {
+		return false, nil, err
+	}
+	if maxParticipants != nil && appStatus != nil && model.ApplicationHoldsPlace(*appStatus) {
+		promoted, err = promoteWaitlisted(ctx, tx, hackathonID, *maxParticipants, *appStatus)
+		if err != nil {
+			return false, nil, err
+		}
+	}
+	return true, promoted, tx.Commit(ctx)
+}
+
+// TransferCaptain hands a team from captainID to another member. The team's
+// application and its form answers move with it, since a team applies
+// through its captain. It returns nil if captainID does not captain the team
+// or newCaptainID is not a member.
+func (r *TeamRepository) TransferCaptain(ctx context.Context, teamID, captainID, newCaptainID int64) (*model.Team, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	tag, err := tx.Exec(ctx,
+		`UPDATE teams SET captain_id = $3
+		 WHERE id = $1 AND captain_id = $2
+		   AND EXISTS (SELECT 1 FROM team_members WHERE team_id = $1 AND user_id = $3)`,
+		teamID, captainID, newCaptainID)
+	if err != nil || tag.RowsAffected() == 0 {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE hackathon_applications SET user_id = $2 WHERE team_id = $1`, teamID, newCaptainID); err != nil {
+		return nil, err
+	}
+	// Form answers are keyed by applicant, so they follow the application to
+	// the new captain, replacing any left over from an earlier solo one.
+	if _, err := tx.Exec(ctx,
+		`DELETE FROM hackathon_form_answers f USING hackathon_applications a
+		 WHERE a.team_id = $1 AND f.hackathon_id = a.hackathon_id AND f.user_id = $2`,
+		teamID, newCaptainID); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Exec(ctx,
+		`UPDATE hackathon_form_answers f SET user_id = $3 FROM hackathon_applications a
+		 WHERE a.team_id = $1 AND f.hackathon_id = a.hackathon_id AND f.user_id = $2`,
+		teamID, captainID, newCaptainID); err != nil {
+		return nil, err
+	}
+	result, err := queryTeam(ctx, tx, `SELECT `+teamColumns+teamFrom+` WHERE t.id = $1`, teamID)
+	if err != nil {
+		return nil, err
+	}
+	return result, tx.Commit(ctx)
+}
+
+const poolColumns = `p.hackathon_id, p.user_id, p.skills, p.roles, p.note, p.created_at,
+	EXISTS (SELECT 1 FROM hackathon_applications a WHERE a.hackathon_id = p.hackathon_id AND a.user_id = p.user_id AND a.team_id IS NULL),
+	u.id, u.telegram_id, u.username, u.first_name, u.last_name, u.photo_url, u.role, u.school_level, u.created_at, u.updated_at`
+
+func scanPoolEntry(row pgx.Row) (*model.PoolEntry, error) {
+	var e model.PoolEntry
+	var u model.User
+	err := row.Scan(&e.HackathonID, &e.UserID, &e.Skills, &e.Roles, &e.Note, &e.CreatedAt, &e.Applied,
+		&u.ID, &u.TelegramID, &u.Username, &u.FirstName, &u.LastName, &u.PhotoURL, &u.Role, &u.SchoolLevel, &u.CreatedAt, &u.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	e.User = &u
+	return &e, nil
+}
+
+// ListPool returns a hackathon's "looking for team" pool, earliest first.
+func (r *TeamRepository) ListPool(ctx context.Context, hackathonID int64) ([]model.PoolEntry, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+poolColumns+` FROM team_pool p JOIN users u ON u.id = p.user_id
+		 WHERE p.hackathon_id = $1
+		 ORDER BY p.created_at, p.user_id`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.PoolEntry
+	for rows.Next() {
+		e, err := scanPoolEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *e)
+	}
+	return list, rows.Err()
+}
+
+// EnterPool adds a student to the pool or updates their entry. Students on a
+// team, or whose application was rejected, cannot enter.
+func (r *TeamRepository) EnterPool(ctx context.Context, e *model.PoolEntry) (*model.PoolEntry, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var inTeam, rejected bool
+	err = tx.QueryRow(ctx,
+		`SELECT EXISTS (SELECT 1 FROM team_members WHERE hackathon_id = $1 AND user_id = $2),
+		        EXISTS (SELECT 1 FROM hackathon_applications WHERE hackathon_id = $1 AND user_id = $2 AND status = 'rejected')`,
+		e.HackathonID, e.UserID).Scan(&inTeam, &rejected)
+	switch {
+	case err != nil:
+		return nil, err
+	case inTeam:
+		return nil, fmt.Errorf("already in a team for this hackathon")
+	case rejected:
+		return nil, fmt.Errorf("your application to this hackathon was rejected")
+	}
+	if _, err := tx.Exec(ctx,
+		`INSERT INTO team_pool (hackathon_id, user_id, skills, roles, note) VALUES ($1, $2, $3, $4, $5)
+		 ON CONFLICT (hackathon_id, user_id) DO UPDATE
+		 SET skills = EXCLUDED.skills, roles = EXCLUDED.roles, note = EXCLUDED.note, updated_at = NOW()`,
+		e.HackathonID, e.UserID, e.Skills, e.Roles, e.Note); err != nil {
+		return nil, err
+	}
+	result, err := scanPoolEntry(tx.QueryRow(ctx,
+		`SELECT `+poolColumns+` FROM team_pool p JOIN users u ON u.id = p.user_id
+		 WHERE p.hackathon_id = $1 AND p.user_id = $2`, e.HackathonID, e.UserID))
+	if err != nil {
+		return nil, err
+	}
+	return result, tx.Commit(ctx)
+}
+
+// LeavePool removes a student from the pool. It returns false if they were not in it.
+func (r *TeamRepository) LeavePool(ctx context.Context, hackathonID, userID int64) (bool, error) {
+	tag, err := r.pool.Exec(ctx,
+		`DELETE FROM team_pool WHERE hackathon_id = $1 AND user_id = $2`, hackathonID, userID)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// FormTeams turns groups of pool members into teams of up to maxSize, each
+// captained by its first member, in one transaction. Members who left the
+// pool in the meantime, or who have applied on their own since, are dropped,
+// and groups left with fewer than two people are not formed; those people stay
+// in the pool. Applications are
+// never touched: the new teams apply through their captains like any other.
+func (r *TeamRepository) FormTeams(ctx context.Context, hackathonID int64, groups [][]int64, maxSize int) ([]model.Team, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Same lock as applications and joins, so nobody applies while matched.
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM hackathons WHERE id = $1 FOR UPDATE`, hackathonID); err != nil {
+		return nil, err
+	}
+	taken := map[string]bool{}
+	rows, err := tx.Query(ctx, `SELECT lower(name) FROM teams WHERE hackathon_id = $1`, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		taken[name] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	next := 1
+	for _, group := range groups {
+		rows, err := tx.Query(ctx,
+			`SELECT p.user_id FROM team_pool p
+			 WHERE p.hackathon_id = $1 AND p.user_id = ANY($2)
+			   AND NOT EXISTS (SELECT 1 FROM hackathon_applications a WHERE a.hackathon_id = $1 AND a.user_id = p.user_id)
+			 FOR UPDATE OF p`, hackathonID, group)
+		if err != nil {
+			return nil, err
+		}
+		left, err := scanIDs(rows)
+		if err != nil {
+			return nil, err
+		}
+		inPool := make(map[int64]bool, len(left))
+		for _, id := range left {
+			inPool[id] = true
+		}
+		var members []int64
+		for _, id := range group {
+			if inPool[id] {
+				members = append(members, id)
+			}
+		}
+		if len(members) < 2 {
+			continue
+		}
+		// Only members of a team actually formed leave the pool.
+		if _, err := tx.Exec(ctx,
+			`DELETE FROM team_pool WHERE hackathon_id = $1 AND user_id = ANY($2)`, hackathonID, members); err != nil {
+			return nil, err
+		}
+
+		name := fmt.Sprintf("Team %d", next)
+		for taken[strings.ToLower(name)] {
+			next++
+			name = fmt.Sprintf("Team %d", next)
+		}
+		taken[strings.ToLower(name)] = true
+		var teamID int64
+		if err := tx.QueryRow(ctx,
+			`INSERT INTO teams (hackathon_id, name, captain_id, max_size) VALUES ($1, $2, $3, $4) RETURNING id`,
+			hackathonID, name, members[0], max(maxSize, len(members))).Scan(&teamID); err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx,
+			`INSERT INTO team_members (team_id, user_id, hackathon_id) SELECT $1, unnest($2::bigint[]), $3`,
+			teamID, members, hackathonID); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, teamID)
+	}
+	if len(ids) == 0 {
+		return nil, tx.Commit(ctx)
+	}
+	list, err := queryTeams(ctx, tx, `SELECT `+teamColumns+teamFrom+` WHERE t.id = ANY($1) ORDER BY t.id`, ids)
+	if err != nil {
+		return nil, err
+	}
+	return list, tx.Commit(ctx)
+}
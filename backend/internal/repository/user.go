@@ -9,7 +9,7 @@ import (
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
 )
 
-const userColumns = `id, telegram_id, username, first_name, last_name, photo_url, role, school_login, school_level, school_xp, audit_ratio, coins, created_at, updated_at`
+const userColumns = `id, telegram_id, username, first_name, last_name, photo_url, role, school_login, school_level, school_xp, audit_ratio, coins, community_xp, community_level, created_at, updated_at`
 
 type UserRepository struct {
 	pool *pgxpool.Pool
@@ -22,7 +22,7 @@ func NewUserRepository(pool *pgxpool.Pool) *UserRepository {
 func scanUser(row pgx.Row) (*model.User, error) {
 	var u model.User
 	err := row.Scan(&u.ID, &u.TelegramID, &u.Username, &u.FirstName, &u.LastName, &u.PhotoURL, &u.Role,
-		&u.SchoolLogin, &u.SchoolLevel, &u.SchoolXP, &u.AuditRatio, &u.Coins, &u.CreatedAt, &u.UpdatedAt)
+		&u.SchoolLogin, &u.SchoolLevel, &u.SchoolXP, &u.AuditRatio, &u.Coins, &u.CommunityXP, &u.CommunityLevel, &u.CreatedAt, &u.UpdatedAt)
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, nil
 	}
@@ -44,6 +44,37 @@ func (r *UserRepository) FindByID(ctx context.Context, id int64) (*model.User, e
 	))
 }
 
+// FindByUsername looks a user up by Telegram username, ignoring case and a leading @.
+func (r *UserRepository) FindByUsername(ctx context.Context, username string) (*model.User, error) {
+	return scanUser(r.pool.QueryRow(ctx,
+		`SELECT `+userColumns+` FROM users WHERE username <> '' AND LOWER(username) = LOWER(LTRIM($1, '@'))`, username,
+	))
+}
+
+// FindByHandle returns every user whose Telegram username or school login
+// matches handle (case-insensitive, leading @ ignored). More than one result
+// means the handle is ambiguous.
+func (r *UserRepository) FindByHandle(ctx context.Context, handle string) ([]model.User, error) {
+	rows, err := r.pool.Query(ctx,
+		`SELECT `+userColumns+` FROM users
+		 WHERE (username <> '' AND LOWER(username) = LOWER(LTRIM($1, '@')))
+		    OR (school_login <> '' AND LOWER(school_login) = LOWER($1))
+		 ORDER BY id`, handle)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var list []model.User
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, *u)
+	}
+	return list, rows.Err()
+}
+
 func (r *UserRepository) Upsert(ctx context.Context, u *model.User) (*model.User, error) {
 	return scanUser(r.pool.QueryRow(ctx,
 		`INSERT INTO users (telegram_id, username, first_name, last_name, photo_url, role)
@@ -82,40 +113,6 @@ func (r *UserRepository) PromoteToAdmin(ctx context.Context, userID int64) (*mod
 	))
 }
 
-func (r *UserRepository) GetLeaderboard(ctx context.Context, limit int) ([]LeaderboardEntry, error) {
-	rows, err := r.pool.Query(ctx,
-		`SELECT id, first_name, last_name, username, photo_url, coins, school_level
-		 FROM users ORDER BY coins DESC LIMIT $1`, limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var entries []LeaderboardEntry
-	rank := 1
-	for rows.Next() {
-		var e LeaderboardEntry
-		if err := rows.Scan(&e.UserID, &e.FirstName, &e.LastName, &e.Username, &e.PhotoURL, &e.Coins, &e.SchoolLevel); err != nil {
-			return nil, err
-		}
-		e.Rank = rank
-		rank++
-		entries = append(entries, e)
-	}
-	return entries, nil
-}
-
-// LeaderboardEntry is a flat struct for leaderboard queries.
-type LeaderboardEntry struct {
-	Rank        int
-	UserID      int64
-	FirstName   string
-	LastName    string
-	Username    string
-	PhotoURL    string
-	Coins       int
-	SchoolLevel int
-}
-
 func (r *UserRepository) ListAllTelegramIDs(ctx context.Context) ([]int64, error) {
 	rows, err := r.pool.Query(ctx, `SELECT telegram_id FROM users`)
 	if err != nil {
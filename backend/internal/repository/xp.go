@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+type XPRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewXPRepository(pool *pgxpool.Pool) *XPRepository {
+	return &XPRepository{pool: pool}
+}
+
+// communityLevelSQL computes the level for the XP in expr from thresholds bound as $n.
+func communityLevelSQL(expr, thresholds string) string {
+	return `1 + (SELECT COUNT(*) FROM unnest(` + thresholds + `::bigint[]) t WHERE t <= ` + expr + `)`
+}
+
+// Grant adds amount XP to userID for the event source/refID and updates their
+// level. A grant for the same event is applied only once; granted reports
+// whether this call applied it, and xp is the user's new total.
+func (r *XPRepository) Grant(ctx context.Context, userID int64, source string, refID int64, amount int, levels model.CommunityLevels) (xp int64, granted bool, err error) {
+	err = r.pool.QueryRow(ctx,
+		`WITH ins AS (
+		     INSERT INTO community_xp_events (user_id, source, ref_id, amount)
+		     VALUES ($1, $2, $3, $4)
+		     ON CONFLICT (user_id, source, ref_id) DO NOTHING
+		     RETURNING user_id, amount
+		 )
+		 UPDATE users u
+		 SET community_xp = u.community_xp + ins.amount,
+		     community_level = `+communityLevelSQL("u.community_xp + ins.amount", "$5")+`,
+		     updated_at = NOW()
+		 FROM ins WHERE u.id = ins.user_id
+		 RETURNING u.community_xp`,
+		userID, source, refID, amount, []int64(levels),
+	).Scan(&xp)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return xp, true, nil
+}
+
+// SyncLevels recomputes every user's level, e.g. after the thresholds changed.
+func (r *XPRepository) SyncLevels(ctx context.Context, levels model.CommunityLevels) error {
+	_, err := r.pool.Exec(ctx,
+		`UPDATE users SET community_level = `+communityLevelSQL("community_xp", "$1")+`
+		 WHERE community_level <> `+communityLevelSQL("community_xp", "$1"),
+		[]int64(levels))
+	return err
+}
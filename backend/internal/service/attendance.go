@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -11,26 +12,90 @@ import (
 
 type AttendanceService struct {
 	attendanceRepo *repository.AttendanceRepository
+	awardRepo      *repository.AwardRepository
+	limits         model.AwardLimits
+	events         *EventBus
+	timezone       string // campus timezone for weekly streaks
+	multipliers    model.StreakMultipliers
 }
 
-func NewAttendanceService(attendanceRepo *repository.AttendanceRepository) *AttendanceService {
-	return &AttendanceService{attendanceRepo: attendanceRepo}
+func NewAttendanceService(attendanceRepo *repository.AttendanceRepository, awardRepo *repository.AwardRepository, limits model.AwardLimits, events *EventBus, timezone string, multipliers model.StreakMultipliers) *AttendanceService {
+	return &AttendanceService{
+		attendanceRepo: attendanceRepo,
+		awardRepo:      awardRepo,
+		limits:         limits,
+		events:         events,
+		timezone:       timezone,
+		multipliers:    multipliers,
+	}
 }
 
-func (s *AttendanceService) CheckIn(ctx context.Context, userID int64, eventName string, coins int) (*model.Attendance, error) {
-	a := &model.Attendance{
-		UserID:       userID,
-		EventName:    eventName,
-		CoinsAwarded: coins,
-	}
-	result, err := s.attendanceRepo.CheckIn(ctx, a)
+// CheckIn records a check-in by adminID. Awards above the approval threshold
+// are not applied; a pending award request is returned instead. The threshold
+// and single-award limit apply to coins as entered; the daily cap counts the
+// coins credited, streak multiplier included.
+func (s *AttendanceService) CheckIn(ctx context.Context, adminID, userID int64, eventName string, coins int) (*model.Attendance, *model.CoinAwardRequest, error) {
+	needsApproval, err := s.limits.Check(coins)
 	if err != nil {
-		if strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "unique") {
-			return nil, fmt.Errorf("already checked in for this event today")
+		return nil, nil, err
+	}
+	if needsApproval {
+		req, err := s.awardRepo.CreateRequest(ctx, &model.CoinAwardRequest{
+			Kind:        model.LedgerAttendance,
+			RequestedBy: adminID,
+			UserID:      userID,
+			Amount:      coins,
+			Reason:      eventName,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create award request: %w", err)
 		}
-		return nil, fmt.Errorf("failed to check in: %w", err)
+		return nil, req, nil
+	}
+
+	a := &model.Attendance{UserID: userID, EventName: eventName, BaseCoins: coins, AwardedBy: &adminID}
+	result, err := s.attendanceRepo.CheckIn(ctx, a, s.timezone, s.multipliers, s.limits.DailyCap)
+	if err != nil {
+		return nil, nil, checkInError(err)
+	}
+	s.events.Publish(model.EventCheckedIn, userID, result.ID)
+	return result, nil, nil
+}
+
+// approve approves an attendance award request and records the check-in in
+// the same transaction. It returns nil if reviewerID cannot approve it.
+func (s *AttendanceService) approve(ctx context.Context, reviewerID int64, req *model.CoinAwardRequest) (*model.CoinAwardRequest, error) {
+	a := &model.Attendance{UserID: req.UserID, EventName: req.Reason, BaseCoins: req.Amount, AwardedBy: &req.RequestedBy}
+	approved, result, err := s.awardRepo.ApproveRequest(ctx, req.ID, reviewerID, a, s.timezone, s.multipliers, s.limits.DailyCap)
+	if err != nil {
+		return nil, checkInError(err)
+	}
+	if approved != nil {
+		s.events.Publish(model.EventCheckedIn, result.UserID, result.ID)
+	}
+	return approved, nil
+}
+
+func checkInError(err error) error {
+	switch {
+	case strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "unique"):
+		return fmt.Errorf("already checked in for this event today")
+	case errors.Is(err, repository.ErrAwardCapReached):
+		return err
+	default:
+		return fmt.Errorf("failed to check in: %w", err)
+	}
+}
+
+// Streak returns userID's weekly attendance streak and the multiplier their
+// next check-in would get.
+func (s *AttendanceService) Streak(ctx context.Context, userID int64) (*model.Streak, error) {
+	streak, err := s.attendanceRepo.Streak(ctx, userID, s.timezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get streak: %w", err)
 	}
-	return result, nil
+	streak.NextMultiplier = s.multipliers.For(streak.NextWeeks())
+	return streak, nil
 }
 
 func (s *AttendanceService) History(ctx context.Context, userID int64) ([]model.Attendance, error) {
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 	"time"
 
 	initdata "github.com/telegram-mini-apps/init-data-golang"
@@ -11,14 +12,16 @@ import (
 )
 
 type AuthService struct {
-	botToken string
-	userRepo *repository.UserRepository
+	botToken        string
+	userRepo        *repository.UserRepository
+	referralService *ReferralService
 }
 
-func NewAuthService(botToken string, userRepo *repository.UserRepository) *AuthService {
+func NewAuthService(botToken string, userRepo *repository.UserRepository, referralService *ReferralService) *AuthService {
 	return &AuthService{
-		botToken: botToken,
-		userRepo: userRepo,
+		botToken:        botToken,
+		userRepo:        userRepo,
+		referralService: referralService,
 	}
 }
 
@@ -62,5 +65,12 @@ func (s *AuthService) AuthenticateWithInitData(ctx context.Context, rawInitData
 		return nil, fmt.Errorf("failed to upsert user: %w", err)
 	}
 
+	// New users may have opened the app through a referral link
+	if existing == nil && parsed.StartParam != "" {
+		if err := s.referralService.Track(ctx, result.ID, parsed.StartParam); err != nil {
+			log.Printf("Failed to track referral for user %d: %v", result.ID, err)
+		}
+	}
+
 	return result, nil
 }
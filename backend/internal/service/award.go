@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+type AwardService struct {
+	awardRepo         *repository.AwardRepository
+	userRepo          *repository.UserRepository
+	attendanceService *AttendanceService
+	coinService       *CoinService
+	repeatCheckIns    int
+	spikeMinCoins     int
+	spikeFactor       int
+}
+
+func NewAwardService(awardRepo *repository.AwardRepository, userRepo *repository.UserRepository, attendanceService *AttendanceService, coinService *CoinService, repeatCheckIns, spikeMinCoins, spikeFactor int) *AwardService {
+	return &AwardService{
+		awardRepo:         awardRepo,
+		userRepo:          userRepo,
+		attendanceService: attendanceService,
+		coinService:       coinService,
+		repeatCheckIns:    repeatCheckIns,
+		spikeMinCoins:     spikeMinCoins,
+		spikeFactor:       spikeFactor,
+	}
+}
+
+func (s *AwardService) ListRequests(ctx context.Context, status string) ([]model.CoinAwardRequest, error) {
+	list, err := s.awardRepo.ListRequests(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list award requests: %w", err)
+	}
+	if list == nil {
+		list = []model.CoinAwardRequest{}
+	}
+	return list, nil
+}
+
+// Approve applies a pending award on behalf of the admin who requested it; the
+// coins still count towards that admin's daily cap. The request is approved and
+// the coins credited in one transaction, so a failed award leaves it pending.
+func (s *AwardService) Approve(ctx context.Context, reviewerID, requestID int64) (*model.CoinAwardRequest, error) {
+	req, err := s.awardRepo.GetRequest(ctx, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get award request: %w", err)
+	}
+	if req == nil {
+		return nil, fmt.Errorf("award request not found")
+	}
+
+	var approved *model.CoinAwardRequest
+	switch req.Kind {
+	case model.LedgerAttendance:
+		approved, err = s.attendanceService.approve(ctx, reviewerID, req)
+	default:
+		approved, _, err = s.awardRepo.ApproveRequest(ctx, req.ID, reviewerID, nil, "", nil, s.coinService.limits.DailyCap)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if approved == nil {
+		return nil, s.notReviewed(ctx, reviewerID, requestID)
+	}
+	return approved, nil
+}
+
+func (s *AwardService) Reject(ctx context.Context, reviewerID, requestID int64) (*model.CoinAwardRequest, error) {
+	req, err := s.awardRepo.ReviewRequest(ctx, requestID, reviewerID, model.AwardRequestRejected)
+	if err != nil {
+		return nil, fmt.Errorf("failed to review award request: %w", err)
+	}
+	if req == nil {
+		return nil, s.notReviewed(ctx, reviewerID, requestID)
+	}
+	return req, nil
+}
+
+// notReviewed explains why reviewerID could not review a request.
+func (s *AwardService) notReviewed(ctx context.Context, reviewerID, requestID int64) error {
+	req, err := s.awardRepo.GetRequest(ctx, requestID)
+	switch {
+	case err != nil:
+		return fmt.Errorf("failed to get award request: %w", err)
+	case req == nil:
+		return fmt.Errorf("award request not found")
+	case req.RequestedBy == reviewerID:
+		return fmt.Errorf("another admin has to review your award request")
+	default:
+		return fmt.Errorf("award request is already %s", req.Status)
+	}
+}
+
+// ─── Alerts ──────────────────────────────────────────────────────────────────
+
+func (s *AwardService) ListAlerts(ctx context.Context, includeResolved bool, limit int) ([]model.AdminAlert, error) {
+	list, err := s.awardRepo.ListAlerts(ctx, includeResolved, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list alerts: %w", err)
+	}
+	if list == nil {
+		list = []model.AdminAlert{}
+	}
+	return list, nil
+}
+
+func (s *AwardService) ResolveAlert(ctx context.Context, resolverID, alertID int64) (*model.AdminAlert, error) {
+	a, err := s.awardRepo.ResolveAlert(ctx, alertID, resolverID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve alert: %w", err)
+	}
+	return a, nil
+}
+
+// ScanAnomalies raises alerts for suspicious award patterns. Findings are
+// fingerprinted so a rerun does not raise the same alert twice: a repeated
+// check-in alert is raised again only when the count grows, a spike at most
+// once per admin per day.
+func (s *AwardService) ScanAnomalies(ctx context.Context) error {
+	if s.repeatCheckIns > 0 {
+		repeats, err := s.awardRepo.RepeatCheckIns(ctx, s.repeatCheckIns)
+		if err != nil {
+			return fmt.Errorf("failed to find repeated check-ins: %w", err)
+		}
+		for _, rc := range repeats {
+			admin, student := s.userName(ctx, rc.AdminID), s.userName(ctx, rc.UserID)
+			_, err := s.awardRepo.RaiseAlert(ctx, &model.AdminAlert{
+				Kind:        model.AlertRepeatCheckIn,
+				Message:     fmt.Sprintf("%s checked in %s %d times in 7 days (%d coins)", admin, student, rc.Count, rc.Coins),
+				AdminID:     &rc.AdminID,
+				UserID:      &rc.UserID,
+				Fingerprint: fmt.Sprintf("%s:%d:%d:%d", model.AlertRepeatCheckIn, rc.AdminID, rc.UserID, rc.Count),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to raise alert: %w", err)
+			}
+		}
+	}
+
+	if s.spikeFactor > 0 {
+		spikes, err := s.awardRepo.AwardSpikes(ctx, s.spikeMinCoins, float64(s.spikeFactor))
+		if err != nil {
+			return fmt.Errorf("failed to find award spikes: %w", err)
+		}
+		day := time.Now().UTC().Format(time.DateOnly)
+		for _, sp := range spikes {
+			_, err := s.awardRepo.RaiseAlert(ctx, &model.AdminAlert{
+				Kind: model.AlertAwardSpike,
+				Message: fmt.Sprintf("%s awarded %d coins in 24 hours (usual daily average %.0f)",
+					s.userName(ctx, sp.AdminID), sp.Awarded, sp.DailyAverage),
+				AdminID:     &sp.AdminID,
+				Fingerprint: fmt.Sprintf("%s:%d:%s", model.AlertAwardSpike, sp.AdminID, day),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to raise alert: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *AwardService) userName(ctx context.Context, id int64) string {
+	u, err := s.userRepo.FindByID(ctx, id)
+	if err != nil || u == nil {
+		return fmt.Sprintf("user #%d", id)
+	}
+	return displayName(u)
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"slices"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+// badgeDefinitions are the automatically awarded badges. Codes are stable
+// identifiers; names, descriptions and icons can change freely.
+var badgeDefinitions = []model.BadgeDefinition{
+	{
+		Code: "first-check-in", Name: "First Steps", Icon: "👣",
+		Description: "Checked in to a first event",
+		Events:      []string{model.EventCheckedIn}, Counter: model.CounterCheckIns, Threshold: 1,
+	},
+	{
+		Code: "events-10", Name: "Regular", Icon: "📅",
+		Description: "Attended 10 events",
+		Events:      []string{model.EventCheckedIn}, Counter: model.CounterCheckIns, Threshold: 10, BonusCoins: 20,
+	},
+	{
+		Code: "events-50", Name: "Pillar of the Community", Icon: "🏛️",
+		Description: "Attended 50 events",
+		Events:      []string{model.EventCheckedIn}, Counter: model.CounterCheckIns, Threshold: 50, BonusCoins: 100,
+	},
+	{
+		Code: "clubs-3", Name: "Social Butterfly", Icon: "🦋",
+		Description: "Joined 3 clubs",
+		Events:      []string{model.EventClubJoined}, Counter: model.CounterClubsJoined, Threshold: 3, BonusCoins: 10,
+	},
+	{
+		Code: "first-hackathon", Name: "Hacker", Icon: "💻",
+		Description: "Applied to a first hackathon",
+		Events:      []string{model.EventHackathonApplied}, Counter: model.CounterHackathonApplications, Threshold: 1,
+	},
+	{
+		Code: "hackathon-finalist", Name: "Hackathon Finalist", Icon: "🚀",
+		Description: "Reached the final of a hackathon",
+		Events:      []string{model.EventHackathonResult}, Counter: model.CounterHackathonFinals, Threshold: 1, BonusCoins: 50,
+	},
+	{
+		Code: "first-purchase", Name: "First Purchase", Icon: "🛍️",
+		Description: "Bought something in the shop",
+		Events:      []string{model.EventPurchased}, Counter: model.CounterPurchases, Threshold: 1,
+	},
+	{
+		Code: "quests-5", Name: "Adventurer", Icon: "🗺️",
+		Description: "Completed 5 quests",
+		Events:      []string{model.EventQuestCompleted}, Counter: model.CounterQuestsCompleted, Threshold: 5, BonusCoins: 25,
+	},
+}
+
+type BadgeService struct {
+	badgeRepo   *repository.BadgeRepository
+	userRepo    *repository.UserRepository
+	telegramGW  *gateway.TelegramGateway
+	definitions []model.BadgeDefinition
+}
+
+func NewBadgeService(badgeRepo *repository.BadgeRepository, userRepo *repository.UserRepository, telegramGW *gateway.TelegramGateway) *BadgeService {
+	return &BadgeService{badgeRepo: badgeRepo, userRepo: userRepo, telegramGW: telegramGW, definitions: badgeDefinitions}
+}
+
+// Sync stores the badge definitions so they can be awarded and listed.
+func (s *BadgeService) Sync(ctx context.Context) error {
+	if err := s.badgeRepo.Sync(ctx, s.definitions); err != nil {
+		return fmt.Errorf("failed to sync badges: %w", err)
+	}
+	return nil
+}
+
+// HandleEvent evaluates every definition triggered by e for the event's user.
+func (s *BadgeService) HandleEvent(ctx context.Context, e model.DomainEvent) error {
+	counts := make(map[string]int)
+	for _, d := range s.definitions {
+		if !slices.Contains(d.Events, e.Kind) {
+			continue
+		}
+		n, ok := counts[d.Counter]
+		if !ok {
+			var err error
+			if n, err = s.badgeRepo.Count(ctx, d.Counter, e.UserID); err != nil {
+				return fmt.Errorf("failed to count %s: %w", d.Counter, err)
+			}
+			counts[d.Counter] = n
+		}
+		if n < d.Threshold {
+			continue
+		}
+
+		badge, err := s.badgeRepo.Award(ctx, e.UserID, d.Code, d.BonusCoins)
+		if err != nil {
+			return fmt.Errorf("failed to award badge %s: %w", d.Code, err)
+		}
+		if badge != nil {
+			s.notifyBadge(ctx, e.UserID, badge, d.BonusCoins)
+		}
+	}
+	return nil
+}
+
+func (s *BadgeService) notifyBadge(ctx context.Context, userID int64, b *model.Badge, bonus int) {
+	u, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil || u == nil {
+		return
+	}
+	msg := fmt.Sprintf("%s <b>New badge: %s</b>\n\n%s", b.Icon, html.EscapeString(b.Name), html.EscapeString(b.Description))
+	if bonus > 0 {
+		msg += fmt.Sprintf("\n\n+%d coins", bonus)
+	}
+	go func() {
+		if err := s.telegramGW.SendMessage(u.TelegramID, msg); err != nil {
+			log.Printf("Failed to notify user %d about badge %s: %v", u.ID, b.Code, err)
+		}
+	}()
+}
+
+func (s *BadgeService) ListForUser(ctx context.Context, userID int64) ([]model.Badge, error) {
+	list, err := s.badgeRepo.ListForUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list badges: %w", err)
+	}
+	if list == nil {
+		list = []model.Badge{}
+	}
+	return list, nil
+}
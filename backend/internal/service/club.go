@@ -10,10 +10,11 @@ import (
 
 type ClubService struct {
 	clubRepo *repository.ClubRepository
+	events   *EventBus
 }
 
-func NewClubService(clubRepo *repository.ClubRepository) *ClubService {
-	return &ClubService{clubRepo: clubRepo}
+func NewClubService(clubRepo *repository.ClubRepository, events *EventBus) *ClubService {
+	return &ClubService{clubRepo: clubRepo, events: events}
 }
 
 func (s *ClubService) List(ctx context.Context, userID *int64) ([]model.Club, error) {
@@ -43,6 +44,7 @@ func (s *ClubService) Join(ctx context.Context, clubID, userID int64) error {
 	if err := s.clubRepo.Join(ctx, clubID, userID); err != nil {
 		return fmt.Errorf("failed to join club: %w", err)
 	}
+	s.events.Publish(model.EventClubJoined, userID, clubID)
 	return nil
 }
 
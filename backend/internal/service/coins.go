@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+const (
+	maxTransferNoteLen = 200
+	maxBulkAwardRows   = 1000
+)
+
+type CoinService struct {
+	coinRepo     *repository.CoinRepository
+	userRepo     *repository.UserRepository
+	awardRepo    *repository.AwardRepository
+	telegramGW   *gateway.TelegramGateway
+	sendLimit    int
+	receiveLimit int
+	limits       model.AwardLimits
+}
+
+func NewCoinService(coinRepo *repository.CoinRepository, userRepo *repository.UserRepository, awardRepo *repository.AwardRepository, telegramGW *gateway.TelegramGateway, sendLimit, receiveLimit int, limits model.AwardLimits) *CoinService {
+	return &CoinService{
+		coinRepo:     coinRepo,
+		userRepo:     userRepo,
+		awardRepo:    awardRepo,
+		telegramGW:   telegramGW,
+		sendLimit:    sendLimit,
+		receiveLimit: receiveLimit,
+		limits:       limits,
+	}
+}
+
+// Transfer sends coins to a recipient identified by id or, if recipientID is nil, by username.
+func (s *CoinService) Transfer(ctx context.Context, senderID int64, recipientID *int64, recipientUsername string, amount int, note string) (*model.Transfer, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	note = strings.TrimSpace(note)
+	if len(note) > maxTransferNoteLen {
+		return nil, fmt.Errorf("note must be at most %d characters", maxTransferNoteLen)
+	}
+
+	if recipientID == nil {
+		if strings.TrimSpace(recipientUsername) == "" {
+			return nil, fmt.Errorf("recipient is required")
+		}
+		recipient, err := s.userRepo.FindByUsername(ctx, strings.TrimSpace(recipientUsername))
+		if err != nil {
+			return nil, fmt.Errorf("failed to find recipient: %w", err)
+		}
+		if recipient == nil {
+			return nil, fmt.Errorf("recipient not found")
+		}
+		recipientID = &recipient.ID
+	}
+	if *recipientID == senderID {
+		return nil, fmt.Errorf("cannot send coins to yourself")
+	}
+
+	t, err := s.coinRepo.Transfer(ctx, senderID, *recipientID, amount, note, s.sendLimit, s.receiveLimit)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyTransfer(t)
+	return t, nil
+}
+
+func (s *CoinService) notifyTransfer(t *model.Transfer) {
+	noteLine := ""
+	if t.Note != "" {
+		noteLine = "\n\n<i>" + html.EscapeString(t.Note) + "</i>"
+	}
+	toSender := fmt.Sprintf("💸 You sent <b>%d coins</b> to %s.%s", t.Amount, html.EscapeString(displayName(&t.Recipient)), noteLine)
+	toRecipient := fmt.Sprintf("🪙 %s sent you <b>%d coins</b>!%s", html.EscapeString(displayName(&t.Sender)), t.Amount, noteLine)
+	go func() {
+		if err := s.telegramGW.SendMessage(t.Sender.TelegramID, toSender); err != nil {
+			log.Printf("Failed to notify transfer sender %d: %v", t.Sender.ID, err)
+		}
+		if err := s.telegramGW.SendMessage(t.Recipient.TelegramID, toRecipient); err != nil {
+			log.Printf("Failed to notify transfer recipient %d: %v", t.Recipient.ID, err)
+		}
+	}()
+}
+
+func (s *CoinService) History(ctx context.Context, userID int64, limit int) ([]model.LedgerEntry, error) {
+	list, err := s.coinRepo.ListLedger(ctx, userID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list coin history: %w", err)
+	}
+	if list == nil {
+		list = []model.LedgerEntry{}
+	}
+	return list, nil
+}
+
+// displayName is how a user is addressed in notifications.
+func displayName(u *model.User) string {
+	if u.Username != "" {
+		return "@" + u.Username
+	}
+	return strings.TrimSpace(u.FirstName + " " + u.LastName)
+}
+
+// Adjust credits (positive amount) or debits (negative amount) a user's coins
+// on behalf of an admin. Credits above the approval threshold are not applied;
+// a pending award request is returned instead.
+func (s *CoinService) Adjust(ctx context.Context, actorID, userID int64, amount int, reason string) (*model.LedgerEntry, *model.CoinAwardRequest, error) {
+	adj := model.CoinAdjustment{UserID: userID, Amount: amount, Reason: strings.TrimSpace(reason)}
+	if err := validateAdjustment(adj); err != nil {
+		return nil, nil, err
+	}
+	needsApproval, err := s.limits.Check(adj.Amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	if needsApproval {
+		req, err := s.awardRepo.CreateRequest(ctx, &model.CoinAwardRequest{
+			Kind:        model.LedgerAdjustment,
+			RequestedBy: actorID,
+			UserID:      adj.UserID,
+			Amount:      adj.Amount,
+			Reason:      adj.Reason,
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create award request: %w", err)
+		}
+		return nil, req, nil
+	}
+
+	entry, err := s.applyAdjustment(ctx, actorID, adj)
+	return entry, nil, err
+}
+
+// applyAdjustment applies adj as actorID; credits count towards that admin's daily cap.
+func (s *CoinService) applyAdjustment(ctx context.Context, actorID int64, adj model.CoinAdjustment) (*model.LedgerEntry, error) {
+	entries, err := s.coinRepo.Adjust(ctx, actorID, []model.CoinAdjustment{adj}, s.limits.DailyCap)
+	if err != nil {
+		return nil, err
+	}
+	return &entries[0], nil
+}
+
+func validateAdjustment(adj model.CoinAdjustment) error {
+	if adj.Amount == 0 {
+		return fmt.Errorf("amount must not be zero")
+	}
+	if adj.Reason == "" {
+		return fmt.Errorf("reason is required")
+	}
+	return nil
+}
+
+// BulkAward parses a CSV of "handle,amount,reason" lines, where handle is a
+// Telegram username or school login. Without commit it only returns the
+// preview; with commit it applies every row in one transaction, and only if
+// all rows are valid. Rows above the approval threshold are invalid; such
+// awards have to go through Adjust one by one.
+func (s *CoinService) BulkAward(ctx context.Context, actorID int64, data io.Reader, commit bool) (*model.BulkAwardResult, error) {
+	rows, err := parseAwardCSV(data)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.BulkAwardResult{Rows: rows, Valid: true}
+	for i := range rows {
+		row := &rows[i]
+		if row.Error == "" {
+			if needsApproval, err := s.limits.Check(row.Amount); err != nil {
+				row.Error = err.Error()
+			} else if needsApproval {
+				row.Error = fmt.Sprintf("awards above %d coins need approval; submit this one individually", s.limits.ApprovalThreshold)
+			}
+		}
+		if row.Error == "" {
+			s.resolveAwardRow(ctx, row)
+		}
+		if row.Error != "" {
+			result.Valid = false
+			continue
+		}
+		result.TotalAmount += row.Amount
+	}
+	if !commit || !result.Valid {
+		return result, nil
+	}
+
+	adjustments := make([]model.CoinAdjustment, len(rows))
+	for i, row := range rows {
+		adjustments[i] = model.CoinAdjustment{UserID: row.User.ID, Amount: row.Amount, Reason: row.Reason}
+	}
+	if _, err := s.coinRepo.Adjust(ctx, actorID, adjustments, s.limits.DailyCap); err != nil {
+		return nil, err
+	}
+	result.Committed = true
+	return result, nil
+}
+
+func (s *CoinService) resolveAwardRow(ctx context.Context, row *model.CoinAwardRow) {
+	users, err := s.userRepo.FindByHandle(ctx, row.Identifier)
+	switch {
+	case err != nil:
+		row.Error = "failed to look up user"
+	case len(users) == 0:
+		row.Error = "user not found"
+	case len(users) > 1:
+		row.Error = "matches several users"
+	default:
+		row.User = &users[0]
+		if row.User.Coins+row.Amount < 0 {
+			row.Error = fmt.Sprintf("user has only %d coins", row.User.Coins)
+		}
+	}
+}
+
+// parseAwardCSV reads award rows, skipping a header line and blank lines.
+// Per-row problems are reported on the row; only unreadable input is an error.
+func parseAwardCSV(data io.Reader) ([]model.CoinAwardRow, error) {
+	r := csv.NewReader(data)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	var rows []model.CoinAwardRow
+	for first := true; ; first = false {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		if len(record) == 1 && strings.TrimSpace(record[0]) == "" {
+			continue
+		}
+
+		line, _ := r.FieldPos(0)
+		row := model.CoinAwardRow{Line: line}
+		if len(record) != 3 {
+			row.Error = "expected 3 columns: handle, amount, reason"
+			rows = append(rows, row)
+			continue
+		}
+		row.Identifier = strings.TrimSpace(record[0])
+		row.Reason = strings.TrimSpace(record[2])
+		amount, err := strconv.Atoi(strings.TrimSpace(record[1]))
+		if err != nil {
+			if first {
+				continue // header
+			}
+			row.Error = "amount must be a whole number"
+		}
+		row.Amount = amount
+		if row.Error == "" && row.Identifier == "" {
+			row.Error = "handle is required"
+		}
+		if row.Error == "" {
+			if err := validateAdjustment(model.CoinAdjustment{Amount: row.Amount, Reason: row.Reason}); err != nil {
+				row.Error = err.Error()
+			}
+		}
+		rows = append(rows, row)
+		if len(rows) > maxBulkAwardRows {
+			return nil, fmt.Errorf("at most %d rows per upload", maxBulkAwardRows)
+		}
+	}
+	if len(rows) == 0 {
+		return nil, fmt.Errorf("CSV contains no rows")
+	}
+	return rows, nil
+}
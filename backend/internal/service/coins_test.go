@@ -0,0 +1,81 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+func TestParseAwardCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    []model.CoinAwardRow
+		wantErr string
+	}{
+		{
+			name:  "header and rows",
+			input: "handle,amount,reason\nalice,50,won the quiz\n@bob, -20 , late return\n",
+			want: []model.CoinAwardRow{
+				{Line: 2, Identifier: "alice", Amount: 50, Reason: "won the quiz"},
+				{Line: 3, Identifier: "@bob", Amount: -20, Reason: "late return"},
+			},
+		},
+		{
+			name:  "no header, blank lines skipped",
+			input: "alice,10,helped\n\n   \ncarol,5,helped\n",
+			want: []model.CoinAwardRow{
+				{Line: 1, Identifier: "alice", Amount: 10, Reason: "helped"},
+				{Line: 4, Identifier: "carol", Amount: 5, Reason: "helped"},
+			},
+		},
+		{
+			name:  "row problems are reported on the row",
+			input: "alice,1,ok\nbob,ten,typo\ncarol,5\n,5,no handle\ndave,0,nothing\nerin,5,\n",
+			want: []model.CoinAwardRow{
+				{Line: 1, Identifier: "alice", Amount: 1, Reason: "ok"},
+				{Line: 2, Identifier: "bob", Reason: "typo", Error: "amount must be a whole number"},
+				{Line: 3, Error: "expected 3 columns: handle, amount, reason"},
+				{Line: 4, Amount: 5, Reason: "no handle", Error: "handle is required"},
+				{Line: 5, Identifier: "dave", Reason: "nothing", Error: "amount must not be zero"},
+				{Line: 6, Identifier: "erin", Amount: 5, Error: "reason is required"},
+			},
+		},
+		{
+			name:  "a bad amount on the first line is a header",
+			input: "who,how much,why\nalice,5,ok\n",
+			want:  []model.CoinAwardRow{{Line: 2, Identifier: "alice", Amount: 5, Reason: "ok"}},
+		},
+		{name: "empty", input: "", wantErr: "CSV contains no rows"},
+		{name: "header only", input: "handle,amount,reason\n", wantErr: "CSV contains no rows"},
+		{name: "unreadable", input: "alice,\"5,oops\n", wantErr: "invalid CSV"},
+		{
+			name:    "too many rows",
+			input:   strings.Repeat("alice,1,bonus\n", maxBulkAwardRows+1),
+			wantErr: "at most 1000 rows per upload",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := parseAwardCSV(strings.NewReader(tt.input))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(rows) != len(tt.want) {
+				t.Fatalf("got %d rows, want %d: %+v", len(rows), len(tt.want), rows)
+			}
+			for i := range rows {
+				if rows[i] != tt.want[i] {
+					t.Errorf("row %d = %+v, want %+v", i, rows[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+const eventHandlerTimeout = 10 * time.Second
+
+// EventHandler reacts to a domain event.
+type EventHandler func(ctx context.Context, e model.DomainEvent) error
+
+// EventBus delivers domain events to subscribers in the background, so a
+// slow or failing subscriber never fails the request that caused the event.
+// A nil *EventBus drops events.
+type EventBus struct {
+	mu       sync.RWMutex
+	handlers []EventHandler
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{}
+}
+
+func (b *EventBus) Subscribe(h EventHandler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, h)
+}
+
+func (b *EventBus) Publish(kind string, userID, refID int64) {
+	if b == nil {
+		return
+	}
+	e := model.DomainEvent{Kind: kind, UserID: userID, RefID: refID, OccurredAt: time.Now()}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, h := range b.handlers {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), eventHandlerTimeout)
+			defer cancel()
+			if err := h(ctx, e); err != nil {
+				log.Printf("Failed to handle %s event for user %d: %v", e.Kind, e.UserID, err)
+			}
+		}()
+	}
+}
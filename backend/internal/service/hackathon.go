@@ -2,30 +2,57 @@ package service
 
 import (
 	"context"
+	"encoding/csv"
+	"errors"
 	"fmt"
+	"html"
+	"io"
+	"log"
+	"math"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
 )
 
 type HackathonService struct {
 	hackathonRepo *repository.HackathonRepository
+	teamRepo      *repository.TeamRepository
+	userRepo      *repository.UserRepository
+	telegramGW    *gateway.TelegramGateway
+	events        *EventBus
 }
 
-func NewHackathonService(hackathonRepo *repository.HackathonRepository) *HackathonService {
-	return &HackathonService{hackathonRepo: hackathonRepo}
+func NewHackathonService(hackathonRepo *repository.HackathonRepository, teamRepo *repository.TeamRepository, userRepo *repository.UserRepository, telegramGW *gateway.TelegramGateway, events *EventBus) *HackathonService {
+	return &HackathonService{hackathonRepo: hackathonRepo, teamRepo: teamRepo, userRepo: userRepo, telegramGW: telegramGW, events: events}
 }
 
-func (s *HackathonService) List(ctx context.Context, status string) ([]model.Hackathon, error) {
-	list, err := s.hackathonRepo.List(ctx, status)
+// List returns hackathons matching filter (active, past, a single status or
+// "" for all). Drafts are only included when includeDrafts is set.
+func (s *HackathonService) List(ctx context.Context, filter string, includeDrafts bool) ([]model.Hackathon, error) {
+	var statuses []string
+	if filter != "" {
+		var ok bool
+		if statuses, ok = model.HackathonStatusesFor(filter); !ok {
+			return nil, fmt.Errorf("unknown hackathon status %q", filter)
+		}
+	}
+	list, err := s.hackathonRepo.List(ctx, statuses)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list hackathons: %w", err)
 	}
-	if list == nil {
-		list = []model.Hackathon{}
+	result := []model.Hackathon{}
+	for _, h := range list {
+		if h.Status == model.HackathonDraft && !includeDrafts {
+			continue
+		}
+		result = append(result, h)
 	}
-	return list, nil
+	return result, nil
 }
 
 func (s *HackathonService) GetByID(ctx context.Context, id int64) (*model.Hackathon, error) {
@@ -36,7 +63,22 @@ func (s *HackathonService) GetByID(ctx context.Context, id int64) (*model.Hackat
 	return h, nil
 }
 
+// Create stores a new hackathon. Without an explicit status it starts as a
+// draft while registration_opens_at is in the future and open otherwise.
 func (s *HackathonService) Create(ctx context.Context, h *model.Hackathon) (*model.Hackathon, error) {
+	if err := validateHackathonDetails(h); err != nil {
+		return nil, err
+	}
+	switch h.Status {
+	case "":
+		h.Status = model.HackathonRegistrationOpen
+		if h.RegistrationOpensAt != nil && h.RegistrationOpensAt.After(time.Now()) {
+			h.Status = model.HackathonDraft
+		}
+	case model.HackathonDraft, model.HackathonRegistrationOpen:
+	default:
+		return nil, fmt.Errorf("a new hackathon must be a draft or open for registration")
+	}
 	result, err := s.hackathonRepo.Create(ctx, h)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create hackathon: %w", err)
@@ -44,6 +86,169 @@ func (s *HackathonService) Create(ctx context.Context, h *model.Hackathon) (*mod
 	return result, nil
 }
 
+// Update replaces the editable details of a hackathon. Dates that have
+// already taken effect in its lifecycle cannot be moved.
+func (s *HackathonService) Update(ctx context.Context, h *model.Hackathon) (*model.Hackathon, error) {
+	cur, err := s.hackathonRepo.GetByID(ctx, h.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if cur == nil {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	if err := validateHackathonDetails(h); err != nil {
+		return nil, err
+	}
+	if err := checkHackathonDateChanges(cur, h, time.Now()); err != nil {
+		return nil, err
+	}
+	h.Status = cur.Status
+	result, err := s.hackathonRepo.Update(ctx, h)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update hackathon: %w", err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("hackathon status changed concurrently, try again")
+	}
+	if capacityRaised(cur.MaxParticipants, result.MaxParticipants) && acceptsWithdrawals(result.Status) {
+		promoted, err := s.hackathonRepo.FillFromWaitlist(ctx, result.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to promote waitlisted applicants: %w", err)
+		}
+		for i := range promoted {
+			s.notifyPromoted(ctx, &promoted[i])
+		}
+		result.Participants += len(promoted)
+	}
+	return result, nil
+}
+
+// capacityRaised reports whether a participant cap went up. Removing the cap
+// does not promote anyone, since admins may waitlist by hand.
+func capacityRaised(before, after *int) bool {
+	return before != nil && after != nil && *after > *before
+}
+
+// acceptsWithdrawals reports whether places can still change hands, i.e. the
+// hackathon has not started.
+func acceptsWithdrawals(status string) bool {
+	return status == model.HackathonRegistrationOpen || status == model.HackathonRegistrationClosed
+}
+
+func validateHackathonDetails(h *model.Hackathon) error {
+	if h.MaxTeamSize != nil && *h.MaxTeamSize < 1 {
+		return fmt.Errorf("max_team_size must be at least 1")
+	}
+	if h.MaxParticipants != nil && *h.MaxParticipants < 1 {
+		return fmt.Errorf("max_participants must be at least 1")
+	}
+	return validateHackathonDates(h)
+}
+
+func validateHackathonDates(h *model.Hackathon) error {
+	if !h.EndDate.After(h.StartDate) {
+		return fmt.Errorf("end_date must be after start_date")
+	}
+	if h.RegistrationClosesAt != nil && h.RegistrationClosesAt.After(h.StartDate) {
+		return fmt.Errorf("registration must close before the hackathon starts")
+	}
+	if h.RegistrationOpensAt != nil && h.RegistrationClosesAt != nil && !h.RegistrationClosesAt.After(*h.RegistrationOpensAt) {
+		return fmt.Errorf("registration_closes_at must be after registration_opens_at")
+	}
+	if h.SubmissionDeadline != nil && (!h.SubmissionDeadline.After(h.StartDate) || h.SubmissionDeadline.After(h.EndDate)) {
+		return fmt.Errorf("submission_deadline must be after start_date and no later than end_date")
+	}
+	return nil
+}
+
+// checkHackathonDateChanges rejects moving dates the lifecycle has already
+// acted on: the registration opening once open, the registration and start
+// dates once running, the submission deadline once passed, and every date
+// from judging on. Dates that still lie ahead must stay in the future so the
+// scheduler does not skip a stage.
+func checkHackathonDateChanges(cur, next *model.Hackathon, now time.Time) error {
+	opensChanged := !sameTime(cur.RegistrationOpensAt, next.RegistrationOpensAt)
+	closesChanged := !sameTime(cur.RegistrationClosesAt, next.RegistrationClosesAt)
+	startChanged := !cur.StartDate.Equal(next.StartDate)
+	endChanged := !cur.EndDate.Equal(next.EndDate)
+	deadlineChanged := !cur.SubmissionsClose().Equal(next.SubmissionsClose())
+
+	switch cur.Status {
+	case model.HackathonDraft:
+	case model.HackathonRegistrationOpen, model.HackathonRegistrationClosed:
+		if opensChanged {
+			return fmt.Errorf("registration_opens_at cannot change once registration has opened")
+		}
+		if closesChanged && cur.Status == model.HackathonRegistrationOpen &&
+			next.RegistrationClosesAt != nil && !now.Before(*next.RegistrationClosesAt) {
+			return fmt.Errorf("registration_closes_at must be in the future")
+		}
+		if startChanged && !now.Before(next.StartDate) {
+			return fmt.Errorf("start_date must be in the future")
+		}
+	case model.HackathonRunning:
+		if opensChanged || closesChanged || startChanged {
+			return fmt.Errorf("only end_date can change while the hackathon is running")
+		}
+		if endChanged && !now.Before(next.EndDate) {
+			return fmt.Errorf("end_date must be in the future")
+		}
+		if deadlineChanged && (!now.Before(cur.SubmissionsClose()) || !now.Before(next.SubmissionsClose())) {
+			return fmt.Errorf("submissions have closed, the deadline cannot move")
+		}
+	default:
+		if opensChanged || closesChanged || startChanged || endChanged || deadlineChanged {
+			return fmt.Errorf("dates cannot change once the hackathon is %s", cur.Status)
+		}
+	}
+	return nil
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// Transition moves a hackathon to status if the lifecycle allows it.
+func (s *HackathonService) Transition(ctx context.Context, id int64, status string) (*model.Hackathon, error) {
+	h, err := s.hackathonRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	if !h.CanMoveTo(status) {
+		return nil, fmt.Errorf("cannot move hackathon from %s to %s", h.Status, status)
+	}
+	// The scheduler would close registration again straight away.
+	if status == model.HackathonRegistrationOpen && !h.RegistrationDeadlineAhead(time.Now()) {
+		return nil, fmt.Errorf("registration deadline has passed")
+	}
+	result, err := s.hackathonRepo.Transition(ctx, id, []string{h.Status}, status)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update hackathon status: %w", err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf("hackathon status changed concurrently, try again")
+	}
+	return result, nil
+}
+
+// Advance applies the date-driven lifecycle transitions. It is run by a background job.
+func (s *HackathonService) Advance(ctx context.Context) error {
+	moved, err := s.hackathonRepo.Advance(ctx)
+	for _, h := range moved {
+		log.Printf("Hackathon %d (%s) moved to %s", h.ID, h.Title, h.Status)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to advance hackathons: %w", err)
+	}
+	return nil
+}
+
 func (s *HackathonService) Delete(ctx context.Context, id int64) error {
 	if err := s.hackathonRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("failed to delete hackathon: %w", err)
@@ -51,22 +256,103 @@ func (s *HackathonService) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (s *HackathonService) Apply(ctx context.Context, hackathonID, userID int64, teamName string) (*model.HackathonApplication, error) {
+// Apply registers a student, or with teamID their whole team, for a
+// hackathon. Only the captain applies for a team, and every member must be
+// eligible. answers must fill in the hackathon's application form.
+func (s *HackathonService) Apply(ctx context.Context, hackathonID, userID int64, teamName string, teamID *int64, answers []model.FormAnswer) (*model.HackathonApplication, error) {
 	app := &model.HackathonApplication{
 		HackathonID: hackathonID,
 		UserID:      userID,
 		TeamName:    teamName,
+		TeamID:      teamID,
+	}
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil || h.Status == model.HackathonDraft {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	if !h.RegistrationOpen(time.Now()) {
+		return nil, fmt.Errorf("registration is not open")
+	}
+	members := []int64{userID}
+	if teamID != nil {
+		t, err := s.teamRepo.GetByID(ctx, *teamID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team: %w", err)
+		}
+		if t == nil || t.HackathonID != hackathonID {
+			return nil, fmt.Errorf("team not found")
+		}
+		if err := checkTeamEligible(h, t, userID); err != nil {
+			return nil, err
+		}
+		app.TeamName = t.Name
+		members = members[:0]
+		for _, m := range t.Members {
+			members = append(members, m.UserID)
+		}
+	} else {
+		t, err := s.teamRepo.GetByMember(ctx, hackathonID, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get team: %w", err)
+		}
+		if t != nil {
+			return nil, fmt.Errorf("you are on team %s, the captain applies for the whole team", t.Name)
+		}
+	}
+
+	var formErr error
+	result, err := s.hackathonRepo.Apply(ctx, app, func(fields []model.FormField) error {
+		app.Answers, formErr = checkAnswers(fields, answers)
+		return formErr
+	})
+	if formErr != nil {
+		return nil, formErr
 	}
-	result, err := s.hackathonRepo.Apply(ctx, app)
 	if err != nil {
 		if strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "unique constraint") {
 			return nil, fmt.Errorf("already applied to this hackathon")
 		}
 		return nil, fmt.Errorf("failed to apply: %w", err)
 	}
+	// Registration closed between the check and the insert.
+	if result == nil {
+		return nil, fmt.Errorf("registration is not open")
+	}
+	for _, id := range members {
+		s.events.Publish(model.EventHackathonApplied, id, result.ID)
+	}
 	return result, nil
 }
 
+// checkTeamEligible checks a team application by captainID: the team must fit
+// the hackathon's size limit and every member must be a verified student.
+// Members cannot hold individual applications; joining a team rules that out.
+func checkTeamEligible(h *model.Hackathon, t *model.Team, captainID int64) error {
+	if t.CaptainID != captainID {
+		return fmt.Errorf("only the team captain can apply for the team")
+	}
+	if h.MaxTeamSize != nil && len(t.Members) > *h.MaxTeamSize {
+		return fmt.Errorf("the team has %d members, the limit is %d", len(t.Members), *h.MaxTeamSize)
+	}
+	var unverified []string
+	for _, m := range t.Members {
+		if m.User != nil && m.User.Role == model.RoleGuest {
+			name := m.User.FirstName
+			if m.User.Username != "" {
+				name = "@" + m.User.Username
+			}
+			unverified = append(unverified, name)
+		}
+	}
+	if len(unverified) > 0 {
+		return fmt.Errorf("not every member is eligible, unverified: %s", strings.Join(unverified, ", "))
+	}
+	return nil
+}
+
 func (s *HackathonService) ListApplications(ctx context.Context, hackathonID int64) ([]model.HackathonApplication, error) {
 	list, err := s.hackathonRepo.ListApplications(ctx, hackathonID)
 	if err != nil {
@@ -77,3 +363,369 @@ func (s *HackathonService) ListApplications(ctx context.Context, hackathonID int
 	}
 	return list, nil
 }
+
+// ExportApplicationsCSV writes a hackathon's applications as CSV, one column
+// per form field after the applicant's details.
+func (s *HackathonService) ExportApplicationsCSV(ctx context.Context, hackathonID int64, w io.Writer) error {
+	apps, err := s.ListApplications(ctx, hackathonID)
+	if err != nil {
+		return err
+	}
+	fields, err := s.Form(ctx, hackathonID)
+	if err != nil {
+		return err
+	}
+	header := []string{"name", "username", "team", "places", "status", "applied_at"}
+	for _, f := range fields {
+		header = append(header, f.Label)
+	}
+	cw := csv.NewWriter(w)
+	_ = cw.Write(csvSafe(header))
+	for _, a := range apps {
+		name, username := "", ""
+		if a.User != nil {
+			name = strings.TrimSpace(a.User.FirstName + " " + a.User.LastName)
+			username = a.User.Username
+		}
+		row := []string{
+			name, username, a.TeamName, strconv.Itoa(a.Places), a.Status, a.CreatedAt.UTC().Format(time.RFC3339),
+		}
+		byField := make(map[int64]model.FormAnswer, len(a.Answers))
+		for _, ans := range a.Answers {
+			byField[ans.FieldID] = ans
+		}
+		for _, f := range fields {
+			ans := byField[f.ID]
+			switch {
+			case f.Type == model.FormFieldMultiChoice:
+				row = append(row, strings.Join(ans.Choices, "; "))
+			case f.Type == model.FormFieldCheckbox && ans.Value == "true":
+				row = append(row, "yes")
+			default:
+				row = append(row, ans.Value)
+			}
+		}
+		_ = cw.Write(csvSafe(row))
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// csvSafe prefixes cells that a spreadsheet would run as a formula with an
+// apostrophe, since names and answers are typed in by applicants.
+func csvSafe(row []string) []string {
+	for i, cell := range row {
+		if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+			row[i] = "'" + cell
+		}
+	}
+	return row
+}
+
+// Withdraw removes a student's application. A freed place goes to the next
+// waitlisted applicant, who is notified.
+func (s *HackathonService) Withdraw(ctx context.Context, hackathonID, userID int64) error {
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil || h.Status == model.HackathonDraft {
+		return fmt.Errorf("hackathon not found")
+	}
+	if !acceptsWithdrawals(h.Status) {
+		return fmt.Errorf("cannot withdraw, the hackathon is %s", h.Status)
+	}
+	t, err := s.teamRepo.GetByMember(ctx, hackathonID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get team: %w", err)
+	}
+	if t != nil && t.ApplicationID != nil && t.CaptainID != userID {
+		return fmt.Errorf("only the team captain can withdraw the team's application")
+	}
+	withdrawn, promoted, err := s.hackathonRepo.Withdraw(ctx, hackathonID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to withdraw application: %w", err)
+	}
+	if withdrawn == nil {
+		return fmt.Errorf("you have not applied to this hackathon")
+	}
+	for i := range promoted {
+		s.notifyPromoted(ctx, &promoted[i])
+	}
+	return nil
+}
+
+// MyApplications returns the applications a student has made.
+func (s *HackathonService) MyApplications(ctx context.Context, userID int64) ([]model.HackathonApplication, error) {
+	list, err := s.hackathonRepo.ListApplicationsByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	if list == nil {
+		list = []model.HackathonApplication{}
+	}
+	return list, nil
+}
+
+// ReviewApplication approves, rejects or waitlists a single application.
+func (s *HackathonService) ReviewApplication(ctx context.Context, reviewerID, hackathonID, applicationID int64, status, message string) (*model.HackathonApplication, error) {
+	list, err := s.ReviewApplications(ctx, reviewerID, hackathonID, []int64{applicationID}, status, message)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		app, err := s.hackathonRepo.GetApplication(ctx, applicationID)
+		switch {
+		case err != nil:
+			return nil, fmt.Errorf("failed to get application: %w", err)
+		case app == nil || app.HackathonID != hackathonID:
+			return nil, fmt.Errorf("application not found")
+		default:
+			return nil, fmt.Errorf("application is already %s", app.Status)
+		}
+	}
+	return &list[0], nil
+}
+
+// ReviewApplications sets status on several applications of a hackathon at
+// once and notifies each applicant. Applications already in status are
+// skipped; the changed ones are returned. Approvals must fit under the
+// participant cap, and rejections free places for the waitlist.
+func (s *HackathonService) ReviewApplications(ctx context.Context, reviewerID, hackathonID int64, ids []int64, status, message string) ([]model.HackathonApplication, error) {
+	if !model.ApplicationReviewable(status) {
+		return nil, fmt.Errorf("status must be approved, rejected or waitlisted")
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no applications selected")
+	}
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	if h.Status == model.HackathonFinished || h.Status == model.HackathonCancelled {
+		return nil, fmt.Errorf("applications can no longer be reviewed, the hackathon is %s", h.Status)
+	}
+	list, promoted, err := s.hackathonRepo.ReviewApplications(ctx, hackathonID, ids, status, strings.TrimSpace(message), reviewerID)
+	if errors.Is(err, repository.ErrNoPlaces) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to review applications: %w", err)
+	}
+	for i := range list {
+		s.notifyReview(ctx, &list[i])
+	}
+	for i := range promoted {
+		s.notifyPromoted(ctx, &promoted[i])
+	}
+	if list == nil {
+		list = []model.HackathonApplication{}
+	}
+	return list, nil
+}
+
+var applicationReviewMessages = map[string]string{
+	model.ApplicationApproved:   "✅ <b>You're in: %s</b>\n\nYour application has been approved.",
+	model.ApplicationRejected:   "❌ <b>Application not accepted: %s</b>",
+	model.ApplicationWaitlisted: "⏳ <b>You're on the waitlist: %s</b>\n\nWe'll let you know if a place opens up.",
+}
+
+func (s *HackathonService) notifyReview(ctx context.Context, app *model.HackathonApplication) {
+	u, err := s.userRepo.FindByID(ctx, app.UserID)
+	if err != nil || u == nil {
+		return
+	}
+	msg := fmt.Sprintf(applicationReviewMessages[app.Status], html.EscapeString(app.HackathonTitle))
+	if app.ReviewMessage != "" {
+		msg += "\n\n" + html.EscapeString(app.ReviewMessage)
+	}
+	go func() {
+		if err := s.telegramGW.SendMessage(u.TelegramID, msg); err != nil {
+			log.Printf("Failed to notify user %d about hackathon application %d: %v", u.ID, app.ID, err)
+		}
+	}()
+}
+
+func (s *HackathonService) notifyPromoted(ctx context.Context, app *model.HackathonApplication) {
+	u, err := s.userRepo.FindByID(ctx, app.UserID)
+	if err != nil || u == nil {
+		return
+	}
+	msg := promotedMessage(app)
+	go func() {
+		if err := s.telegramGW.SendMessage(u.TelegramID, msg); err != nil {
+			log.Printf("Failed to notify user %d about waitlist promotion %d: %v", u.ID, app.ID, err)
+		}
+	}()
+}
+
+// promotedMessage tells an applicant they moved off the waitlist.
+func promotedMessage(app *model.HackathonApplication) string {
+	msg := fmt.Sprintf("🎉 <b>A place opened up: %s</b>\n\nYou've moved off the waitlist.", html.EscapeString(app.HackathonTitle))
+	if app.Status == model.ApplicationPending {
+		msg += " Your application is now awaiting review."
+	}
+	return msg
+}
+
+const (
+	maxFormFields      = 30
+	maxFormLabelLength = 200
+	maxFormChoices     = 50
+	maxFormChoiceLen   = 100
+	maxFormTextLength  = 200
+	maxFormLongText    = 2000
+)
+
+// Form returns a hackathon's application form.
+func (s *HackathonService) Form(ctx context.Context, hackathonID int64) ([]model.FormField, error) {
+	list, err := s.hackathonRepo.ListFormFields(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application form: %w", err)
+	}
+	if list == nil {
+		list = []model.FormField{}
+	}
+	return list, nil
+}
+
+// SetForm replaces a hackathon's application form; an empty list removes it.
+// Fields keep their answers as long as they are sent back with their id.
+func (s *HackathonService) SetForm(ctx context.Context, hackathonID int64, fields []model.FormField) ([]model.FormField, error) {
+	if len(fields) > maxFormFields {
+		return nil, fmt.Errorf("a form can have at most %d fields", maxFormFields)
+	}
+	labels := map[string]bool{}
+	ids := map[int64]bool{}
+	for i := range fields {
+		f := &fields[i]
+		f.Label = strings.TrimSpace(f.Label)
+		switch {
+		case f.Label == "" || len([]rune(f.Label)) > maxFormLabelLength:
+			return nil, fmt.Errorf("field labels must be 1-%d characters", maxFormLabelLength)
+		case labels[strings.ToLower(f.Label)]:
+			return nil, fmt.Errorf("field %q is listed twice", f.Label)
+		case f.ID != 0 && ids[f.ID]:
+			return nil, fmt.Errorf("field %d is listed twice", f.ID)
+		case !model.IsFormFieldType(f.Type):
+			return nil, fmt.Errorf("unknown field type %q", f.Type)
+		}
+		labels[strings.ToLower(f.Label)] = true
+		ids[f.ID] = true
+		if !f.HasChoices() {
+			f.Choices = nil
+			continue
+		}
+		choices, err := normalizeChoices(f.Choices)
+		if err != nil {
+			return nil, fmt.Errorf("%q: %w", f.Label, err)
+		}
+		f.Choices = choices
+	}
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	list, err := s.hackathonRepo.ReplaceFormFields(ctx, hackathonID, fields)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// normalizeChoices trims a choice field's options, which must be distinct.
+func normalizeChoices(choices []string) ([]string, error) {
+	if len(choices) == 0 || len(choices) > maxFormChoices {
+		return nil, fmt.Errorf("choice fields need 1-%d choices", maxFormChoices)
+	}
+	result := make([]string, len(choices))
+	for i, c := range choices {
+		c = strings.TrimSpace(c)
+		switch {
+		case c == "" || len([]rune(c)) > maxFormChoiceLen:
+			return nil, fmt.Errorf("choices must be 1-%d characters", maxFormChoiceLen)
+		case slices.Contains(result[:i], c):
+			return nil, fmt.Errorf("choice %q is listed twice", c)
+		}
+		result[i] = c
+	}
+	return result, nil
+}
+
+// checkAnswers validates answers against a form and returns them in form
+// order, trimmed and without empty ones. Every required field must be
+// answered, and a required checkbox ticked.
+func checkAnswers(fields []model.FormField, answers []model.FormAnswer) ([]model.FormAnswer, error) {
+	onForm := make(map[int64]bool, len(fields))
+	for _, f := range fields {
+		onForm[f.ID] = true
+	}
+	byField := make(map[int64]model.FormAnswer, len(answers))
+	for _, a := range answers {
+		if !onForm[a.FieldID] {
+			return nil, fmt.Errorf("form field %d is not on this hackathon's form", a.FieldID)
+		}
+		if _, ok := byField[a.FieldID]; ok {
+			return nil, fmt.Errorf("form field %d is answered twice", a.FieldID)
+		}
+		byField[a.FieldID] = a
+	}
+
+	var result []model.FormAnswer
+	for _, f := range fields {
+		a := model.FormAnswer{FieldID: f.ID, Value: strings.TrimSpace(byField[f.ID].Value)}
+		switch f.Type {
+		case model.FormFieldText, model.FormFieldLongText:
+			limit := maxFormTextLength
+			if f.Type == model.FormFieldLongText {
+				limit = maxFormLongText
+			}
+			if len([]rune(a.Value)) > limit {
+				return nil, fmt.Errorf("the answer to %q must be at most %d characters", f.Label, limit)
+			}
+		case model.FormFieldNumber:
+			// ParseFloat also accepts "NaN" and "Inf", which are not answers.
+			n, err := strconv.ParseFloat(a.Value, 64)
+			if a.Value != "" && (err != nil || math.IsNaN(n) || math.IsInf(n, 0)) {
+				return nil, fmt.Errorf("the answer to %q must be a number", f.Label)
+			}
+		case model.FormFieldChoice:
+			if a.Value != "" && !slices.Contains(f.Choices, a.Value) {
+				return nil, fmt.Errorf("%q is not a choice for %q", a.Value, f.Label)
+			}
+		case model.FormFieldMultiChoice:
+			a.Value = ""
+			for _, c := range byField[f.ID].Choices {
+				c = strings.TrimSpace(c)
+				if !slices.Contains(f.Choices, c) {
+					return nil, fmt.Errorf("%q is not a choice for %q", c, f.Label)
+				}
+				if !slices.Contains(a.Choices, c) {
+					a.Choices = append(a.Choices, c)
+				}
+			}
+		case model.FormFieldCheckbox:
+			switch a.Value {
+			case "true":
+			case "", "false":
+				a.Value = ""
+			default:
+				return nil, fmt.Errorf("the answer to %q must be true or false", f.Label)
+			}
+		}
+		if a.Value == "" && len(a.Choices) == 0 {
+			if f.Required {
+				return nil, fmt.Errorf("%q is required", f.Label)
+			}
+			continue
+		}
+		result = append(result, a)
+	}
+	return result, nil
+}
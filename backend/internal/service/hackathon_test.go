@@ -0,0 +1,199 @@
+package service
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+func TestNormalizeChoices(t *testing.T) {
+	many := make([]string, maxFormChoices+1)
+	for i := range many {
+		many[i] = fmt.Sprint("option ", i)
+	}
+	tests := []struct {
+		name    string
+		choices []string
+		want    []string
+		wantErr string
+	}{
+		{name: "trimmed", choices: []string{" web ", "mobile"}, want: []string{"web", "mobile"}},
+		{name: "at the limit", choices: many[:maxFormChoices], want: many[:maxFormChoices]},
+		{name: "none", choices: nil, wantErr: "choice fields need 1-50 choices"},
+		{name: "too many", choices: many, wantErr: "choice fields need 1-50 choices"},
+		{name: "blank", choices: []string{"web", "  "}, wantErr: "choices must be 1-100 characters"},
+		{name: "too long", choices: []string{strings.Repeat("ü", 101)}, wantErr: "choices must be 1-100 characters"},
+		{name: "duplicate after trimming", choices: []string{"web", " web"}, wantErr: `choice "web" is listed twice`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeChoices(tt.choices)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil || !slices.Equal(got, tt.want) {
+				t.Fatalf("got %v, %v; want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+var testForm = []model.FormField{
+	{ID: 1, Label: "Name", Type: model.FormFieldText, Required: true},
+	{ID: 2, Label: "About you", Type: model.FormFieldLongText},
+	{ID: 3, Label: "Age", Type: model.FormFieldNumber},
+	{ID: 4, Label: "Track", Type: model.FormFieldChoice, Choices: []string{"web", "mobile"}},
+	{ID: 5, Label: "Stack", Type: model.FormFieldMultiChoice, Choices: []string{"go", "js", "sql"}},
+	{ID: 6, Label: "Rules", Type: model.FormFieldCheckbox, Required: true},
+}
+
+// withRequired adds answers to the required fields of testForm, unless
+// answers already cover them.
+func withRequired(answers ...model.FormAnswer) []model.FormAnswer {
+	has := func(id int64) bool {
+		return slices.ContainsFunc(answers, func(a model.FormAnswer) bool { return a.FieldID == id })
+	}
+	if !has(1) {
+		answers = append(answers, model.FormAnswer{FieldID: 1, Value: "Ann"})
+	}
+	if !has(6) {
+		answers = append(answers, model.FormAnswer{FieldID: 6, Value: "true"})
+	}
+	return answers
+}
+
+func TestCheckAnswers(t *testing.T) {
+	tests := []struct {
+		name    string
+		answers []model.FormAnswer
+		want    []model.FormAnswer
+		wantErr string
+	}{
+		{
+			name: "everything answered, returned in form order",
+			answers: []model.FormAnswer{
+				{FieldID: 6, Value: "true"},
+				{FieldID: 5, Choices: []string{"sql", " go ", "sql"}},
+				{FieldID: 4, Value: " mobile"},
+				{FieldID: 3, Value: "-3.5"},
+				{FieldID: 2, Value: "  I like maps  "},
+				{FieldID: 1, Value: " Ann "},
+			},
+			want: []model.FormAnswer{
+				{FieldID: 1, Value: "Ann"},
+				{FieldID: 2, Value: "I like maps"},
+				{FieldID: 3, Value: "-3.5"},
+				{FieldID: 4, Value: "mobile"},
+				{FieldID: 5, Choices: []string{"sql", "go"}},
+				{FieldID: 6, Value: "true"},
+			},
+		},
+		{
+			name:    "blank optional answers are dropped",
+			answers: withRequired(model.FormAnswer{FieldID: 2, Value: "  "}, model.FormAnswer{FieldID: 5}),
+			want:    []model.FormAnswer{{FieldID: 1, Value: "Ann"}, {FieldID: 6, Value: "true"}},
+		},
+		{
+			name:    "field from another form",
+			answers: withRequired(model.FormAnswer{FieldID: 99, Value: "x"}),
+			wantErr: "form field 99 is not on this hackathon's form",
+		},
+		{
+			name:    "answered twice",
+			answers: withRequired(model.FormAnswer{FieldID: 3, Value: "1"}, model.FormAnswer{FieldID: 3, Value: "2"}),
+			wantErr: "form field 3 is answered twice",
+		},
+		{
+			name:    "required text missing",
+			answers: withRequired(model.FormAnswer{FieldID: 1, Value: "   "}),
+			wantErr: `"Name" is required`,
+		},
+		{
+			name:    "required checkbox unticked",
+			answers: withRequired(model.FormAnswer{FieldID: 6, Value: "false"}),
+			wantErr: `"Rules" is required`,
+		},
+		{
+			name:    "checkbox not a boolean",
+			answers: withRequired(model.FormAnswer{FieldID: 6, Value: "yes"}),
+			wantErr: `the answer to "Rules" must be true or false`,
+		},
+		{
+			name:    "text too long",
+			answers: withRequired(model.FormAnswer{FieldID: 1, Value: strings.Repeat("a", maxFormTextLength+1)}),
+			wantErr: `the answer to "Name" must be at most 200 characters`,
+		},
+		{
+			name:    "long text too long",
+			answers: withRequired(model.FormAnswer{FieldID: 2, Value: strings.Repeat("a", maxFormLongText+1)}),
+			wantErr: `the answer to "About you" must be at most 2000 characters`,
+		},
+		{
+			name:    "choice not listed",
+			answers: withRequired(model.FormAnswer{FieldID: 4, Value: "desktop"}),
+			wantErr: `"desktop" is not a choice for "Track"`,
+		},
+		{
+			name:    "multi choice not listed",
+			answers: withRequired(model.FormAnswer{FieldID: 5, Choices: []string{"go", "rust"}}),
+			wantErr: `"rust" is not a choice for "Stack"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checkAnswers(testForm, tt.answers)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].FieldID != tt.want[i].FieldID || got[i].Value != tt.want[i].Value ||
+					!slices.Equal(got[i].Choices, tt.want[i].Choices) {
+					t.Errorf("answer %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCheckAnswersNumbers(t *testing.T) {
+	tests := []struct {
+		value string
+		ok    bool
+	}{
+		{"42", true}, {"-3.5", true}, {"1e3", true}, {" 7 ", true},
+		{"abc", false}, {"1,5", false}, {"NaN", false}, {"nan", false},
+		{"Inf", false}, {"-Inf", false}, {"+infinity", false}, {"1e999", false},
+	}
+	for _, tt := range tests {
+		_, err := checkAnswers(testForm, withRequired(model.FormAnswer{FieldID: 3, Value: tt.value}))
+		if tt.ok && err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.value, err)
+		}
+		if !tt.ok && (err == nil || err.Error() != `the answer to "Age" must be a number`) {
+			t.Errorf("%q: err = %v, want a number error", tt.value, err)
+		}
+	}
+}
+
+func TestCSVSafe(t *testing.T) {
+	got := csvSafe([]string{"", "Ann", "=SUM(A1)", "+1", "-2", "@cmd", "\tx", "\rx", "a=b", "42"})
+	want := []string{"", "Ann", "'=SUM(A1)", "'+1", "'-2", "'@cmd", "'\tx", "'\rx", "a=b", "42"}
+	if !slices.Equal(got, want) {
+		t.Errorf("csvSafe = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,536 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+const (
+	maxRubricCriteria     = 20
+	maxCriterionName      = 100
+	maxCriterionWeight    = 100
+	maxCriterionScore     = 100
+	maxJudgeCommentLength = 2000
+	maxPrizeTiers         = 10
+	maxPrizeCoins         = 10000
+)
+
+type JudgingService struct {
+	judgingRepo   *repository.JudgingRepository
+	hackathonRepo *repository.HackathonRepository
+	projectRepo   *repository.ProjectRepository
+	teamRepo      *repository.TeamRepository
+	userRepo      *repository.UserRepository
+	telegramGW    *gateway.TelegramGateway
+	events        *EventBus
+}
+
+func NewJudgingService(judgingRepo *repository.JudgingRepository, hackathonRepo *repository.HackathonRepository, projectRepo *repository.ProjectRepository, teamRepo *repository.TeamRepository, userRepo *repository.UserRepository, telegramGW *gateway.TelegramGateway, events *EventBus) *JudgingService {
+	return &JudgingService{
+		judgingRepo:   judgingRepo,
+		hackathonRepo: hackathonRepo,
+		projectRepo:   projectRepo,
+		teamRepo:      teamRepo,
+		userRepo:      userRepo,
+		telegramGW:    telegramGW,
+		events:        events,
+	}
+}
+
+// hackathon returns a hackathon that judging can still be set up for.
+func (s *JudgingService) hackathon(ctx context.Context, hackathonID int64) (*model.Hackathon, error) {
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	if h.Status == model.HackathonFinished || h.Status == model.HackathonCancelled {
+		return nil, fmt.Errorf("the hackathon is %s", h.Status)
+	}
+	return h, nil
+}
+
+// Criteria returns a hackathon's rubric.
+func (s *JudgingService) Criteria(ctx context.Context, hackathonID int64) ([]model.JudgingCriterion, error) {
+	list, err := s.judgingRepo.ListCriteria(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list criteria: %w", err)
+	}
+	if list == nil {
+		list = []model.JudgingCriterion{}
+	}
+	return list, nil
+}
+
+// SetCriteria replaces a hackathon's rubric. MaxScore defaults to 10.
+func (s *JudgingService) SetCriteria(ctx context.Context, hackathonID int64, criteria []model.JudgingCriterion) ([]model.JudgingCriterion, error) {
+	if len(criteria) == 0 || len(criteria) > maxRubricCriteria {
+		return nil, fmt.Errorf("a rubric needs 1-%d criteria", maxRubricCriteria)
+	}
+	seen := map[string]bool{}
+	for i := range criteria {
+		c := &criteria[i]
+		c.Name = strings.TrimSpace(c.Name)
+		c.Description = strings.TrimSpace(c.Description)
+		if c.MaxScore == 0 {
+			c.MaxScore = 10
+		}
+		switch {
+		case c.Name == "" || len([]rune(c.Name)) > maxCriterionName:
+			return nil, fmt.Errorf("criterion names must be 1-%d characters", maxCriterionName)
+		case seen[strings.ToLower(c.Name)]:
+			return nil, fmt.Errorf("criterion %q is listed twice", c.Name)
+		case c.Weight < 1 || c.Weight > maxCriterionWeight:
+			return nil, fmt.Errorf("weights must be 1-%d", maxCriterionWeight)
+		case c.MaxScore < 1 || c.MaxScore > maxCriterionScore:
+			return nil, fmt.Errorf("max_score must be 1-%d", maxCriterionScore)
+		}
+		seen[strings.ToLower(c.Name)] = true
+	}
+	if _, err := s.hackathon(ctx, hackathonID); err != nil {
+		return nil, err
+	}
+	return s.judgingRepo.ReplaceCriteria(ctx, hackathonID, criteria)
+}
+
+// Judges returns a hackathon's judges with their progress.
+func (s *JudgingService) Judges(ctx context.Context, hackathonID int64) ([]model.Judge, error) {
+	list, err := s.judgingRepo.ListJudges(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list judges: %w", err)
+	}
+	if list == nil {
+		list = []model.Judge{}
+	}
+	return list, nil
+}
+
+// AddJudge lets a user judge a hackathon and tells them on Telegram.
+func (s *JudgingService) AddJudge(ctx context.Context, admin *model.User, hackathonID, userID int64) error {
+	h, err := s.hackathon(ctx, hackathonID)
+	if err != nil {
+		return err
+	}
+	u, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+	if u == nil {
+		return fmt.Errorf("user not found")
+	}
+	added, err := s.judgingRepo.AddJudge(ctx, hackathonID, userID, admin.ID)
+	if err != nil {
+		return fmt.Errorf("failed to add judge: %w", err)
+	}
+	if !added {
+		return fmt.Errorf("already a judge for this hackathon")
+	}
+	s.notify(u, fmt.Sprintf("⚖️ <b>You're a judge for %s</b>\n\nYour assigned projects will show up once judging opens.",
+		html.EscapeString(h.Title)))
+	return nil
+}
+
+// RemoveJudge takes a judge off a hackathon, discarding their scores.
+func (s *JudgingService) RemoveJudge(ctx context.Context, hackathonID, userID int64) error {
+	if _, err := s.hackathon(ctx, hackathonID); err != nil {
+		return err
+	}
+	removed, err := s.judgingRepo.RemoveJudge(ctx, hackathonID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to remove judge: %w", err)
+	}
+	if !removed {
+		return fmt.Errorf("judge not found")
+	}
+	return nil
+}
+
+// Assign gives a judge projects to score. Judges cannot be given their own
+// team's project.
+func (s *JudgingService) Assign(ctx context.Context, hackathonID, judgeID int64, projectIDs []int64) (int, error) {
+	if len(projectIDs) == 0 {
+		return 0, fmt.Errorf("project_ids is required")
+	}
+	if _, err := s.hackathon(ctx, hackathonID); err != nil {
+		return 0, err
+	}
+	ok, err := s.judgingRepo.IsJudge(ctx, hackathonID, judgeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check judge: %w", err)
+	}
+	if !ok {
+		return 0, fmt.Errorf("user is not a judge for this hackathon")
+	}
+	projects, err := s.projectRepo.ListByHackathon(ctx, hackathonID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list projects: %w", err)
+	}
+	byID := make(map[int64]*model.Project, len(projects))
+	for i := range projects {
+		byID[projects[i].ID] = &projects[i]
+	}
+	t, err := s.teamRepo.ListByUser(ctx, judgeID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list teams: %w", err)
+	}
+	ownTeams := map[int64]bool{}
+	for _, team := range t {
+		ownTeams[team.ID] = true
+	}
+
+	pairs := make([]model.JudgeAssignment, len(projectIDs))
+	for i, id := range projectIDs {
+		p := byID[id]
+		if p == nil {
+			return 0, fmt.Errorf("project %d is not in this hackathon", id)
+		}
+		if ownTeams[p.TeamID] {
+			return 0, fmt.Errorf("conflict of interest: the judge is on team %s", p.TeamName)
+		}
+		pairs[i] = model.JudgeAssignment{ProjectID: id, JudgeID: judgeID}
+	}
+	n, err := s.judgingRepo.Assign(ctx, hackathonID, pairs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to assign projects: %w", err)
+	}
+	return n, nil
+}
+
+// AutoAssign tops every project up to perProject judges, picking the least
+// loaded judges without a conflict of interest. Projects with too few
+// eligible judges get as many as there are.
+func (s *JudgingService) AutoAssign(ctx context.Context, hackathonID int64, perProject int) (int, error) {
+	if perProject < 1 {
+		return 0, fmt.Errorf("judges_per_project must be at least 1")
+	}
+	if _, err := s.hackathon(ctx, hackathonID); err != nil {
+		return 0, err
+	}
+	judges, err := s.judgingRepo.ListJudges(ctx, hackathonID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list judges: %w", err)
+	}
+	if len(judges) == 0 {
+		return 0, fmt.Errorf("the hackathon has no judges")
+	}
+	projects, err := s.projectRepo.ListByHackathon(ctx, hackathonID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list projects: %w", err)
+	}
+	teams, err := s.teamRepo.ListByHackathon(ctx, hackathonID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list teams: %w", err)
+	}
+	existing, err := s.judgingRepo.ListAssignments(ctx, hackathonID, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list assignments: %w", err)
+	}
+
+	teamByID := make(map[int64]*model.Team, len(teams))
+	for i := range teams {
+		teamByID[teams[i].ID] = &teams[i]
+	}
+	type pair struct{ project, judge int64 }
+	assigned := map[pair]bool{}
+	perProjectCount := map[int64]int{}
+	load := map[int64]int{}
+	for _, a := range existing {
+		assigned[pair{a.ProjectID, a.JudgeID}] = true
+		perProjectCount[a.ProjectID]++
+		load[a.JudgeID]++
+	}
+
+	var pairs []model.JudgeAssignment
+	for _, p := range projects {
+		team := teamByID[p.TeamID]
+		for perProjectCount[p.ID] < perProject {
+			best := int64(0)
+			for _, j := range judges {
+				if assigned[pair{p.ID, j.UserID}] || (team != nil && team.HasMember(j.UserID)) {
+					continue
+				}
+				if best == 0 || load[j.UserID] < load[best] {
+					best = j.UserID
+				}
+			}
+			if best == 0 {
+				break
+			}
+			assigned[pair{p.ID, best}] = true
+			perProjectCount[p.ID]++
+			load[best]++
+			pairs = append(pairs, model.JudgeAssignment{ProjectID: p.ID, JudgeID: best})
+		}
+	}
+	if len(pairs) == 0 {
+		return 0, nil
+	}
+	n, err := s.judgingRepo.Assign(ctx, hackathonID, pairs)
+	if err != nil {
+		return 0, fmt.Errorf("failed to assign projects: %w", err)
+	}
+	return n, nil
+}
+
+// Assignments lists every judge's assignments for organisers.
+func (s *JudgingService) Assignments(ctx context.Context, hackathonID int64) ([]model.JudgeAssignment, error) {
+	list, err := s.judgingRepo.ListAssignments(ctx, hackathonID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assignments: %w", err)
+	}
+	if list == nil {
+		list = []model.JudgeAssignment{}
+	}
+	return list, nil
+}
+
+// MyAssignments lists the projects a judge has to score.
+func (s *JudgingService) MyAssignments(ctx context.Context, judge *model.User, hackathonID int64) ([]model.JudgeAssignment, error) {
+	ok, err := s.judgingRepo.IsJudge(ctx, hackathonID, judge.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check judge: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("you are not a judge for this hackathon")
+	}
+	list, err := s.judgingRepo.ListAssignments(ctx, hackathonID, &judge.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list assignments: %w", err)
+	}
+	if list == nil {
+		list = []model.JudgeAssignment{}
+	}
+	return list, nil
+}
+
+// Score records a judge's scores for an assigned project while the
+// hackathon is in judging. Every rubric criterion must be scored.
+func (s *JudgingService) Score(ctx context.Context, judge *model.User, hackathonID, projectID int64, scores map[int64]int, comment string) (*model.JudgeAssignment, error) {
+	comment = strings.TrimSpace(comment)
+	if len([]rune(comment)) > maxJudgeCommentLength {
+		return nil, fmt.Errorf("comment must be at most %d characters", maxJudgeCommentLength)
+	}
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	if h.Status != model.HackathonJudging {
+		return nil, fmt.Errorf("judging is not open")
+	}
+	criteria, err := s.judgingRepo.ListCriteria(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list criteria: %w", err)
+	}
+	if len(criteria) == 0 {
+		return nil, fmt.Errorf("the hackathon has no rubric yet")
+	}
+	if err := checkScores(criteria, scores); err != nil {
+		return nil, err
+	}
+
+	a, err := s.judgingRepo.Score(ctx, hackathonID, &model.JudgeAssignment{
+		ProjectID: projectID, JudgeID: judge.ID, Scores: scores, Comment: comment,
+	})
+	if err != nil {
+		if err.Error() == "judging is not open" {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to save scores: %w", err)
+	}
+	if a == nil {
+		return nil, fmt.Errorf("you are not assigned this project")
+	}
+	return a, nil
+}
+
+// checkScores requires a score within range for every criterion and nothing else.
+func checkScores(criteria []model.JudgingCriterion, scores map[int64]int) error {
+	known := make(map[int64]bool, len(criteria))
+	for _, c := range criteria {
+		known[c.ID] = true
+		score, ok := scores[c.ID]
+		if !ok {
+			return fmt.Errorf("criterion %q has no score", c.Name)
+		}
+		if score < 0 || score > c.MaxScore {
+			return fmt.Errorf("%s must be scored 0-%d", c.Name, c.MaxScore)
+		}
+	}
+	for id := range scores {
+		if !known[id] {
+			return fmt.Errorf("criterion %d is not in the rubric", id)
+		}
+	}
+	return nil
+}
+
+// Results returns a hackathon's ranking. Once published everyone sees the
+// published placements; before that only organisers see a live preview.
+func (s *JudgingService) Results(ctx context.Context, viewer *model.User, hackathonID int64) ([]model.HackathonResult, error) {
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil || (h.Status == model.HackathonDraft && (viewer == nil || viewer.Role != model.RoleAdmin)) {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	var list []model.HackathonResult
+	switch {
+	case h.ResultsPublishedAt != nil:
+		list, err = s.judgingRepo.PublishedResults(ctx, hackathonID)
+	case viewer != nil && viewer.Role == model.RoleAdmin:
+		list, err = s.judgingRepo.Results(ctx, hackathonID)
+	default:
+		return nil, fmt.Errorf("results have not been published")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get results: %w", err)
+	}
+	if list == nil {
+		list = []model.HackathonResult{}
+	}
+	return list, nil
+}
+
+// Prizes returns a hackathon's prize tiers, best placement first.
+func (s *JudgingService) Prizes(ctx context.Context, hackathonID int64) ([]model.PrizeTier, error) {
+	list, err := s.judgingRepo.ListPrizes(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list prizes: %w", err)
+	}
+	if list == nil {
+		list = []model.PrizeTier{}
+	}
+	return list, nil
+}
+
+// SetPrizes replaces a hackathon's prize tiers; an empty list removes them.
+func (s *JudgingService) SetPrizes(ctx context.Context, hackathonID int64, tiers []model.PrizeTier) ([]model.PrizeTier, error) {
+	if len(tiers) > maxPrizeTiers {
+		return nil, fmt.Errorf("at most %d prize tiers are allowed", maxPrizeTiers)
+	}
+	seen := map[int]bool{}
+	for _, t := range tiers {
+		switch {
+		case t.Placement < 1 || t.Placement > maxPrizeTiers:
+			return nil, fmt.Errorf("placements must be 1-%d", maxPrizeTiers)
+		case seen[t.Placement]:
+			return nil, fmt.Errorf("placement %d is listed twice", t.Placement)
+		case t.Coins < 1 || t.Coins > maxPrizeCoins:
+			return nil, fmt.Errorf("prizes must be 1-%d coins", maxPrizeCoins)
+		}
+		seen[t.Placement] = true
+	}
+	if _, err := s.hackathon(ctx, hackathonID); err != nil {
+		return nil, err
+	}
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].Placement < tiers[j].Placement })
+	if err := s.judgingRepo.ReplacePrizes(ctx, hackathonID, tiers); err != nil {
+		return nil, err
+	}
+	if tiers == nil {
+		tiers = []model.PrizeTier{}
+	}
+	return tiers, nil
+}
+
+// Payouts returns the prizes paid when a hackathon's results were published.
+func (s *JudgingService) Payouts(ctx context.Context, hackathonID int64) ([]model.PrizePayout, error) {
+	list, err := s.judgingRepo.ListPayouts(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payouts: %w", err)
+	}
+	if list == nil {
+		list = []model.PrizePayout{}
+	}
+	return list, nil
+}
+
+// Publish fixes the ranking of a hackathon in judging, pays out its prizes
+// and finishes it. Every member of a team with a project hears their
+// placement, and their share of any prize, on Telegram.
+func (s *JudgingService) Publish(ctx context.Context, hackathonID int64) ([]model.HackathonResult, error) {
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	if h.Status != model.HackathonJudging {
+		return nil, fmt.Errorf("results can only be published while judging, the hackathon is %s", h.Status)
+	}
+	results, payouts, err := s.judgingRepo.PublishResults(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish results: %w", err)
+	}
+	if results == nil {
+		return nil, fmt.Errorf("hackathon status changed concurrently, try again")
+	}
+	s.announce(ctx, h, results, payouts)
+	return results, nil
+}
+
+// announce tells every team member how their project placed and what they
+// won, and emits EventHackathonResult for them.
+func (s *JudgingService) announce(ctx context.Context, h *model.Hackathon, results []model.HackathonResult, payouts []model.PrizePayout) {
+	teams, err := s.teamRepo.ListByHackathon(ctx, h.ID)
+	if err != nil {
+		log.Printf("Failed to announce results of hackathon %d: %v", h.ID, err)
+		return
+	}
+	members := make(map[int64][]model.TeamMember, len(teams))
+	for _, t := range teams {
+		members[t.ID] = t.Members
+	}
+	won := make(map[int64]int, len(payouts))
+	for _, p := range payouts {
+		won[p.UserID] = p.Coins
+	}
+	ranked := 0
+	for _, r := range results {
+		if r.Placement != nil {
+			ranked++
+		}
+	}
+	for _, r := range results {
+		msg := fmt.Sprintf("🏁 <b>Results of %s are out</b>\n\n%s was not ranked.",
+			html.EscapeString(h.Title), html.EscapeString(r.TeamName))
+		if r.Placement != nil {
+			msg = fmt.Sprintf("🏆 <b>Results of %s are out</b>\n\n%s placed #%d of %d with %.2f points.",
+				html.EscapeString(h.Title), html.EscapeString(r.TeamName), *r.Placement, ranked, *r.Score)
+		}
+		for _, m := range members[r.TeamID] {
+			s.events.Publish(model.EventHackathonResult, m.UserID, r.ProjectID)
+			if m.User == nil {
+				continue
+			}
+			if coins := won[m.UserID]; coins > 0 {
+				s.notify(m.User, msg+fmt.Sprintf("\n\n💰 Your share of the prize: <b>%d coins</b>.", coins))
+			} else {
+				s.notify(m.User, msg)
+			}
+		}
+	}
+}
+
+func (s *JudgingService) notify(u *model.User, msg string) {
+	go func() {
+		if err := s.telegramGW.SendMessage(u.TelegramID, msg); err != nil {
+			log.Printf("Failed to notify user %d about judging: %v", u.ID, err)
+		}
+	}()
+}
@@ -2,18 +2,146 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
 )
 
+const maxLeaderboardLimit = 100
+
 type LeaderboardService struct {
-	userRepo *repository.UserRepository
+	leaderboardRepo *repository.LeaderboardRepository
+	seasonRepo      *repository.SeasonRepository
+	leagues         []model.League
+}
+
+func NewLeaderboardService(leaderboardRepo *repository.LeaderboardRepository, seasonRepo *repository.SeasonRepository, leagues []model.League) *LeaderboardService {
+	return &LeaderboardService{leaderboardRepo: leaderboardRepo, seasonRepo: seasonRepo, leagues: leagues}
+}
+
+// ErrBadLeaderboardQuery is returned for a period, metric or league that
+// cannot be ranked.
+var ErrBadLeaderboardQuery = errors.New("invalid leaderboard query")
+
+// Get returns a page of the leaderboard, limited to one league unless league
+// is empty. Week and month are the last 7 and 30 days; season is the current
+// season.
+func (s *LeaderboardService) Get(ctx context.Context, q model.LeaderboardQuery, league string) ([]model.LeaderboardEntry, error) {
+	if err := s.normalizeQuery(ctx, &q); err != nil {
+		return nil, err
+	}
+	if err := s.setQueryLeague(&q, league); err != nil {
+		return nil, err
+	}
+	return s.list(ctx, q)
+}
+
+// Rank returns userID's entry on the leaderboard Get would return, wherever
+// they rank, or nil if they are not ranked.
+func (s *LeaderboardService) Rank(ctx context.Context, q model.LeaderboardQuery, league string, userID int64) (*model.LeaderboardEntry, error) {
+	if err := s.normalizeQuery(ctx, &q); err != nil {
+		return nil, err
+	}
+	if err := s.setQueryLeague(&q, league); err != nil {
+		return nil, err
+	}
+	e, err := s.leaderboardRepo.Rank(ctx, q, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard rank: %w", err)
+	}
+	if e != nil {
+		s.setLeague(e)
+	}
+	return e, nil
+}
+
+func (s *LeaderboardService) setQueryLeague(q *model.LeaderboardQuery, league string) error {
+	if league == "" {
+		return nil
+	}
+	for i := range s.leagues {
+		if s.leagues[i].Name == league {
+			q.League = &s.leagues[i]
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: unknown league %q", ErrBadLeaderboardQuery, league)
+}
+
+// TopByLeague returns the top q.Limit entries of every league.
+func (s *LeaderboardService) TopByLeague(ctx context.Context, q model.LeaderboardQuery) ([]model.LeagueStandings, error) {
+	if err := s.normalizeQuery(ctx, &q); err != nil {
+		return nil, err
+	}
+	q.Offset = 0
+
+	standings := make([]model.LeagueStandings, len(s.leagues))
+	for i := range s.leagues {
+		q.League = &s.leagues[i]
+		entries, err := s.list(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+		standings[i] = model.LeagueStandings{League: s.leagues[i], Entries: entries}
+	}
+	return standings, nil
+}
+
+func (s *LeaderboardService) list(ctx context.Context, q model.LeaderboardQuery) ([]model.LeaderboardEntry, error) {
+	entries, err := s.leaderboardRepo.List(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard: %w", err)
+	}
+	if entries == nil {
+		entries = []model.LeaderboardEntry{}
+	}
+	for i := range entries {
+		s.setLeague(&entries[i])
+	}
+	return entries, nil
 }
 
-func NewLeaderboardService(userRepo *repository.UserRepository) *LeaderboardService {
-	return &LeaderboardService{userRepo: userRepo}
+func (s *LeaderboardService) setLeague(e *model.LeaderboardEntry) {
+	if l := model.LeagueFor(s.leagues, e.SchoolLevel); l != nil {
+		e.League = l.Name
+	}
 }
 
-func (s *LeaderboardService) GetTop(ctx context.Context, limit int) ([]repository.LeaderboardEntry, error) {
-	return s.userRepo.GetLeaderboard(ctx, limit)
+func (s *LeaderboardService) normalizeQuery(ctx context.Context, q *model.LeaderboardQuery) error {
+	switch q.Period {
+	case model.PeriodSeason:
+		season, err := s.seasonRepo.Current(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get current season: %w", err)
+		}
+		if season == nil {
+			return fmt.Errorf("%w: no season is running", ErrBadLeaderboardQuery)
+		}
+		q.Since, q.Until = &season.StartsAt, &season.EndsAt
+	case model.PeriodWeek:
+		since := time.Now().AddDate(0, 0, -7)
+		q.Since = &since
+	case model.PeriodMonth:
+		since := time.Now().AddDate(0, 0, -30)
+		q.Since = &since
+	case "", model.PeriodAll:
+		q.Period = model.PeriodAll
+	default:
+		return fmt.Errorf("%w: unknown period %q", ErrBadLeaderboardQuery, q.Period)
+	}
+	switch q.Metric {
+	case "":
+		q.Metric = model.MetricCoins
+	case model.MetricCoins, model.MetricXP, model.MetricLevel, model.MetricAttendance, model.MetricCommunityXP:
+	default:
+		return fmt.Errorf("%w: unknown metric %q", ErrBadLeaderboardQuery, q.Metric)
+	}
+	if q.Limit <= 0 || q.Limit > maxLeaderboardLimit {
+		q.Limit = 10
+	}
+	q.Offset = max(q.Offset, 0)
+	return nil
 }
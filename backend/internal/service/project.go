@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+const (
+	maxProjectTitleLength       = 200
+	maxProjectDescriptionLength = 5000
+	maxProjectAttachments       = 5
+)
+
+type ProjectService struct {
+	projectRepo   *repository.ProjectRepository
+	teamRepo      *repository.TeamRepository
+	hackathonRepo *repository.HackathonRepository
+}
+
+func NewProjectService(projectRepo *repository.ProjectRepository, teamRepo *repository.TeamRepository, hackathonRepo *repository.HackathonRepository) *ProjectService {
+	return &ProjectService{projectRepo: projectRepo, teamRepo: teamRepo, hackathonRepo: hackathonRepo}
+}
+
+// memberTeam returns a team that viewer belongs to, or that viewer administers.
+func (s *ProjectService) memberTeam(ctx context.Context, viewer *model.User, teamID int64) (*model.Team, error) {
+	t, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+	if t == nil {
+		return nil, fmt.Errorf("team not found")
+	}
+	if viewer.Role != model.RoleAdmin && !t.HasMember(viewer.ID) {
+		return nil, fmt.Errorf("only team members can see the project")
+	}
+	return t, nil
+}
+
+// Get returns a team's project, nil if nothing has been submitted yet.
+func (s *ProjectService) Get(ctx context.Context, viewer *model.User, teamID int64) (*model.Project, error) {
+	if _, err := s.memberTeam(ctx, viewer, teamID); err != nil {
+		return nil, err
+	}
+	p, err := s.projectRepo.GetByTeam(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	return p, nil
+}
+
+// Save submits or edits a team's project. Any member of a team whose
+// application holds a place may do so while the hackathon runs, up to the
+// submission deadline.
+func (s *ProjectService) Save(ctx context.Context, user *model.User, teamID int64, p *model.Project) (*model.Project, error) {
+	t, err := s.memberTeam(ctx, user, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !t.HasMember(user.ID) {
+		return nil, fmt.Errorf("only team members can submit the project")
+	}
+	if t.ApplicationID == nil || !model.ApplicationHoldsPlace(t.ApplicationStatus) {
+		return nil, fmt.Errorf("the team is not registered for this hackathon")
+	}
+	if err := validateProject(p); err != nil {
+		return nil, err
+	}
+	h, err := s.hackathonRepo.GetByID(ctx, t.HackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil || !h.SubmissionsOpen(time.Now()) {
+		return nil, fmt.Errorf("submissions are closed")
+	}
+
+	p.HackathonID = t.HackathonID
+	p.TeamID = t.ID
+	p.UpdatedBy = &user.ID
+	result, err := s.projectRepo.Save(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save project: %w", err)
+	}
+	// The deadline passed between the check and the write.
+	if result == nil {
+		return nil, fmt.Errorf("submissions are closed")
+	}
+	return result, nil
+}
+
+// validateProject trims p's fields and checks their lengths and links.
+func validateProject(p *model.Project) error {
+	p.Title = strings.TrimSpace(p.Title)
+	p.Description = strings.TrimSpace(p.Description)
+	if p.Title == "" || len([]rune(p.Title)) > maxProjectTitleLength {
+		return fmt.Errorf("title must be 1-%d characters", maxProjectTitleLength)
+	}
+	if len([]rune(p.Description)) > maxProjectDescriptionLength {
+		return fmt.Errorf("description must be at most %d characters", maxProjectDescriptionLength)
+	}
+	var err error
+	if p.RepoURL, err = checkLink("repository_url", p.RepoURL); err != nil {
+		return err
+	}
+	if p.DemoURL, err = checkLink("demo_url", p.DemoURL); err != nil {
+		return err
+	}
+	attachments := []string{}
+	for _, a := range p.Attachments {
+		link, err := checkLink("attachments", a)
+		if err != nil {
+			return err
+		}
+		if link != "" {
+			attachments = append(attachments, link)
+		}
+	}
+	if len(attachments) > maxProjectAttachments {
+		return fmt.Errorf("at most %d attachments", maxProjectAttachments)
+	}
+	p.Attachments = attachments
+	return nil
+}
+
+// checkLink trims an optional link and requires it to be an http(s) URL.
+func checkLink(field, link string) (string, error) {
+	link = strings.TrimSpace(link)
+	if link == "" {
+		return "", nil
+	}
+	u, err := url.Parse(link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("%s must be http(s) URLs", field)
+	}
+	return link, nil
+}
+
+// List returns a hackathon's projects with their team members.
+func (s *ProjectService) List(ctx context.Context, hackathonID int64) ([]model.Project, error) {
+	list, err := s.projectRepo.ListByHackathon(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	if list == nil {
+		return []model.Project{}, nil
+	}
+	teams, err := s.teamRepo.ListByHackathon(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+	members := make(map[int64][]model.TeamMember, len(teams))
+	for _, t := range teams {
+		members[t.ID] = t.Members
+	}
+	for i := range list {
+		list[i].Members = members[list[i].TeamID]
+	}
+	return list, nil
+}
+
+// ExportCSV writes a hackathon's projects as CSV, one row per team.
+func (s *ProjectService) ExportCSV(ctx context.Context, hackathonID int64, w io.Writer) error {
+	list, err := s.List(ctx, hackathonID)
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(w)
+	_ = cw.Write(csvSafe([]string{"team", "members", "title", "description", "repository_url", "demo_url", "attachments", "updated_at"}))
+	for _, p := range list {
+		names := make([]string, len(p.Members))
+		for i, m := range p.Members {
+			names[i] = fmt.Sprint(m.UserID)
+			if m.User != nil {
+				names[i] = strings.TrimSpace(m.User.FirstName + " " + m.User.LastName)
+				if m.User.Username != "" {
+					names[i] += " (@" + m.User.Username + ")"
+				}
+			}
+		}
+		// Titles and descriptions are student-written; keep them inert.
+		_ = cw.Write(csvSafe([]string{
+			p.TeamName, strings.Join(names, "; "), p.Title, p.Description, p.RepoURL, p.DemoURL,
+			strings.Join(p.Attachments, " "), p.UpdatedAt.UTC().Format(time.RFC3339),
+		}))
+	}
+	cw.Flush()
+	return cw.Error()
+}
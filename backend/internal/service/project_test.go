@@ -0,0 +1,117 @@
+package service
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+func TestCheckLink(t *testing.T) {
+	tests := []struct {
+		link    string
+		want    string
+		wantErr bool
+	}{
+		{link: "", want: ""},
+		{link: "   ", want: ""},
+		{link: "https://github.com/team/app", want: "https://github.com/team/app"},
+		{link: "  http://demo.example.com/?q=1 ", want: "http://demo.example.com/?q=1"},
+		{link: "ftp://files.example.com/app.zip", wantErr: true},
+		{link: "javascript:alert(1)", wantErr: true},
+		{link: "github.com/team/app", wantErr: true},
+		{link: "https://", wantErr: true},
+		{link: "https://exa mple.com/%zz", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := checkLink("demo_url", tt.link)
+		if tt.wantErr {
+			if err == nil || err.Error() != "demo_url must be http(s) URLs" {
+				t.Errorf("checkLink(%q) error = %v, want a demo_url error", tt.link, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("checkLink(%q) = %q, %v; want %q", tt.link, got, err, tt.want)
+		}
+	}
+}
+
+func TestValidateProject(t *testing.T) {
+	tests := []struct {
+		name    string
+		project model.Project
+		want    model.Project
+		wantErr string
+	}{
+		{
+			name: "trims fields and drops blank attachments",
+			project: model.Project{
+				Title: "  Campus map ", Description: " Find rooms fast\n",
+				RepoURL: " https://github.com/t/map ", DemoURL: "",
+				Attachments: []string{"https://slides.example.com/1", "  ", " https://video.example.com/2 "},
+			},
+			want: model.Project{
+				Title: "Campus map", Description: "Find rooms fast",
+				RepoURL:     "https://github.com/t/map",
+				Attachments: []string{"https://slides.example.com/1", "https://video.example.com/2"},
+			},
+		},
+		{
+			name:    "no attachments becomes an empty list",
+			project: model.Project{Title: "Map"},
+			want:    model.Project{Title: "Map", Attachments: []string{}},
+		},
+		{name: "blank title", project: model.Project{Title: "   "}, wantErr: "title must be 1-200 characters"},
+		{name: "long title", project: model.Project{Title: strings.Repeat("я", 201)}, wantErr: "title must be 1-200 characters"},
+		{
+			name:    "long description",
+			project: model.Project{Title: "Map", Description: strings.Repeat("x", 5001)},
+			wantErr: "description must be at most 5000 characters",
+		},
+		{
+			name:    "bad repository link",
+			project: model.Project{Title: "Map", RepoURL: "git@github.com:t/map.git"},
+			wantErr: "repository_url must be http(s) URLs",
+		},
+		{
+			name:    "bad demo link",
+			project: model.Project{Title: "Map", DemoURL: "localhost:3000"},
+			wantErr: "demo_url must be http(s) URLs",
+		},
+		{
+			name:    "bad attachment",
+			project: model.Project{Title: "Map", Attachments: []string{"file:///etc/passwd"}},
+			wantErr: "attachments must be http(s) URLs",
+		},
+		{
+			name: "too many attachments",
+			project: model.Project{Title: "Map", Attachments: []string{
+				"https://a.example.com", "https://b.example.com", "https://c.example.com",
+				"https://d.example.com", "https://e.example.com", "https://f.example.com",
+			}},
+			wantErr: "at most 5 attachments",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.project
+			err := validateProject(&p)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if p.Title != tt.want.Title || p.Description != tt.want.Description ||
+				p.RepoURL != tt.want.RepoURL || p.DemoURL != tt.want.DemoURL ||
+				!slices.Equal(p.Attachments, tt.want.Attachments) || p.Attachments == nil {
+				t.Errorf("got %+v, want %+v", p, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+type QuestService struct {
+	questRepo  *repository.QuestRepository
+	userRepo   *repository.UserRepository
+	telegramGW *gateway.TelegramGateway
+	limits     model.AwardLimits
+	events     *EventBus
+}
+
+func NewQuestService(questRepo *repository.QuestRepository, userRepo *repository.UserRepository, telegramGW *gateway.TelegramGateway, limits model.AwardLimits, events *EventBus) *QuestService {
+	return &QuestService{questRepo: questRepo, userRepo: userRepo, telegramGW: telegramGW, limits: limits, events: events}
+}
+
+func (s *QuestService) List(ctx context.Context, userID *int64, includeClosed bool) ([]model.Quest, error) {
+	list, err := s.questRepo.List(ctx, userID, includeClosed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quests: %w", err)
+	}
+	if list == nil {
+		list = []model.Quest{}
+	}
+	return list, nil
+}
+
+func (s *QuestService) GetByID(ctx context.Context, id int64, userID *int64) (*model.Quest, error) {
+	q, err := s.questRepo.GetByID(ctx, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quest: %w", err)
+	}
+	return q, nil
+}
+
+func (s *QuestService) Create(ctx context.Context, q *model.Quest) (*model.Quest, error) {
+	if err := s.validate(q); err != nil {
+		return nil, err
+	}
+	if q.Deadline != nil && !q.Deadline.After(time.Now()) {
+		return nil, fmt.Errorf("deadline must be in the future")
+	}
+	result, err := s.questRepo.Create(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create quest: %w", err)
+	}
+	return result, nil
+}
+
+// Update returns nil if the quest does not exist. Rewards already paid are
+// not affected by a new reward amount.
+func (s *QuestService) Update(ctx context.Context, q *model.Quest) (*model.Quest, error) {
+	if err := s.validate(q); err != nil {
+		return nil, err
+	}
+	result, err := s.questRepo.Update(ctx, q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update quest: %w", err)
+	}
+	return result, nil
+}
+
+// validate checks the quest fields; rewards are held to the single award limit
+// since every approval pays them out.
+func (s *QuestService) validate(q *model.Quest) error {
+	q.Title = strings.TrimSpace(q.Title)
+	if q.Title == "" {
+		return fmt.Errorf("title is required")
+	}
+	if q.RewardCoins <= 0 {
+		return fmt.Errorf("reward must be positive")
+	}
+	if s.limits.MaxSingle > 0 && q.RewardCoins > s.limits.MaxSingle {
+		return fmt.Errorf("reward cannot exceed %d coins", s.limits.MaxSingle)
+	}
+	if q.MaxCompletions != nil && *q.MaxCompletions <= 0 {
+		return fmt.Errorf("max completions must be positive")
+	}
+	return nil
+}
+
+// ─── Submissions ─────────────────────────────────────────────────────────────
+
+// Submit records userID's proof for a quest that is still open. Proof is a
+// text, a link, or both.
+func (s *QuestService) Submit(ctx context.Context, questID, userID int64, proofText, proofURL string) (*model.QuestSubmission, error) {
+	proofText = strings.TrimSpace(proofText)
+	proofURL = strings.TrimSpace(proofURL)
+	if proofText == "" && proofURL == "" {
+		return nil, fmt.Errorf("proof text or link is required")
+	}
+	if proofURL != "" {
+		u, err := url.Parse(proofURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("proof link must be an http(s) URL")
+		}
+	}
+
+	q, err := s.questRepo.GetByID(ctx, questID, &userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get quest: %w", err)
+	}
+	if q == nil {
+		return nil, fmt.Errorf("quest not found")
+	}
+	if !q.Open(time.Now()) {
+		return nil, fmt.Errorf("quest is closed")
+	}
+
+	sub, err := s.questRepo.CreateSubmission(ctx, &model.QuestSubmission{
+		QuestID: questID, UserID: userID, ProofText: proofText, ProofURL: proofURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to submit quest: %w", err)
+	}
+	if sub == nil {
+		return nil, fmt.Errorf("you already have a %s submission for this quest", q.MyStatus)
+	}
+	return sub, nil
+}
+
+func (s *QuestService) ListSubmissions(ctx context.Context, status string, questID *int64) ([]model.QuestSubmission, error) {
+	list, err := s.questRepo.ListSubmissions(ctx, status, questID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list quest submissions: %w", err)
+	}
+	if list == nil {
+		list = []model.QuestSubmission{}
+	}
+	return list, nil
+}
+
+// Approve pays the quest reward and notifies the student. The reward counts
+// towards the reviewer's daily award cap.
+func (s *QuestService) Approve(ctx context.Context, reviewerID, submissionID int64, note string) (*model.QuestSubmission, error) {
+	sub, err := s.questRepo.ApproveSubmission(ctx, submissionID, reviewerID, strings.TrimSpace(note), s.limits.DailyCap)
+	if err != nil {
+		return nil, err
+	}
+	if sub == nil {
+		return nil, s.explainReview(ctx, reviewerID, submissionID)
+	}
+	s.events.Publish(model.EventQuestCompleted, sub.UserID, sub.ID)
+	s.notify(ctx, sub)
+	return sub, nil
+}
+
+func (s *QuestService) Reject(ctx context.Context, reviewerID, submissionID int64, note string) (*model.QuestSubmission, error) {
+	sub, err := s.questRepo.RejectSubmission(ctx, submissionID, reviewerID, strings.TrimSpace(note))
+	if err != nil {
+		return nil, fmt.Errorf("failed to reject quest submission: %w", err)
+	}
+	if sub == nil {
+		return nil, s.explainReview(ctx, reviewerID, submissionID)
+	}
+	s.notify(ctx, sub)
+	return sub, nil
+}
+
+// explainReview says why a review updated nothing.
+func (s *QuestService) explainReview(ctx context.Context, reviewerID, submissionID int64) error {
+	sub, err := s.questRepo.GetSubmission(ctx, submissionID)
+	switch {
+	case err != nil:
+		return fmt.Errorf("failed to get quest submission: %w", err)
+	case sub == nil:
+		return fmt.Errorf("quest submission not found")
+	case sub.UserID == reviewerID:
+		return fmt.Errorf("another admin has to review your submission")
+	default:
+		return fmt.Errorf("quest submission is already %s", sub.Status)
+	}
+}
+
+func (s *QuestService) notify(ctx context.Context, sub *model.QuestSubmission) {
+	u, err := s.userRepo.FindByID(ctx, sub.UserID)
+	if err != nil || u == nil {
+		return
+	}
+	var msg string
+	if sub.Status == model.QuestSubmissionApproved {
+		msg = fmt.Sprintf("✅ <b>Quest completed: %s</b>\n\nYour reward has been added to your balance.", html.EscapeString(sub.QuestTitle))
+	} else {
+		msg = fmt.Sprintf("❌ <b>Quest submission rejected: %s</b>", html.EscapeString(sub.QuestTitle))
+	}
+	if sub.ReviewNote != "" {
+		msg += "\n\n" + html.EscapeString(sub.ReviewNote)
+	}
+	go func() {
+		if err := s.telegramGW.SendMessage(u.TelegramID, msg); err != nil {
+			log.Printf("Failed to notify user %d about quest submission %d: %v", u.ID, sub.ID, err)
+		}
+	}()
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"log"
+	"strings"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+type RaffleService struct {
+	raffleRepo *repository.RaffleRepository
+	telegramGW *gateway.TelegramGateway
+}
+
+func NewRaffleService(raffleRepo *repository.RaffleRepository, telegramGW *gateway.TelegramGateway) *RaffleService {
+	return &RaffleService{raffleRepo: raffleRepo, telegramGW: telegramGW}
+}
+
+func (s *RaffleService) List(ctx context.Context, userID *int64) ([]model.Raffle, error) {
+	list, err := s.raffleRepo.List(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list raffles: %w", err)
+	}
+	if list == nil {
+		list = []model.Raffle{}
+	}
+	return list, nil
+}
+
+func (s *RaffleService) GetByID(ctx context.Context, id int64, userID *int64) (*model.Raffle, error) {
+	rf, err := s.raffleRepo.GetByID(ctx, id, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get raffle: %w", err)
+	}
+	return rf, nil
+}
+
+// Create generates the secret seed and stores its hash as the public commitment.
+func (s *RaffleService) Create(ctx context.Context, rf *model.Raffle) (*model.Raffle, error) {
+	if strings.TrimSpace(rf.Title) == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+	if rf.TicketPriceCoins <= 0 {
+		return nil, fmt.Errorf("ticket price must be positive")
+	}
+	if rf.MaxTicketsPerUser != nil && *rf.MaxTicketsPerUser <= 0 {
+		return nil, fmt.Errorf("ticket limit must be positive")
+	}
+
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, fmt.Errorf("failed to generate seed: %w", err)
+	}
+	rf.Seed = hex.EncodeToString(seed)
+	rf.SeedHash = model.SeedHash(rf.Seed)
+
+	result, err := s.raffleRepo.Create(ctx, rf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raffle: %w", err)
+	}
+	return result, nil
+}
+
+// maxTicketsPerPurchase caps one ticket purchase, which inserts a row per ticket.
+const maxTicketsPerPurchase = 100
+
+func (s *RaffleService) BuyTickets(ctx context.Context, raffleID, userID int64, quantity int) ([]model.RaffleTicket, error) {
+	if quantity <= 0 || quantity > maxTicketsPerPurchase {
+		return nil, fmt.Errorf("quantity must be between 1 and %d", maxTicketsPerPurchase)
+	}
+	return s.raffleRepo.BuyTickets(ctx, raffleID, userID, quantity)
+}
+
+// Draw closes the raffle, picks the winner and notifies them via Telegram.
+func (s *RaffleService) Draw(ctx context.Context, raffleID int64) (*model.Raffle, error) {
+	rf, err := s.raffleRepo.Draw(ctx, raffleID)
+	if err != nil {
+		return nil, err
+	}
+	if rf.Winner != nil {
+		winner := rf.Winner
+		msg := fmt.Sprintf("🎉 <b>You won the raffle \"%s\"!</b>\n\nWinning ticket: #%d\nPrize: %s",
+			html.EscapeString(rf.Title), *rf.WinningTicket, html.EscapeString(rf.Prize))
+		go func() {
+			if err := s.telegramGW.SendMessage(winner.TelegramID, msg); err != nil {
+				log.Printf("Failed to notify raffle winner %d: %v", winner.ID, err)
+			}
+		}()
+	}
+	return rf, nil
+}
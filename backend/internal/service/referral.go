@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"net/url"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+type ReferralService struct {
+	referralRepo *repository.ReferralRepository
+	userRepo     *repository.UserRepository
+	telegramGW   *gateway.TelegramGateway
+	reward       int
+	miniAppURL   string // e.g. https://t.me/<bot>/<app>; links are omitted if empty
+}
+
+func NewReferralService(referralRepo *repository.ReferralRepository, userRepo *repository.UserRepository, telegramGW *gateway.TelegramGateway, reward int, miniAppURL string) *ReferralService {
+	return &ReferralService{
+		referralRepo: referralRepo,
+		userRepo:     userRepo,
+		telegramGW:   telegramGW,
+		reward:       reward,
+		miniAppURL:   miniAppURL,
+	}
+}
+
+// Track records a referral for a user who just signed up through a deep link.
+// Start parameters that are not referrals are ignored.
+func (s *ReferralService) Track(ctx context.Context, refereeID int64, startParam string) error {
+	code, ok := model.ParseReferralStartParam(startParam)
+	if !ok {
+		return nil
+	}
+	if _, err := s.referralRepo.Create(ctx, code, refereeID); err != nil {
+		return fmt.Errorf("failed to track referral: %w", err)
+	}
+	return nil
+}
+
+// Complete rewards the referral of a user who just verified as a student and
+// tells the referrer.
+func (s *ReferralService) Complete(ctx context.Context, referee *model.User) error {
+	ref, err := s.referralRepo.Complete(ctx, referee.ID, referee.SchoolLogin, s.reward)
+	if err != nil {
+		return fmt.Errorf("failed to complete referral: %w", err)
+	}
+	if ref == nil || ref.Status != model.ReferralRewarded {
+		return nil
+	}
+
+	referrer, err := s.userRepo.FindByID(ctx, ref.ReferrerID)
+	if err != nil || referrer == nil {
+		return nil
+	}
+	name := referee.FirstName
+	if name == "" {
+		name = referee.SchoolLogin
+	}
+	msg := fmt.Sprintf("🤝 <b>%s joined through your invite!</b>\n\n+%d coins", html.EscapeString(name), ref.RewardCoins)
+	go func() {
+		if err := s.telegramGW.SendMessage(referrer.TelegramID, msg); err != nil {
+			log.Printf("Failed to notify referrer %d: %v", referrer.ID, err)
+		}
+	}()
+	return nil
+}
+
+// Summary returns userID's referral link and the users they referred.
+func (s *ReferralService) Summary(ctx context.Context, userID int64) (*model.ReferralSummary, error) {
+	code, err := s.referralRepo.Code(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get referral code: %w", err)
+	}
+	list, err := s.referralRepo.ListByReferrer(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list referrals: %w", err)
+	}
+	if list == nil {
+		list = []model.Referral{}
+	}
+
+	summary := &model.ReferralSummary{
+		Code:        code,
+		StartParam:  model.ReferralStartParam(code),
+		RewardCoins: s.reward,
+		Referrals:   list,
+	}
+	if s.miniAppURL != "" {
+		summary.Link = s.miniAppURL + "?startapp=" + url.QueryEscape(summary.StartParam)
+	}
+	return summary, nil
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
@@ -10,12 +11,13 @@ import (
 )
 
 type SchoolService struct {
-	schoolGW *gateway.SchoolGateway
-	userRepo *repository.UserRepository
+	schoolGW        *gateway.SchoolGateway
+	userRepo        *repository.UserRepository
+	referralService *ReferralService
 }
 
-func NewSchoolService(schoolGW *gateway.SchoolGateway, userRepo *repository.UserRepository) *SchoolService {
-	return &SchoolService{schoolGW: schoolGW, userRepo: userRepo}
+func NewSchoolService(schoolGW *gateway.SchoolGateway, userRepo *repository.UserRepository, referralService *ReferralService) *SchoolService {
+	return &SchoolService{schoolGW: schoolGW, userRepo: userRepo, referralService: referralService}
 }
 
 func (s *SchoolService) VerifyStudent(ctx context.Context, userID int64, username, password string) (*model.User, error) {
@@ -50,5 +52,10 @@ func (s *SchoolService) VerifyStudent(ctx context.Context, userID int64, usernam
 		return nil, fmt.Errorf("failed to update user: %w", err)
 	}
 
+	// 6. Reward the referral, if any; verification succeeds regardless
+	if err := s.referralService.Complete(ctx, user); err != nil {
+		log.Printf("Failed to complete referral for user %d: %v", user.ID, err)
+	}
+
 	return user, nil
 }
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+type SeasonService struct {
+	seasonRepo         *repository.SeasonRepository
+	leaderboardService *LeaderboardService
+	topBadges          int
+}
+
+func NewSeasonService(seasonRepo *repository.SeasonRepository, leaderboardService *LeaderboardService, topBadges int) *SeasonService {
+	return &SeasonService{seasonRepo: seasonRepo, leaderboardService: leaderboardService, topBadges: topBadges}
+}
+
+func (s *SeasonService) List(ctx context.Context) ([]model.Season, error) {
+	list, err := s.seasonRepo.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list seasons: %w", err)
+	}
+	if list == nil {
+		list = []model.Season{}
+	}
+	return list, nil
+}
+
+// Get returns a season with a page of its standings: the archive once the
+// season is closed, the live coin leaderboard for its dates before that.
+// It returns nil if the season does not exist.
+func (s *SeasonService) Get(ctx context.Context, id int64, limit, offset int) (*model.SeasonStandings, error) {
+	season, err := s.seasonRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season: %w", err)
+	}
+	if season == nil {
+		return nil, nil
+	}
+	if limit <= 0 || limit > maxLeaderboardLimit {
+		limit = 50
+	}
+	offset = max(offset, 0)
+
+	var standings []model.LeaderboardEntry
+	if season.ClosedAt != nil {
+		standings, err = s.seasonRepo.Standings(ctx, id, limit, offset)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get season standings: %w", err)
+		}
+		if standings == nil {
+			standings = []model.LeaderboardEntry{}
+		}
+		for i := range standings {
+			s.leaderboardService.setLeague(&standings[i])
+		}
+	} else {
+		q := model.LeaderboardQuery{
+			Metric: model.MetricCoins, Since: &season.StartsAt, Until: &season.EndsAt,
+			Limit: limit, Offset: offset,
+		}
+		standings, err = s.leaderboardService.list(ctx, q)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &model.SeasonStandings{Season: *season, Standings: standings}, nil
+}
+
+func (s *SeasonService) Create(ctx context.Context, season *model.Season) (*model.Season, error) {
+	if err := s.validate(ctx, season); err != nil {
+		return nil, err
+	}
+	created, err := s.seasonRepo.Create(ctx, season)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create season: %w", err)
+	}
+	return created, nil
+}
+
+// Update changes a season that has not been closed yet. It returns nil if there is no such season.
+func (s *SeasonService) Update(ctx context.Context, season *model.Season) (*model.Season, error) {
+	existing, err := s.seasonRepo.GetByID(ctx, season.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season: %w", err)
+	}
+	if existing == nil {
+		return nil, nil
+	}
+	if existing.ClosedAt != nil {
+		return nil, fmt.Errorf("closed seasons cannot be changed")
+	}
+	if err := s.validate(ctx, season); err != nil {
+		return nil, err
+	}
+	updated, err := s.seasonRepo.Update(ctx, season)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update season: %w", err)
+	}
+	if updated == nil {
+		return nil, fmt.Errorf("closed seasons cannot be changed")
+	}
+	return updated, nil
+}
+
+func (s *SeasonService) Delete(ctx context.Context, id int64) error {
+	deleted, err := s.seasonRepo.Delete(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete season: %w", err)
+	}
+	if !deleted {
+		return fmt.Errorf("season not found or already closed")
+	}
+	return nil
+}
+
+func (s *SeasonService) validate(ctx context.Context, season *model.Season) error {
+	season.Name = strings.TrimSpace(season.Name)
+	if season.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if !season.EndsAt.After(season.StartsAt) {
+		return fmt.Errorf("season must end after it starts")
+	}
+	overlaps, err := s.seasonRepo.Overlaps(ctx, season, season.ID)
+	if err != nil {
+		return fmt.Errorf("failed to check season dates: %w", err)
+	}
+	if overlaps {
+		return fmt.Errorf("season overlaps another season")
+	}
+	return nil
+}
+
+// Close ends a season now, before its scheduled end if it is still running.
+func (s *SeasonService) Close(ctx context.Context, id int64) (*model.Season, error) {
+	return s.seasonRepo.Close(ctx, id, s.topBadges)
+}
+
+// CloseDue closes every season past its end. It is run by a background job.
+func (s *SeasonService) CloseDue(ctx context.Context) error {
+	due, err := s.seasonRepo.ListDue(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list due seasons: %w", err)
+	}
+	for _, season := range due {
+		if _, err := s.seasonRepo.Close(ctx, season.ID, s.topBadges); err != nil {
+			return fmt.Errorf("failed to close season %d: %w", season.ID, err)
+		}
+		log.Printf("Closed season %d (%s) ended at %s", season.ID, season.Name, season.EndsAt.Format(time.RFC3339))
+	}
+	return nil
+}
@@ -2,17 +2,25 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
 	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
 )
 
+// maxCartQuantity caps the units of one item in a single order.
+const maxCartQuantity = 100
+
 type ShopService struct {
-	repo *repository.ShopRepository
+	repo   *repository.ShopRepository
+	events *EventBus
 }
 
-func NewShopService(repo *repository.ShopRepository) *ShopService {
-	return &ShopService{repo: repo}
+func NewShopService(repo *repository.ShopRepository, events *EventBus) *ShopService {
+	return &ShopService{repo: repo, events: events}
 }
 
 func (s *ShopService) ListItems(ctx context.Context) ([]model.ShopItem, error) {
@@ -27,6 +35,129 @@ func (s *ShopService) DeleteItem(ctx context.Context, id int64) error {
 	return s.repo.DeleteItem(ctx, id)
 }
 
-func (s *ShopService) Buy(ctx context.Context, userID, itemID int64) (*model.Purchase, error) {
-	return s.repo.Buy(ctx, userID, itemID)
+func (s *ShopService) Buy(ctx context.Context, userID, itemID int64, promoCode string) (*model.Purchase, error) {
+	purchase, err := s.repo.Buy(ctx, userID, itemID, strings.TrimSpace(promoCode))
+	if err != nil {
+		return nil, err
+	}
+	s.events.Publish(model.EventPurchased, userID, purchase.ID)
+	return purchase, nil
+}
+
+// SetSale schedules a sale price for an item; a nil price removes the sale.
+func (s *ShopService) SetSale(ctx context.Context, itemID int64, price *int, startsAt, endsAt *time.Time) (*model.ShopItem, error) {
+	if price != nil && *price < 0 {
+		return nil, fmt.Errorf("sale price must not be negative")
+	}
+	if startsAt != nil && endsAt != nil && !endsAt.After(*startsAt) {
+		return nil, fmt.Errorf("sale must end after it starts")
+	}
+	if price == nil {
+		startsAt, endsAt = nil, nil
+	}
+	item, err := s.repo.SetSale(ctx, itemID, price, startsAt, endsAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set sale: %w", err)
+	}
+	return item, nil
+}
+
+func (s *ShopService) ListPromoCodes(ctx context.Context) ([]model.PromoCode, error) {
+	list, err := s.repo.ListPromoCodes(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list promo codes: %w", err)
+	}
+	if list == nil {
+		list = []model.PromoCode{}
+	}
+	return list, nil
+}
+
+func (s *ShopService) CreatePromoCode(ctx context.Context, p *model.PromoCode) (*model.PromoCode, error) {
+	p.Code = strings.TrimSpace(p.Code)
+	if p.Code == "" {
+		return nil, fmt.Errorf("code is required")
+	}
+	switch p.DiscountType {
+	case model.DiscountPercent:
+		if p.DiscountValue <= 0 || p.DiscountValue > 100 {
+			return nil, fmt.Errorf("percent discount must be between 1 and 100")
+		}
+	case model.DiscountFixed:
+		if p.DiscountValue <= 0 {
+			return nil, fmt.Errorf("fixed discount must be positive")
+		}
+	default:
+		return nil, fmt.Errorf("discount type must be percent or fixed")
+	}
+	result, err := s.repo.CreatePromoCode(ctx, p)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate key") || strings.Contains(err.Error(), "unique constraint") {
+			return nil, fmt.Errorf("promo code already exists")
+		}
+		return nil, fmt.Errorf("failed to create promo code: %w", err)
+	}
+	return result, nil
+}
+
+func (s *ShopService) DeletePromoCode(ctx context.Context, id int64) error {
+	return s.repo.DeletePromoCode(ctx, id)
+}
+
+// Checkout merges duplicate cart lines and buys everything as one order.
+func (s *ShopService) Checkout(ctx context.Context, userID int64, items []model.CartItem) (*model.Order, error) {
+	if len(items) == 0 {
+		return nil, fmt.Errorf("cart is empty")
+	}
+	quantities := make(map[int64]int, len(items))
+	for _, item := range items {
+		if item.Quantity <= 0 || item.Quantity > maxCartQuantity {
+			return nil, fmt.Errorf("quantity must be between 1 and %d", maxCartQuantity)
+		}
+		quantities[item.ItemID] += item.Quantity
+		if quantities[item.ItemID] > maxCartQuantity {
+			return nil, fmt.Errorf("at most %d of one item per order", maxCartQuantity)
+		}
+	}
+	cart := make([]model.CartItem, 0, len(quantities))
+	for itemID, qty := range quantities {
+		cart = append(cart, model.CartItem{ItemID: itemID, Quantity: qty})
+	}
+	sort.Slice(cart, func(i, j int) bool { return cart[i].ItemID < cart[j].ItemID })
+
+	order, err := s.repo.Checkout(ctx, userID, cart)
+	if err != nil {
+		return nil, err
+	}
+	// One event per purchase row, as Buy publishes, so subscribers always
+	// get purchase ids.
+	for _, p := range order.Items {
+		s.events.Publish(model.EventPurchased, userID, p.ID)
+	}
+	return order, nil
+}
+
+func (s *ShopService) ListPurchases(ctx context.Context, f model.PurchaseFilter) ([]model.Purchase, error) {
+	list, err := s.repo.ListPurchases(ctx, f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list purchases: %w", err)
+	}
+	if list == nil {
+		list = []model.Purchase{}
+	}
+	return list, nil
+}
+
+func (s *ShopService) SalesReport(ctx context.Context, f model.PurchaseFilter, topBuyers int) (*model.SalesReport, error) {
+	report, err := s.repo.SalesReport(ctx, f, topBuyers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build sales report: %w", err)
+	}
+	if report.Items == nil {
+		report.Items = []model.ItemSales{}
+	}
+	if report.TopBuyers == nil {
+		report.TopBuyers = []model.BuyerStats{}
+	}
+	return report, nil
 }
@@ -0,0 +1,556 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"log"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+const maxTeamNameLength = 100
+
+type TeamService struct {
+	teamRepo      *repository.TeamRepository
+	hackathonRepo *repository.HackathonRepository
+	userRepo      *repository.UserRepository
+	telegramGW    *gateway.TelegramGateway
+	miniAppURL    string // e.g. https://t.me/<bot>/<app>; links are omitted if empty
+}
+
+func NewTeamService(teamRepo *repository.TeamRepository, hackathonRepo *repository.HackathonRepository, userRepo *repository.UserRepository, telegramGW *gateway.TelegramGateway, miniAppURL string) *TeamService {
+	return &TeamService{
+		teamRepo:      teamRepo,
+		hackathonRepo: hackathonRepo,
+		userRepo:      userRepo,
+		telegramGW:    telegramGW,
+		miniAppURL:    miniAppURL,
+	}
+}
+
+// Get returns a team as seen by viewer (nil for anonymous).
+func (s *TeamService) Get(ctx context.Context, viewer *model.User, teamID int64) (*model.Team, error) {
+	t, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+	if t != nil {
+		s.present(t, viewer)
+	}
+	return t, nil
+}
+
+// ListForHackathon returns the teams formed for a hackathon.
+func (s *TeamService) ListForHackathon(ctx context.Context, viewer *model.User, hackathonID int64) ([]model.Team, error) {
+	list, err := s.teamRepo.ListByHackathon(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+	if list == nil {
+		list = []model.Team{}
+	}
+	for i := range list {
+		s.present(&list[i], viewer)
+	}
+	return list, nil
+}
+
+// Mine returns the teams a user belongs to.
+func (s *TeamService) Mine(ctx context.Context, user *model.User) ([]model.Team, error) {
+	list, err := s.teamRepo.ListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list teams: %w", err)
+	}
+	if list == nil {
+		list = []model.Team{}
+	}
+	for i := range list {
+		s.present(&list[i], user)
+	}
+	return list, nil
+}
+
+// present shows the invite code and deep link to members and admins only.
+func (s *TeamService) present(t *model.Team, viewer *model.User) {
+	if viewer == nil || (viewer.Role != model.RoleAdmin && !t.HasMember(viewer.ID)) {
+		t.InviteCode = ""
+		return
+	}
+	if s.miniAppURL != "" {
+		t.InviteLink = s.miniAppURL + "?startapp=" + url.QueryEscape(model.TeamStartParam(t.InviteCode))
+	}
+}
+
+// openHackathon returns a hackathon that is taking registrations, which is
+// when teams can form.
+func (s *TeamService) openHackathon(ctx context.Context, hackathonID int64) (*model.Hackathon, error) {
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil || h.Status == model.HackathonDraft {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	if !h.RegistrationOpen(time.Now()) {
+		return nil, fmt.Errorf("registration is not open")
+	}
+	return h, nil
+}
+
+// Create starts a team captained by user. maxSize defaults to the
+// hackathon's team size limit and may not exceed it.
+func (s *TeamService) Create(ctx context.Context, user *model.User, hackathonID int64, name string, maxSize *int) (*model.Team, error) {
+	if user.Role == model.RoleGuest {
+		return nil, fmt.Errorf("verify your school account to join teams")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > maxTeamNameLength {
+		return nil, fmt.Errorf("team name must be 1-%d characters", maxTeamNameLength)
+	}
+	h, err := s.openHackathon(ctx, hackathonID)
+	if err != nil {
+		return nil, err
+	}
+	size := h.MaxTeamSize
+	if maxSize != nil {
+		size = maxSize
+	}
+	switch {
+	case size == nil:
+		return nil, fmt.Errorf("max_size is required, the hackathon has no team size limit")
+	case *size < 1:
+		return nil, fmt.Errorf("max_size must be at least 1")
+	case h.MaxTeamSize != nil && *size > *h.MaxTeamSize:
+		return nil, fmt.Errorf("max_size cannot exceed the hackathon's limit of %d", *h.MaxTeamSize)
+	}
+
+	t, err := s.teamRepo.Create(ctx, &model.Team{HackathonID: hackathonID, Name: name, CaptainID: user.ID, MaxSize: *size})
+	if err != nil {
+		return nil, err
+	}
+	s.present(t, user)
+	return t, nil
+}
+
+// Join adds user to the team with an invite code. The code may also be given
+// as the deep link start parameter.
+func (s *TeamService) Join(ctx context.Context, user *model.User, code string) (*model.Team, error) {
+	if user.Role == model.RoleGuest {
+		return nil, fmt.Errorf("verify your school account to join teams")
+	}
+	code = strings.TrimSpace(code)
+	if c, ok := model.ParseTeamStartParam(code); ok {
+		code = c
+	}
+	t, err := s.teamRepo.GetByCode(ctx, code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+	if t == nil {
+		return nil, fmt.Errorf("team not found")
+	}
+	if _, err := s.openHackathon(ctx, t.HackathonID); err != nil {
+		return nil, err
+	}
+	t, err = s.teamRepo.Join(ctx, code, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, fmt.Errorf("team not found")
+	}
+	s.present(t, user)
+
+	name := user.FirstName
+	if name == "" {
+		name = user.Username
+	}
+	s.notify(ctx, t.CaptainID, fmt.Sprintf("👥 <b>%s joined %s</b>\n\n%d of %d places taken.",
+		html.EscapeString(name), html.EscapeString(t.Name), len(t.Members), t.MaxSize))
+	return t, nil
+}
+
+// Leave removes user from a team. Once the hackathon has started rosters are final.
+func (s *TeamService) Leave(ctx context.Context, user *model.User, teamID int64) error {
+	t, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return fmt.Errorf("failed to get team: %w", err)
+	}
+	if t == nil {
+		return fmt.Errorf("team not found")
+	}
+	h, err := s.hackathonRepo.GetByID(ctx, t.HackathonID)
+	if err != nil {
+		return fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h != nil && !acceptsWithdrawals(h.Status) {
+		return fmt.Errorf("cannot leave the team, the hackathon is %s", h.Status)
+	}
+	left, promoted, err := s.teamRepo.Leave(ctx, teamID, user.ID)
+	if err != nil {
+		return err
+	}
+	if !left {
+		return fmt.Errorf("you are not on this team")
+	}
+	for i := range promoted {
+		s.notify(ctx, promoted[i].UserID, promotedMessage(&promoted[i]))
+	}
+	return nil
+}
+
+// TransferCaptain hands the team over to another member.
+func (s *TeamService) TransferCaptain(ctx context.Context, user *model.User, teamID, newCaptainID int64) (*model.Team, error) {
+	t, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+	switch {
+	case t == nil:
+		return nil, fmt.Errorf("team not found")
+	case t.CaptainID != user.ID:
+		return nil, fmt.Errorf("only the captain can hand over the team")
+	case newCaptainID == user.ID:
+		return nil, fmt.Errorf("you are already the captain")
+	case !t.HasMember(newCaptainID):
+		return nil, fmt.Errorf("the new captain must be a team member")
+	}
+	t, err = s.teamRepo.TransferCaptain(ctx, teamID, user.ID, newCaptainID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transfer captaincy: %w", err)
+	}
+	if t == nil {
+		return nil, fmt.Errorf("the team changed, try again")
+	}
+	s.present(t, user)
+	s.notify(ctx, newCaptainID, fmt.Sprintf("🧭 <b>You're now the captain of %s</b>", html.EscapeString(t.Name)))
+	return t, nil
+}
+
+func (s *TeamService) notify(ctx context.Context, userID int64, msg string) {
+	u, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil || u == nil {
+		return
+	}
+	go func() {
+		if err := s.telegramGW.SendMessage(u.TelegramID, msg); err != nil {
+			log.Printf("Failed to notify user %d about their team: %v", u.ID, err)
+		}
+	}()
+}
+
+const (
+	// defaultPoolTeamSize is used to form teams when the hackathon sets no team size limit.
+	defaultPoolTeamSize = 4
+	maxPoolTags         = 10
+	maxPoolTagLength    = 30
+	maxPoolNoteLength   = 500
+)
+
+// Pool returns a hackathon's "looking for team" pool.
+func (s *TeamService) Pool(ctx context.Context, hackathonID int64) ([]model.PoolEntry, error) {
+	list, err := s.teamRepo.ListPool(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pool: %w", err)
+	}
+	if list == nil {
+		list = []model.PoolEntry{}
+	}
+	return list, nil
+}
+
+// EnterPool puts user in the pool, or updates their skills and roles.
+func (s *TeamService) EnterPool(ctx context.Context, user *model.User, hackathonID int64, skills, roles []string, note string) (*model.PoolEntry, error) {
+	if user.Role == model.RoleGuest {
+		return nil, fmt.Errorf("verify your school account to join teams")
+	}
+	if _, err := s.openHackathon(ctx, hackathonID); err != nil {
+		return nil, err
+	}
+	skills, err := normalizeTags("skills", skills)
+	if err != nil {
+		return nil, err
+	}
+	roles, err = normalizeTags("roles", roles)
+	if err != nil {
+		return nil, err
+	}
+	note = strings.TrimSpace(note)
+	if len([]rune(note)) > maxPoolNoteLength {
+		return nil, fmt.Errorf("note must be at most %d characters", maxPoolNoteLength)
+	}
+	return s.teamRepo.EnterPool(ctx, &model.PoolEntry{
+		HackathonID: hackathonID, UserID: user.ID, Skills: skills, Roles: roles, Note: note,
+	})
+}
+
+// LeavePool takes user out of the pool.
+func (s *TeamService) LeavePool(ctx context.Context, user *model.User, hackathonID int64) error {
+	left, err := s.teamRepo.LeavePool(ctx, hackathonID, user.ID)
+	if err != nil {
+		return fmt.Errorf("failed to leave pool: %w", err)
+	}
+	if !left {
+		return fmt.Errorf("you are not in the pool")
+	}
+	return nil
+}
+
+// normalizeTags lowercases, trims and deduplicates skills or roles.
+func normalizeTags(field string, tags []string) ([]string, error) {
+	result := []string{}
+	seen := map[string]bool{}
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		if len([]rune(t)) > maxPoolTagLength {
+			return nil, fmt.Errorf("%s must be at most %d characters each", field, maxPoolTagLength)
+		}
+		seen[t] = true
+		result = append(result, t)
+	}
+	if len(result) > maxPoolTags {
+		return nil, fmt.Errorf("at most %d %s", maxPoolTags, field)
+	}
+	return result, nil
+}
+
+// poolTeamSize resolves the team size for matchmaking: size if given, else
+// the hackathon's limit, else defaultPoolTeamSize.
+func poolTeamSize(h *model.Hackathon, size *int) (int, error) {
+	n := defaultPoolTeamSize
+	if h.MaxTeamSize != nil {
+		n = *h.MaxTeamSize
+	}
+	if size != nil {
+		n = *size
+	}
+	switch {
+	case n < 2:
+		return 0, fmt.Errorf("teams need at least 2 members")
+	case h.MaxTeamSize != nil && n > *h.MaxTeamSize:
+		return 0, fmt.Errorf("size cannot exceed the hackathon's limit of %d", *h.MaxTeamSize)
+	}
+	return n, nil
+}
+
+// SuggestTeams proposes balanced teams of up to size members from the pool.
+func (s *TeamService) SuggestTeams(ctx context.Context, hackathonID int64, size *int) ([]model.TeamSuggestion, error) {
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	n, err := poolTeamSize(h, size)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.teamRepo.ListPool(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pool: %w", err)
+	}
+	return suggestTeams(matchable(pool), n, teamLimit(h)), nil
+}
+
+// FormTeams creates the suggested teams in one go and tells the members.
+// Teams can be formed until the hackathon starts. The new teams have no
+// application; their captains apply for them.
+func (s *TeamService) FormTeams(ctx context.Context, hackathonID int64, size *int) ([]model.Team, error) {
+	h, err := s.hackathonRepo.GetByID(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hackathon: %w", err)
+	}
+	if h == nil {
+		return nil, fmt.Errorf("hackathon not found")
+	}
+	if !acceptsWithdrawals(h.Status) {
+		return nil, fmt.Errorf("cannot form teams, the hackathon is %s", h.Status)
+	}
+	n, err := poolTeamSize(h, size)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := s.teamRepo.ListPool(ctx, hackathonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pool: %w", err)
+	}
+	var groups [][]int64
+	for _, sug := range suggestTeams(matchable(pool), n, teamLimit(h)) {
+		ids := make([]int64, len(sug.Members))
+		for i, m := range sug.Members {
+			ids[i] = m.UserID
+		}
+		groups = append(groups, ids)
+	}
+	teams, err := s.teamRepo.FormTeams(ctx, hackathonID, groups, n)
+	if err != nil {
+		return nil, fmt.Errorf("failed to form teams: %w", err)
+	}
+	if teams == nil {
+		teams = []model.Team{}
+	}
+	for _, t := range teams {
+		msg := fmt.Sprintf("🤝 <b>You've been matched into %s</b> for %s\n\n%s",
+			html.EscapeString(t.Name), html.EscapeString(h.Title), teamRoster(&t))
+		for _, m := range t.Members {
+			s.notify(ctx, m.UserID, msg)
+		}
+	}
+	return teams, nil
+}
+
+// teamRoster lists the members of a team, captain first.
+func teamRoster(t *model.Team) string {
+	var b strings.Builder
+	for _, m := range t.Members {
+		name := fmt.Sprint(m.UserID)
+		if m.User != nil {
+			name = m.User.FirstName
+			if m.User.Username != "" {
+				name += " (@" + m.User.Username + ")"
+			}
+		}
+		b.WriteString("• " + html.EscapeString(name))
+		if m.UserID == t.CaptainID {
+			b.WriteString(" — captain")
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// matchable leaves out pool entries of students with an individual
+// application, who would have to withdraw it before joining a team.
+func matchable(pool []model.PoolEntry) []model.PoolEntry {
+	var list []model.PoolEntry
+	for _, e := range pool {
+		if !e.Applied {
+			list = append(list, e)
+		}
+	}
+	return list
+}
+
+// teamLimit is the hackathon's team size limit, or 0 if it has none.
+func teamLimit(h *model.Hackathon) int {
+	if h.MaxTeamSize == nil {
+		return 0
+	}
+	return *h.MaxTeamSize
+}
+
+// suggestTeams splits the pool into as few teams of at most size as
+// possible, with sizes differing by at most one. Students are placed from
+// the highest school level down, always onto one of the smallest teams, so
+// strong students spread out; among those, the team gaining the most new
+// roles and skills wins, then the one with the lowest total level. Nobody is
+// left alone: where an even split would leave someone on their own, they join
+// another team as an extra member if the hackathon's limit (0 for none)
+// allows, and otherwise the lowest-level student stays in the pool. A pool of
+// one yields no suggestions.
+func suggestTeams(pool []model.PoolEntry, size, limit int) []model.TeamSuggestion {
+	if len(pool) < 2 || size < 2 {
+		return []model.TeamSuggestion{}
+	}
+	sorted := make([]model.PoolEntry, len(pool))
+	copy(sorted, pool)
+	sort.SliceStable(sorted, func(i, j int) bool { return poolLevel(&sorted[i]) > poolLevel(&sorted[j]) })
+
+	n := (len(sorted) + size - 1) / size
+	if len(sorted) < 2*n {
+		// Only happens with size 2 and an odd pool: one student is left over.
+		n--
+		if limit > 0 && size+1 > limit {
+			sorted = sorted[:len(sorted)-1]
+		}
+	}
+
+	type draft struct {
+		members []model.PoolEntry
+		level   int
+		skills  map[string]bool
+		roles   map[string]bool
+	}
+	teams := make([]draft, n)
+	for i := range teams {
+		teams[i].skills = map[string]bool{}
+		teams[i].roles = map[string]bool{}
+	}
+	for _, e := range sorted {
+		best, bestGain := -1, 0
+		for i := range teams {
+			t := &teams[i]
+			// Roles count double: a team needs a spread of roles more than of tools.
+			gain := 0
+			for _, r := range e.Roles {
+				if !t.roles[r] {
+					gain += 2
+				}
+			}
+			for _, sk := range e.Skills {
+				if !t.skills[sk] {
+					gain++
+				}
+			}
+			if best >= 0 {
+				b := &teams[best]
+				switch {
+				case len(t.members) > len(b.members):
+					continue
+				case len(t.members) == len(b.members) && gain < bestGain:
+					continue
+				case len(t.members) == len(b.members) && gain == bestGain && t.level >= b.level:
+					continue
+				}
+			}
+			best, bestGain = i, gain
+		}
+		t := &teams[best]
+		t.members = append(t.members, e)
+		t.level += poolLevel(&e)
+		for _, r := range e.Roles {
+			t.roles[r] = true
+		}
+		for _, sk := range e.Skills {
+			t.skills[sk] = true
+		}
+	}
+
+	result := make([]model.TeamSuggestion, len(teams))
+	for i, t := range teams {
+		result[i] = model.TeamSuggestion{
+			Members:      t.members,
+			AverageLevel: float64(t.level) / float64(len(t.members)),
+			Skills:       sortedKeys(t.skills),
+			Roles:        sortedKeys(t.roles),
+		}
+	}
+	return result
+}
+
+func poolLevel(e *model.PoolEntry) int {
+	if e.User == nil {
+		return 0
+	}
+	return e.User.SchoolLevel
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
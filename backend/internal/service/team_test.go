@@ -0,0 +1,127 @@
+package service
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+)
+
+// poolOf builds a pool with one student per level, user ids counting from 1.
+func poolOf(levels ...int) []model.PoolEntry {
+	pool := make([]model.PoolEntry, len(levels))
+	for i, l := range levels {
+		id := int64(i + 1)
+		pool[i] = model.PoolEntry{UserID: id, User: &model.User{ID: id, SchoolLevel: l}}
+	}
+	return pool
+}
+
+func memberIDs(t model.TeamSuggestion) []int64 {
+	ids := make([]int64, len(t.Members))
+	for i, m := range t.Members {
+		ids[i] = m.UserID
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func TestSuggestTeamsSizes(t *testing.T) {
+	tests := []struct {
+		name    string
+		pool    int
+		size    int
+		limit   int
+		want    []int // team sizes, largest first
+		dropped int   // students left in the pool
+	}{
+		{name: "empty pool", pool: 0, size: 3, want: []int{}},
+		{name: "pool of one", pool: 1, size: 3, want: []int{}},
+		{name: "size below two", pool: 4, size: 1, want: []int{}},
+		{name: "exact fit", pool: 4, size: 2, want: []int{2, 2}},
+		{name: "smaller than size", pool: 2, size: 4, want: []int{2}},
+		{name: "uneven split", pool: 7, size: 3, want: []int{3, 2, 2}},
+		{name: "uneven split of four", pool: 10, size: 4, want: []int{4, 3, 3}},
+		{name: "odd pool joins a pair", pool: 3, size: 2, want: []int{3}},
+		{name: "odd pool joins a pair under a looser limit", pool: 5, size: 2, limit: 3, want: []int{3, 2}},
+		{name: "odd pool at the limit stays out", pool: 5, size: 2, limit: 2, want: []int{2, 2}, dropped: 1},
+		{name: "three at the limit", pool: 3, size: 2, limit: 2, want: []int{2}, dropped: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			levels := make([]int, tt.pool)
+			for i := range levels {
+				levels[i] = tt.pool - i
+			}
+			teams := suggestTeams(poolOf(levels...), tt.size, tt.limit)
+
+			sizes := make([]int, len(teams))
+			placed := 0
+			seen := map[int64]bool{}
+			for i, team := range teams {
+				sizes[i] = len(team.Members)
+				placed += len(team.Members)
+				for _, id := range memberIDs(team) {
+					if seen[id] {
+						t.Fatalf("student %d is in two teams", id)
+					}
+					seen[id] = true
+				}
+			}
+			slices.SortFunc(sizes, func(a, b int) int { return b - a })
+			if !slices.Equal(sizes, tt.want) {
+				t.Fatalf("team sizes = %v, want %v", sizes, tt.want)
+			}
+			for _, s := range sizes {
+				if s < 2 {
+					t.Fatalf("suggested a team of %d", s)
+				}
+			}
+			if len(teams) > 0 && tt.pool-placed != tt.dropped {
+				t.Fatalf("%d students left out, want %d", tt.pool-placed, tt.dropped)
+			}
+			if tt.dropped > 0 && seen[int64(tt.pool)] {
+				t.Fatalf("the lowest-level student was placed instead of left out")
+			}
+		})
+	}
+}
+
+func TestSuggestTeamsSpreadsLevels(t *testing.T) {
+	teams := suggestTeams(poolOf(10, 9, 2, 1), 2, 0)
+	got := [][]int64{memberIDs(teams[0]), memberIDs(teams[1])}
+	want := [][]int64{{1, 4}, {2, 3}}
+	for i := range want {
+		if !slices.Equal(got[i], want[i]) {
+			t.Fatalf("teams = %v, want %v", got, want)
+		}
+	}
+	for _, team := range teams {
+		if team.AverageLevel != 5.5 {
+			t.Errorf("average level = %v, want 5.5", team.AverageLevel)
+		}
+	}
+}
+
+func TestSuggestTeamsSpreadsRoles(t *testing.T) {
+	pool := poolOf(5, 5, 5, 5)
+	pool[0].Roles = []string{"backend"}
+	pool[1].Roles = []string{"design"}
+	pool[2].Roles = []string{"backend"}
+	pool[3].Roles = []string{"design"}
+	pool[0].Skills = []string{"go"}
+	pool[2].Skills = []string{"sql", "go"}
+
+	teams := suggestTeams(pool, 2, 0)
+	if len(teams) != 2 {
+		t.Fatalf("got %d teams, want 2", len(teams))
+	}
+	for _, team := range teams {
+		if !slices.Equal(team.Roles, []string{"backend", "design"}) {
+			t.Errorf("team %v covers roles %v, want backend and design", memberIDs(team), team.Roles)
+		}
+	}
+	if !slices.Equal(teams[1].Skills, []string{"go", "sql"}) {
+		t.Errorf("skills = %v, want sorted go, sql", teams[1].Skills)
+	}
+}
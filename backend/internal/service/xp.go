@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/gateway"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/model"
+	"github.com/tomorrow-school/ts-hackathon/backend/internal/repository"
+)
+
+// xpRewards is the community XP granted per domain event. Grants are keyed by
+// the event's ref id, so e.g. leaving and rejoining a club earns nothing.
+// migrations/016_community_xp.sql backfills history with the same amounts.
+var xpRewards = map[string]int{
+	model.EventCheckedIn:        10,
+	model.EventQuestCompleted:   25,
+	model.EventHackathonApplied: 15,
+	model.EventClubJoined:       5,
+}
+
+type XPService struct {
+	xpRepo     *repository.XPRepository
+	userRepo   *repository.UserRepository
+	telegramGW *gateway.TelegramGateway
+	levels     model.CommunityLevels
+}
+
+func NewXPService(xpRepo *repository.XPRepository, userRepo *repository.UserRepository, telegramGW *gateway.TelegramGateway, levels model.CommunityLevels) *XPService {
+	return &XPService{xpRepo: xpRepo, userRepo: userRepo, telegramGW: telegramGW, levels: levels}
+}
+
+// Sync brings stored levels in line with the configured thresholds.
+func (s *XPService) Sync(ctx context.Context) error {
+	if err := s.xpRepo.SyncLevels(ctx, s.levels); err != nil {
+		return fmt.Errorf("failed to sync community levels: %w", err)
+	}
+	return nil
+}
+
+// HandleEvent grants the XP for e, if any, and congratulates the user on a level-up.
+func (s *XPService) HandleEvent(ctx context.Context, e model.DomainEvent) error {
+	amount, ok := xpRewards[e.Kind]
+	if !ok {
+		return nil
+	}
+	xp, granted, err := s.xpRepo.Grant(ctx, e.UserID, e.Kind, e.RefID, amount, s.levels)
+	if err != nil {
+		return fmt.Errorf("failed to grant community xp: %w", err)
+	}
+	if !granted {
+		return nil
+	}
+	if level := s.levels.Level(xp); level > s.levels.Level(xp-int64(amount)) {
+		s.notifyLevelUp(ctx, e.UserID, level)
+	}
+	return nil
+}
+
+// NextLevelXP returns the XP needed for the level after xp's, or nil at the top level.
+func (s *XPService) NextLevelXP(xp int64) *int64 {
+	return s.levels.NextLevelXP(xp)
+}
+
+func (s *XPService) notifyLevelUp(ctx context.Context, userID int64, level int) {
+	u, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil || u == nil {
+		return
+	}
+	msg := fmt.Sprintf("⭐ <b>Level up!</b>\n\nYou reached community level %d.", level)
+	go func() {
+		if err := s.telegramGW.SendMessage(u.TelegramID, msg); err != nil {
+			log.Printf("Failed to notify user %d about level %d: %v", u.ID, level, err)
+		}
+	}()
+}
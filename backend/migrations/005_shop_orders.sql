@@ -0,0 +1,22 @@
+-- Orders group several purchases made in a single checkout
+CREATE TABLE IF NOT EXISTS orders (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    total_coins INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_orders_user ON orders (user_id);
+
+-- Purchases become order line items; price_coins is what was paid for the whole line
+ALTER TABLE purchases
+    ADD COLUMN IF NOT EXISTS order_id BIGINT REFERENCES orders(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS quantity INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS price_coins INTEGER NOT NULL DEFAULT 0;
+
+-- Backfill historical single-item purchases with the current item price
+UPDATE purchases p SET price_coins = si.price_coins
+FROM shop_items si
+WHERE si.id = p.item_id AND p.price_coins = 0;
+
+CREATE INDEX IF NOT EXISTS idx_purchases_order ON purchases (order_id);
@@ -0,0 +1,2 @@
+-- Date-range filters on purchase history and sales reports
+CREATE INDEX IF NOT EXISTS idx_purchases_created ON purchases (created_at);
@@ -0,0 +1,24 @@
+-- Timed sale prices on shop items (applies while NOW() is inside the window)
+ALTER TABLE shop_items
+    ADD COLUMN IF NOT EXISTS sale_price_coins INTEGER,
+    ADD COLUMN IF NOT EXISTS sale_starts_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS sale_ends_at TIMESTAMPTZ;
+
+-- Promo codes: percent or fixed coin discounts, optionally limited to one item
+CREATE TABLE IF NOT EXISTS promo_codes (
+    id BIGSERIAL PRIMARY KEY,
+    code TEXT NOT NULL UNIQUE,
+    discount_type TEXT NOT NULL CHECK (discount_type IN ('percent', 'fixed')),
+    discount_value INTEGER NOT NULL CHECK (discount_value > 0),
+    item_id BIGINT REFERENCES shop_items(id) ON DELETE CASCADE,
+    max_uses INTEGER,           -- NULL = unlimited
+    max_uses_per_user INTEGER,  -- NULL = unlimited
+    used_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ,     -- NULL = never expires
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Record which code was used and how many coins it saved
+ALTER TABLE purchases
+    ADD COLUMN IF NOT EXISTS promo_code_id BIGINT REFERENCES promo_codes(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS discount_coins INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,29 @@
+-- Coin raffles: the seed hash is published on creation, the seed itself on draw
+CREATE TABLE IF NOT EXISTS raffles (
+    id BIGSERIAL PRIMARY KEY,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    prize TEXT NOT NULL DEFAULT '',
+    image_url TEXT NOT NULL DEFAULT '',
+    ticket_price_coins INTEGER NOT NULL CHECK (ticket_price_coins > 0),
+    max_tickets_per_user INTEGER,  -- NULL = unlimited
+    status TEXT NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'drawn')),
+    seed TEXT NOT NULL,
+    seed_hash TEXT NOT NULL,
+    winning_ticket INTEGER,
+    winner_id BIGINT REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    drawn_at TIMESTAMPTZ
+);
+
+-- One row per ticket, numbered from 1 in purchase order within a raffle
+CREATE TABLE IF NOT EXISTS raffle_tickets (
+    id BIGSERIAL PRIMARY KEY,
+    raffle_id BIGINT NOT NULL REFERENCES raffles(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id),
+    ticket_number INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (raffle_id, ticket_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_raffle_tickets_user ON raffle_tickets (raffle_id, user_id);
@@ -0,0 +1,36 @@
+-- Append-only history of coin balance changes (positive = credit, negative = debit).
+-- ref_id points at the row that caused the change, e.g. the attendance or purchase id.
+CREATE TABLE IF NOT EXISTS coin_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount INTEGER NOT NULL,
+    kind TEXT NOT NULL,
+    ref_id BIGINT,
+    counterparty_id BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_coin_ledger_user ON coin_ledger (user_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_coin_ledger_kind_ref ON coin_ledger (kind, ref_id);
+
+-- Backfill history recorded before the ledger existed (safe to re-run)
+INSERT INTO coin_ledger (user_id, amount, kind, ref_id, note, created_at)
+SELECT a.user_id, a.coins_awarded, 'attendance', a.id, a.event_name, a.created_at
+FROM attendance a
+WHERE a.coins_awarded <> 0
+  AND NOT EXISTS (SELECT 1 FROM coin_ledger l WHERE l.kind = 'attendance' AND l.ref_id = a.id);
+
+INSERT INTO coin_ledger (user_id, amount, kind, ref_id, note, created_at)
+SELECT p.user_id, -p.price_coins, 'purchase', p.id, si.name, p.created_at
+FROM purchases p
+JOIN shop_items si ON si.id = p.item_id
+WHERE p.price_coins <> 0
+  AND NOT EXISTS (SELECT 1 FROM coin_ledger l WHERE l.kind = 'purchase' AND l.ref_id = p.id);
+
+INSERT INTO coin_ledger (user_id, amount, kind, ref_id, note, created_at)
+SELECT t.user_id, -COUNT(*) * rf.ticket_price_coins, 'raffle_ticket', rf.id, COUNT(*) || ' × ' || rf.title, MAX(t.created_at)
+FROM raffle_tickets t
+JOIN raffles rf ON rf.id = t.raffle_id
+WHERE NOT EXISTS (SELECT 1 FROM coin_ledger l WHERE l.kind = 'raffle_ticket' AND l.ref_id = rf.id AND l.user_id = t.user_id)
+GROUP BY t.user_id, rf.id, rf.title, rf.ticket_price_coins;
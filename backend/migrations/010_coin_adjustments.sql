@@ -0,0 +1,9 @@
+-- Admin who caused a ledger entry (manual adjustments, awards)
+ALTER TABLE coin_ledger
+    ADD COLUMN IF NOT EXISTS actor_id BIGINT REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_coin_ledger_actor ON coin_ledger (actor_id, created_at);
+
+-- Bulk awards resolve users by school login as well as Telegram username
+CREATE INDEX IF NOT EXISTS idx_users_username_lower ON users (LOWER(username));
+CREATE INDEX IF NOT EXISTS idx_users_school_login_lower ON users (LOWER(school_login));
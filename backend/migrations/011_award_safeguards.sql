@@ -0,0 +1,36 @@
+-- Admin who performed a check-in
+ALTER TABLE attendance
+    ADD COLUMN IF NOT EXISTS awarded_by BIGINT REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_attendance_awarded_by ON attendance (awarded_by, user_id, created_at);
+
+-- Awards above the approval threshold wait here for a second admin
+CREATE TABLE IF NOT EXISTS coin_award_requests (
+    id BIGSERIAL PRIMARY KEY,
+    kind TEXT NOT NULL CHECK (kind IN ('attendance', 'admin_adjustment')),
+    requested_by BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    amount INTEGER NOT NULL,
+    reason TEXT NOT NULL,  -- event name for check-ins
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    reviewed_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_coin_award_requests_status ON coin_award_requests (status, created_at);
+
+-- Alerts raised by the anomaly scanner; fingerprint de-duplicates repeated findings
+CREATE TABLE IF NOT EXISTS admin_alerts (
+    id BIGSERIAL PRIMARY KEY,
+    kind TEXT NOT NULL,
+    message TEXT NOT NULL,
+    admin_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+    user_id BIGINT REFERENCES users(id) ON DELETE CASCADE,
+    fingerprint TEXT NOT NULL UNIQUE,
+    resolved_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_alerts_open ON admin_alerts (created_at) WHERE resolved_at IS NULL;
@@ -0,0 +1,42 @@
+-- Leaderboard seasons; points earned between starts_at and ends_at count towards the season
+CREATE TABLE IF NOT EXISTS seasons (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    carry_over_coins BOOLEAN NOT NULL DEFAULT TRUE,  -- false: balances reset to 0 at close
+    closed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_seasons_open ON seasons (ends_at) WHERE closed_at IS NULL;
+
+-- Final standings archived when a season closes
+CREATE TABLE IF NOT EXISTS season_standings (
+    season_id BIGINT NOT NULL REFERENCES seasons(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rank INTEGER NOT NULL,
+    score BIGINT NOT NULL,
+    school_level INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (season_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_season_standings_rank ON season_standings (season_id, rank);
+
+-- Badges shown on profiles
+CREATE TABLE IF NOT EXISTS badges (
+    id BIGSERIAL PRIMARY KEY,
+    code VARCHAR(100) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    icon VARCHAR(50) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS user_badges (
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    badge_id BIGINT NOT NULL REFERENCES badges(id) ON DELETE CASCADE,
+    awarded_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, badge_id)
+);
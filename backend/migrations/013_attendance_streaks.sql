@@ -0,0 +1,8 @@
+-- Weekly attendance streaks: coins_awarded includes the streak multiplier, base_coins is what the admin entered
+ALTER TABLE attendance
+    ADD COLUMN IF NOT EXISTS base_coins INTEGER,
+    ADD COLUMN IF NOT EXISTS multiplier DOUBLE PRECISION NOT NULL DEFAULT 1;
+
+UPDATE attendance SET base_coins = coins_awarded WHERE base_coins IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_attendance_user_created ON attendance (user_id, created_at);
@@ -0,0 +1,31 @@
+-- Quests: tasks posted by admins that pay coins once a submission is approved
+CREATE TABLE IF NOT EXISTS quests (
+    id BIGSERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    reward_coins INTEGER NOT NULL CHECK (reward_coins > 0),
+    deadline TIMESTAMPTZ,
+    max_completions INTEGER CHECK (max_completions > 0),  -- NULL = unlimited
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS quest_submissions (
+    id BIGSERIAL PRIMARY KEY,
+    quest_id BIGINT NOT NULL REFERENCES quests(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    proof_text TEXT NOT NULL DEFAULT '',
+    proof_url TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    review_note TEXT NOT NULL DEFAULT '',
+    reviewed_by BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A student may resubmit after a rejection, but holds at most one open or approved submission per quest
+CREATE UNIQUE INDEX IF NOT EXISTS idx_quest_submissions_active
+    ON quest_submissions (quest_id, user_id) WHERE status IN ('pending', 'approved');
+CREATE INDEX IF NOT EXISTS idx_quest_submissions_status ON quest_submissions (status, created_at);
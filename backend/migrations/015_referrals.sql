@@ -0,0 +1,23 @@
+-- Referral codes shared as Mini App deep links (startapp=ref_<code>)
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS referral_code VARCHAR(32) UNIQUE
+        DEFAULT substr(md5(random()::text || clock_timestamp()::text), 1, 10);
+
+-- A referral is tracked when a new user opens the app through a link and
+-- rewarded once they verify as a student
+CREATE TABLE IF NOT EXISTS referrals (
+    id BIGSERIAL PRIMARY KEY,
+    referrer_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    referee_id BIGINT NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'rewarded', 'rejected')),
+    school_login VARCHAR(255),  -- set on reward; lower-cased
+    note TEXT NOT NULL DEFAULT '',  -- why a referral was rejected
+    reward_coins INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    rewarded_at TIMESTAMPTZ,
+    CHECK (referrer_id <> referee_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_referrals_referrer ON referrals (referrer_id, created_at);
+-- Each school login can be referred only once
+CREATE UNIQUE INDEX IF NOT EXISTS idx_referrals_school_login ON referrals (school_login) WHERE status = 'rewarded';
@@ -0,0 +1,35 @@
+-- Community XP: a non-spendable engagement counter, separate from coins
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS community_xp BIGINT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS community_level INTEGER NOT NULL DEFAULT 1;  -- derived from community_xp at startup and on every grant
+
+-- One row per XP grant; (user_id, source, ref_id) keeps grants idempotent
+CREATE TABLE IF NOT EXISTS community_xp_events (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    source TEXT NOT NULL,  -- domain event kind, e.g. attendance.checked_in
+    ref_id BIGINT NOT NULL,
+    amount INTEGER NOT NULL CHECK (amount > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, source, ref_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_community_xp_events_user ON community_xp_events (user_id, created_at);
+
+-- Backfill from past activity; amounts match xpRewards in internal/service/xp.go
+INSERT INTO community_xp_events (user_id, source, ref_id, amount, created_at)
+SELECT user_id, 'attendance.checked_in', id, 10, created_at FROM attendance
+ON CONFLICT DO NOTHING;
+INSERT INTO community_xp_events (user_id, source, ref_id, amount, created_at)
+SELECT user_id, 'quest.completed', id, 25, COALESCE(reviewed_at, created_at) FROM quest_submissions WHERE status = 'approved'
+ON CONFLICT DO NOTHING;
+INSERT INTO community_xp_events (user_id, source, ref_id, amount, created_at)
+SELECT user_id, 'hackathon.applied', id, 15, created_at FROM hackathon_applications
+ON CONFLICT DO NOTHING;
+INSERT INTO community_xp_events (user_id, source, ref_id, amount, created_at)
+SELECT user_id, 'club.joined', club_id, 5, joined_at FROM club_members
+ON CONFLICT DO NOTHING;
+
+UPDATE users u SET community_xp = x.total
+FROM (SELECT user_id, SUM(amount) AS total FROM community_xp_events GROUP BY user_id) x
+WHERE x.user_id = u.id AND u.community_xp <> x.total;
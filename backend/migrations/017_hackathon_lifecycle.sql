@@ -0,0 +1,21 @@
+-- Hackathon lifecycle: draft -> registration_open -> registration_closed -> running -> judging -> finished,
+-- or cancelled from any state before judging
+ALTER TABLE hackathons
+    ADD COLUMN IF NOT EXISTS registration_opens_at TIMESTAMPTZ,   -- NULL: opened by hand
+    ADD COLUMN IF NOT EXISTS registration_closes_at TIMESTAMPTZ,  -- NULL: open until start_date
+    ADD COLUMN IF NOT EXISTS status_changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- Map the old active/past flags onto the lifecycle by date
+UPDATE hackathons SET status = CASE
+        WHEN status = 'past' OR end_date <= NOW() THEN 'finished'
+        WHEN start_date <= NOW() THEN 'running'
+        ELSE 'registration_open'
+    END
+WHERE status IN ('active', 'past');
+
+ALTER TABLE hackathons ALTER COLUMN status SET DEFAULT 'draft';
+ALTER TABLE hackathons DROP CONSTRAINT IF EXISTS hackathons_status_check;
+ALTER TABLE hackathons ADD CONSTRAINT hackathons_status_check CHECK (status IN (
+    'draft', 'registration_open', 'registration_closed', 'running', 'judging', 'finished', 'cancelled'));
+
+CREATE INDEX IF NOT EXISTS idx_hackathons_status ON hackathons (status);
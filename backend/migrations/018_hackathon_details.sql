@@ -0,0 +1,9 @@
+-- Editable hackathon details shown on the event page
+ALTER TABLE hackathons
+    ADD COLUMN IF NOT EXISTS location TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS prizes TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS rules TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS max_team_size INTEGER CHECK (max_team_size > 0),  -- NULL = no limit
+    ADD COLUMN IF NOT EXISTS cover_image_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS organizer_contacts TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
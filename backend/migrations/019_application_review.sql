@@ -0,0 +1,11 @@
+-- Admin review of hackathon applications
+ALTER TABLE hackathon_applications
+    ADD COLUMN IF NOT EXISTS review_message TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS reviewed_by INT REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS reviewed_at TIMESTAMPTZ;
+
+ALTER TABLE hackathon_applications DROP CONSTRAINT IF EXISTS hackathon_applications_status_check;
+ALTER TABLE hackathon_applications ADD CONSTRAINT hackathon_applications_status_check
+    CHECK (status IN ('pending', 'approved', 'rejected', 'waitlisted'));
+
+CREATE INDEX IF NOT EXISTS idx_hackathon_applications_user ON hackathon_applications (user_id);
@@ -0,0 +1,12 @@
+-- Participant cap per hackathon; applicants beyond it join a waitlist
+ALTER TABLE hackathons
+    ADD COLUMN IF NOT EXISTS max_participants INTEGER CHECK (max_participants > 0);  -- NULL = no limit
+
+ALTER TABLE hackathon_applications
+    ADD COLUMN IF NOT EXISTS waitlisted_at TIMESTAMPTZ;  -- waitlist order
+
+UPDATE hackathon_applications SET waitlisted_at = COALESCE(reviewed_at, created_at)
+WHERE status = 'waitlisted' AND waitlisted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_hackathon_applications_waitlist
+    ON hackathon_applications (hackathon_id, waitlisted_at, id) WHERE status = 'waitlisted';
@@ -0,0 +1,31 @@
+-- Teams formed for a hackathon; students join with an invite code or a
+-- Mini App deep link (startapp=team_<code>)
+CREATE TABLE IF NOT EXISTS teams (
+    id BIGSERIAL PRIMARY KEY,
+    hackathon_id INT NOT NULL REFERENCES hackathons(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    captain_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    invite_code VARCHAR(32) NOT NULL UNIQUE
+        DEFAULT substr(md5(random()::text || clock_timestamp()::text), 1, 8),
+    max_size INTEGER NOT NULL CHECK (max_size > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_teams_name ON teams (hackathon_id, lower(name));
+
+-- hackathon_id is copied from the team so a student is in at most one team per hackathon
+CREATE TABLE IF NOT EXISTS team_members (
+    team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    hackathon_id INT NOT NULL REFERENCES hackathons(id) ON DELETE CASCADE,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (team_id, user_id),
+    UNIQUE (hackathon_id, user_id)
+);
+
+-- A team applies once, through its captain's application row
+ALTER TABLE hackathon_applications
+    ADD COLUMN IF NOT EXISTS team_id BIGINT REFERENCES teams(id) ON DELETE CASCADE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_hackathon_applications_team
+    ON hackathon_applications (team_id) WHERE team_id IS NOT NULL;
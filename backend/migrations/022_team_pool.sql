@@ -0,0 +1,12 @@
+-- "Looking for team" pool: students without a team list their skills and
+-- preferred roles, and organisers form teams from it
+CREATE TABLE IF NOT EXISTS team_pool (
+    hackathon_id INT NOT NULL REFERENCES hackathons(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    skills TEXT[] NOT NULL DEFAULT '{}',
+    roles TEXT[] NOT NULL DEFAULT '{}',
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (hackathon_id, user_id)
+);
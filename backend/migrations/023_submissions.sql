@@ -0,0 +1,19 @@
+-- Project submissions, one per team, editable until the submission deadline
+ALTER TABLE hackathons
+    ADD COLUMN IF NOT EXISTS submission_deadline TIMESTAMPTZ;  -- NULL = end_date
+
+CREATE TABLE IF NOT EXISTS hackathon_submissions (
+    id BIGSERIAL PRIMARY KEY,
+    hackathon_id INT NOT NULL REFERENCES hackathons(id) ON DELETE CASCADE,
+    team_id BIGINT NOT NULL UNIQUE REFERENCES teams(id) ON DELETE CASCADE,
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    repo_url TEXT NOT NULL DEFAULT '',
+    demo_url TEXT NOT NULL DEFAULT '',
+    attachments TEXT[] NOT NULL DEFAULT '{}',  -- links to slides, videos, etc.
+    updated_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_hackathon_submissions_hackathon ON hackathon_submissions (hackathon_id);
@@ -0,0 +1,51 @@
+-- Judging: per-hackathon judges, a weighted rubric, judge-to-project
+-- assignments, scores and published placements
+CREATE TABLE IF NOT EXISTS hackathon_judges (
+    hackathon_id INT NOT NULL REFERENCES hackathons(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    added_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (hackathon_id, user_id)
+);
+
+CREATE TABLE IF NOT EXISTS judging_criteria (
+    id BIGSERIAL PRIMARY KEY,
+    hackathon_id INT NOT NULL REFERENCES hackathons(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    weight INTEGER NOT NULL CHECK (weight > 0),
+    max_score INTEGER NOT NULL DEFAULT 10 CHECK (max_score > 0),
+    position INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_judging_criteria_hackathon ON judging_criteria (hackathon_id, position);
+
+-- Removing a judge removes their assignments and scores
+CREATE TABLE IF NOT EXISTS judge_assignments (
+    project_id BIGINT NOT NULL REFERENCES hackathon_submissions(id) ON DELETE CASCADE,
+    judge_id INT NOT NULL,
+    hackathon_id INT NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    scored_at TIMESTAMPTZ,  -- NULL until the judge has scored every criterion
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (project_id, judge_id),
+    FOREIGN KEY (hackathon_id, judge_id) REFERENCES hackathon_judges(hackathon_id, user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_judge_assignments_judge ON judge_assignments (judge_id, hackathon_id);
+
+CREATE TABLE IF NOT EXISTS judge_scores (
+    project_id BIGINT NOT NULL,
+    judge_id INT NOT NULL,
+    criterion_id BIGINT NOT NULL REFERENCES judging_criteria(id) ON DELETE CASCADE,
+    score INTEGER NOT NULL CHECK (score >= 0),
+    PRIMARY KEY (project_id, judge_id, criterion_id),
+    FOREIGN KEY (project_id, judge_id) REFERENCES judge_assignments(project_id, judge_id) ON DELETE CASCADE
+);
+
+ALTER TABLE hackathon_submissions
+    ADD COLUMN IF NOT EXISTS final_score DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS placement INTEGER;  -- set when results are published
+
+ALTER TABLE hackathons
+    ADD COLUMN IF NOT EXISTS results_published_at TIMESTAMPTZ;
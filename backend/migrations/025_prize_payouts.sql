@@ -0,0 +1,19 @@
+-- Coin prizes per final placement, paid to the team members when results
+-- are published
+CREATE TABLE IF NOT EXISTS hackathon_prizes (
+    hackathon_id INT NOT NULL REFERENCES hackathons(id) ON DELETE CASCADE,
+    placement INTEGER NOT NULL CHECK (placement > 0),
+    coins INTEGER NOT NULL CHECK (coins > 0),  -- per team, split among its members
+    PRIMARY KEY (hackathon_id, placement)
+);
+
+-- One row per paid member; the primary key keeps payouts from being repeated
+CREATE TABLE IF NOT EXISTS hackathon_prize_payouts (
+    hackathon_id INT NOT NULL REFERENCES hackathons(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    team_id BIGINT NOT NULL REFERENCES teams(id) ON DELETE CASCADE,
+    placement INTEGER NOT NULL,
+    coins INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (hackathon_id, user_id)
+);
@@ -0,0 +1,25 @@
+-- Custom application forms: organisers add typed questions to a hackathon's
+-- application, answered when applying
+CREATE TABLE IF NOT EXISTS hackathon_form_fields (
+    id BIGSERIAL PRIMARY KEY,
+    hackathon_id INT NOT NULL REFERENCES hackathons(id) ON DELETE CASCADE,
+    label VARCHAR(200) NOT NULL,
+    type TEXT NOT NULL
+        CHECK (type IN ('text', 'long_text', 'number', 'choice', 'multi_choice', 'checkbox')),
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    choices TEXT[] NOT NULL DEFAULT '{}',  -- options of choice and multi_choice fields
+    position INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE INDEX IF NOT EXISTS idx_hackathon_form_fields_hackathon ON hackathon_form_fields (hackathon_id, position);
+
+-- Answers belong to the applicant rather than the application row, so they
+-- survive individual applications being folded into a team application
+CREATE TABLE IF NOT EXISTS hackathon_form_answers (
+    hackathon_id INT NOT NULL REFERENCES hackathons(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    field_id BIGINT NOT NULL REFERENCES hackathon_form_fields(id) ON DELETE CASCADE,
+    value TEXT NOT NULL DEFAULT '',
+    choices TEXT[] NOT NULL DEFAULT '{}',  -- set instead of value for multi_choice fields
+    PRIMARY KEY (hackathon_id, user_id, field_id)
+);
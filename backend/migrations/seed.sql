@@ -4,8 +4,16 @@
 -- ============================================================================
 
 -- Wipe everything (order matters due to foreign keys)
-TRUNCATE purchases, shop_items, club_members, clubs, gov_members, attendance,
-         hackathon_applications, hackathons, news, users
+TRUNCATE hackathon_form_answers, hackathon_form_fields,
+         hackathon_prize_payouts, hackathon_prizes, judge_scores,
+         judge_assignments, judging_criteria, hackathon_judges,
+         hackathon_submissions, team_pool, team_members, teams,
+         community_xp_events, referrals, quest_submissions, quests,
+         user_badges, badges, season_standings, seasons, admin_alerts,
+         coin_award_requests, coin_ledger, raffle_tickets, raffles,
+         purchases, orders, promo_codes, shop_items, club_members,
+         clubs, gov_members, attendance, hackathon_applications,
+         hackathons, news, users
          RESTART IDENTITY CASCADE;
 
 -- ─── News ──────────────────────────────────────────────────────────────────
@@ -50,34 +58,42 @@ INSERT INTO news (title, content, tag, image_url) VALUES
 
 -- ─── Hackathons ────────────────────────────────────────────────────────────
 
-INSERT INTO hackathons (title, description, status, start_date, end_date) VALUES
+INSERT INTO hackathons (title, description, status, start_date, end_date, location, max_team_size) VALUES
 (
   'TS Hackathon 2026',
   'Build innovative solutions for the Tomorrow School community. Theme: "Build the Future". Teams of 2-4 students. 48 hours of coding, mentoring, and fun. Prizes include scholarships and gadgets.',
-  'active',
+  'registration_open',
   '2026-02-15 09:00:00+00',
-  '2026-02-17 18:00:00+00'
+  '2026-02-17 18:00:00+00',
+  'Main campus, Hall A',
+  4
 ),
 (
   'DevTools Sprint Q1 2026',
   'A one-day sprint focused on developer experience: build extensions, CLI tools, or IDE plugins that make student and teacher workflows better. Solo or pairs. Lunch and swag provided. Winners get priority project showcase at the spring demo day.',
-  'active',
+  'registration_open',
   '2026-03-08 10:00:00+00',
-  '2026-03-08 20:00:00+00'
+  '2026-03-08 20:00:00+00',
+  'Room 302',
+  2
 ),
 (
   'AI Challenge 2025',
   'Last year''s AI-focused hackathon. Participants built chatbots, recommendation systems, and computer vision projects. Over 30 teams competed; winning projects are now being piloted in campus services.',
-  'past',
+  'finished',
   '2025-11-01 09:00:00+00',
-  '2025-11-03 18:00:00+00'
+  '2025-11-03 18:00:00+00',
+  'Main campus, Hall A',
+  4
 ),
 (
   'Green Tech Hack 2025',
   'Sustainability-themed hackathon. Teams designed apps and hardware prototypes for energy saving, recycling, and campus green initiatives. Sponsored by the Sustainability Office. Winning solutions are being evaluated for campus rollout.',
-  'past',
+  'finished',
   '2025-09-12 09:00:00+00',
-  '2025-09-14 18:00:00+00'
+  '2025-09-14 18:00:00+00',
+  'Main campus, Hall B',
+  5
 );
 
 -- ─── Clubs ─────────────────────────────────────────────────────────────────
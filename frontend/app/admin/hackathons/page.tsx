@@ -68,8 +68,8 @@ export default function AdminHackathonsPage() {
               <CardContent className="pt-4 flex items-center justify-between">
                 <div className="flex-1 min-w-0 space-y-1">
                   <div className="flex items-center gap-2">
-                    <Badge variant={h.status === "active" ? "default" : "secondary"} className="uppercase text-xs">
-                      {h.status}
+                    <Badge variant={h.status === "finished" || h.status === "cancelled" ? "secondary" : "default"} className="uppercase text-xs">
+                      {h.status.replace(/_/g, " ")}
                     </Badge>
                   </div>
                   <h3 className="font-medium text-sm truncate">{h.title}</h3>
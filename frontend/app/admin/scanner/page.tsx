@@ -61,7 +61,7 @@ export default function AdminScannerPage() {
     setSubmitting(true);
     setResult(null);
     try {
-      await api("/api/attendance/check-in", {
+      const res = await api<{ status?: string }>("/api/attendance/check-in", {
         method: "POST",
         body: JSON.stringify({
           user_id: parseInt(scannedUserId),
@@ -69,7 +69,12 @@ export default function AdminScannerPage() {
           coins: parseInt(coins) || 10,
         }),
       });
-      setResult({ success: true, message: `Checked in user #${scannedUserId} — ${coins} coins awarded!` });
+      // Large awards come back as a pending request for a second admin.
+      const message =
+        res.status === "pending"
+          ? `${coins} coins for user #${scannedUserId} are waiting for another admin's approval`
+          : `Checked in user #${scannedUserId} — ${coins} coins awarded!`;
+      setResult({ success: true, message });
       setScannedUserId("");
     } catch (err) {
       setResult({ success: false, message: err instanceof Error ? err.message : "Check-in failed" });
@@ -9,7 +9,7 @@ import { Badge } from "@/components/ui/badge";
 import { Button } from "@/components/ui/button";
 import { Input } from "@/components/ui/input";
 import { Skeleton } from "@/components/ui/skeleton";
-import { ArrowLeft, Calendar, Send } from "lucide-react";
+import { ArrowLeft, Calendar, MapPin, Send, Users } from "lucide-react";
 
 interface Hackathon {
   id: number;
@@ -18,6 +18,12 @@ interface Hackathon {
   status: string;
   start_date: string;
   end_date: string;
+  location?: string;
+  prizes?: string;
+  rules?: string;
+  max_team_size?: number;
+  cover_image_url?: string;
+  organizer_contacts?: string;
 }
 
 export default function HackathonDetailPage() {
@@ -29,6 +35,7 @@ export default function HackathonDetailPage() {
   const [teamName, setTeamName] = useState("");
   const [applying, setApplying] = useState(false);
   const [applied, setApplied] = useState(false);
+  const [waitlistPosition, setWaitlistPosition] = useState<number | null>(null);
   const [applyError, setApplyError] = useState<string | null>(null);
 
   useEffect(() => {
@@ -44,10 +51,13 @@ export default function HackathonDetailPage() {
     // Optimistic: show applied immediately
     setApplied(true);
     try {
-      await api(`/api/hackathons/${params.id}/apply`, {
+      const application = await api<{ status: string; waitlist_position?: number }>(`/api/hackathons/${params.id}/apply`, {
         method: "POST",
         body: JSON.stringify({ team_name: teamName || undefined }),
       });
+      if (application.status === "waitlisted") {
+        setWaitlistPosition(application.waitlist_position ?? null);
+      }
     } catch (err) {
       // Revert on error
       setApplied(false);
@@ -84,11 +94,15 @@ export default function HackathonDetailPage() {
         <ArrowLeft className="h-4 w-4 mr-1" /> Back
       </Button>
 
+      {hackathon.cover_image_url && (
+        <img src={hackathon.cover_image_url} alt="" className="w-full h-40 object-cover rounded-xl" />
+      )}
+
       <h1 className="text-2xl font-bold">{hackathon.title}</h1>
 
       <div className="flex items-center gap-2">
-        <Badge variant={hackathon.status === "active" ? "default" : "secondary"} className="uppercase">
-          {hackathon.status}
+        <Badge variant={hackathon.status === "finished" || hackathon.status === "cancelled" ? "secondary" : "default"} className="uppercase">
+          {hackathon.status.replace(/_/g, " ")}
         </Badge>
         <div className="flex items-center gap-1 text-xs text-muted-foreground">
           <Calendar className="h-3 w-3" />
@@ -104,8 +118,38 @@ export default function HackathonDetailPage() {
         </CardContent>
       </Card>
 
+      {(hackathon.location || hackathon.max_team_size) && (
+        <div className="flex flex-wrap gap-3 text-xs text-muted-foreground">
+          {hackathon.location && (
+            <span className="flex items-center gap-1">
+              <MapPin className="h-3 w-3" /> {hackathon.location}
+            </span>
+          )}
+          {hackathon.max_team_size && (
+            <span className="flex items-center gap-1">
+              <Users className="h-3 w-3" /> Up to {hackathon.max_team_size} per team
+            </span>
+          )}
+        </div>
+      )}
+
+      {[
+        ["Prizes", hackathon.prizes],
+        ["Rules", hackathon.rules],
+        ["Contacts", hackathon.organizer_contacts],
+      ].map(([label, text]) =>
+        text ? (
+          <Card key={label}>
+            <CardContent className="pt-4 space-y-1">
+              <h3 className="font-semibold text-sm">{label}</h3>
+              <div className="whitespace-pre-wrap text-sm leading-relaxed">{text}</div>
+            </CardContent>
+          </Card>
+        ) : null
+      )}
+
       {/* Apply section */}
-      {user && hackathon.status === "active" && !applied && (
+      {user && hackathon.status === "registration_open" && !applied && (
         <Card>
           <CardContent className="pt-4 space-y-3">
             <h3 className="font-semibold">Apply to this hackathon</h3>
@@ -128,7 +172,13 @@ export default function HackathonDetailPage() {
       {applied && (
         <Card>
           <CardContent className="pt-4 text-center">
-            <p className="text-green-600 font-medium">Application submitted successfully!</p>
+            {waitlistPosition !== null ? (
+              <p className="text-amber-600 font-medium">
+                The hackathon is full. You&apos;re #{waitlistPosition} on the waitlist.
+              </p>
+            ) : (
+              <p className="text-green-600 font-medium">Application submitted successfully!</p>
+            )}
           </CardContent>
         </Card>
       )}
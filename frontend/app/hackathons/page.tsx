@@ -70,10 +70,10 @@ export default function HackathonsPage() {
               <Card className="hover:opacity-80 transition-opacity">
                 <CardContent className="pt-4 space-y-2">
                   <Badge
-                    variant={h.status === "active" ? "default" : "secondary"}
+                    variant={h.status === "finished" || h.status === "cancelled" ? "secondary" : "default"}
                     className="uppercase text-xs"
                   >
-                    {h.status}
+                    {h.status.replace(/_/g, " ")}
                   </Badge>
                   <h2 className="font-semibold">{h.title}</h2>
                   <p className="text-sm text-muted-foreground line-clamp-2">
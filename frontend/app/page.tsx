@@ -36,6 +36,7 @@ interface LeaderboardEntry {
   photo_url?: string;
   coins: number;
   school_level?: number;
+  score: number;
 }
 
 export default function Home() {
@@ -51,7 +52,7 @@ export default function Home() {
       api<Hackathon[]>("/api/hackathons?status=active").then((data) => {
         if (data.length > 0) setHackathon(data[0]);
       }),
-      api<LeaderboardEntry[]>("/api/leaderboard").then(setLeaderboard).catch(() => {}),
+      api<LeaderboardEntry[]>("/api/leaderboard?limit=5").then(setLeaderboard).catch(() => {}),
     ])
       .catch(console.error)
       .finally(() => setLoading(false));
@@ -154,7 +155,7 @@ export default function Home() {
                   </div>
                   <div className="flex items-center gap-1">
                     <Coins className="h-3 w-3 text-yellow-500" />
-                    <span className="text-sm font-semibold">{entry.coins}</span>
+                    <span className="text-sm font-semibold">{entry.score}</span>
                   </div>
                 </div>
               ))}